@@ -409,10 +409,11 @@ func NewApp(
 		authtypes.FeeCollectorName,
 	)
 
+	// NOTE: MultiStakingKeeper is passed by reference, it's set below.
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
 		keys[slashingtypes.StoreKey],
-		&stakingKeeper,
+		multistakingkeeper.NewSlashingStakingKeeper(&stakingKeeper, &app.MultiStakingKeeper),
 		app.GetSubspace(slashingtypes.ModuleName),
 	)
 
@@ -459,6 +460,7 @@ func NewApp(
 		app.EpochsKeeper,
 		&app.StakingKeeper,
 		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// register the staking hooks
@@ -484,7 +486,11 @@ func NewApp(
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	scopedLiquiStakeKeeper := app.CapabilityKeeper.ScopeToModule(liquidstaketypes.ModuleName)
 
-	app.EpochsKeeper = *epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	app.EpochsKeeper = *epochskeeper.NewKeeper(
+		appCodec,
+		keys[epochstypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	// Sealing prevents other modules from creating scoped sub-keepers
 	app.CapabilityKeeper.Seal()
@@ -556,6 +562,7 @@ func NewApp(
 		app.IBCKeeper,
 		app.ICAControllerKeeper,
 		app.TransferKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	transferStack := liquidstake.NewIBCMiddleware(app.LiquidStakeKeeper, transferIBCModule)
@@ -599,10 +606,10 @@ func NewApp(
 	)
 
 	// set epoch keeper
-	app.EpochsKeeper.SetHooks(epochskeeper.NewMultiEpochHooks(
+	app.EpochsKeeper.Subscribe(
 		app.LiquidStakeKeeper.Hooks(),
 		app.MultiStakingKeeper.Hooks(),
-	))
+	)
 
 	/****  Module Options ****/
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	epochstypes "github.com/celinium-network/celinium/x/epochs/types"
+)
+
+// TestProductionGenesisRequiredEpochs checks that every epoch subscribed by the modules is defined in
+// the production genesis and declared as required, so the handlers are not silently skipped on a real
+// network. The identifiers are derived from the subscriptions, not from the required epochs themselves.
+func TestProductionGenesisRequiredEpochs(t *testing.T) {
+	app := Setup(t, false)
+
+	genesisEpochs := make(map[string]bool)
+	for _, epoch := range epochstypes.ProductionGenesisState().Epochs {
+		genesisEpochs[epoch.Identifier] = true
+	}
+
+	requiredEpochs := make(map[string]bool)
+	for _, epoch := range app.EpochsKeeper.RequiredEpochs() {
+		requiredEpochs[epoch.Identifier] = true
+	}
+
+	listeners := app.EpochsKeeper.GetEpochListeners("")
+	require.NotEmpty(t, listeners)
+
+	for _, listener := range listeners {
+		require.True(t, genesisEpochs[listener.Identifier],
+			"epoch %s subscribed by %s is not in production genesis", listener.Identifier, listener.Module)
+		require.True(t, requiredEpochs[listener.Identifier],
+			"epoch %s subscribed by %s is not required", listener.Identifier, listener.Module)
+	}
+}
+
+func TestEnsureRequiredEpochs(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	required := app.EpochsKeeper.RequiredEpochs()[0]
+	app.EpochsKeeper.DeleteEpochInfo(ctx, required.Identifier)
+
+	// the missing epoch is created with its default duration.
+	require.NoError(t, app.EpochsKeeper.EnsureRequiredEpochs(ctx))
+	epoch, found := app.EpochsKeeper.GetEpochInfo(ctx, required.Identifier)
+	require.True(t, found)
+	require.Equal(t, required.DefaultDuration, epoch.Duration)
+	require.Equal(t, ctx.BlockTime(), epoch.StartTime)
+}
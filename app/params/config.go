@@ -19,6 +19,9 @@ const (
 	DelegationEpochIdentifier   = "LiquidStakeDelegateEpoch"
 	UndelegationEpochIdentifier = "LiquidStakeUndelegateEpoch"
 	ReinvestEpochIdentifier     = "LiquidStakeReinvestEpoch"
+
+	RefreshAgentDelegationEpochIdentifier = "MultiStakingRefreshAgentDelegation"
+	CollectAgentRewardEpochIdentifier     = "MultiStakingCollectReward"
 )
 
 var (
@@ -42,6 +42,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
+		GetCmdEpochHistory(),
+		GetCmdEpochListeners(),
 	)
 
 	return cmd
@@ -123,3 +125,81 @@ func GetCmdCurrentEpoch() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdEpochHistory provides the history records of the epochs by specified identifier
+func GetCmdEpochHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-history [identifier]",
+		Short: "Query the history records of the epochs by specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs epoch-history week --reverse`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EpochHistory(cmd.Context(), &types.QueryEpochHistoryRequest{
+				Identifier: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-history")
+
+	return cmd
+}
+
+// GetCmdEpochListeners provides the modules which subscribe to the epochs
+func GetCmdEpochListeners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-listeners [identifier]",
+		Short: "Query the modules which subscribe to the epochs, optionally by specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs epoch-listeners week`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEpochListenersRequest{}
+			if len(args) > 0 {
+				req.Identifier = args[0]
+			}
+
+			res, err := queryClient.EpochListeners(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -28,6 +28,10 @@ import (
 // InitGenesis initializes the epochs module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
 	// set epoch info from genesis
 	for _, epoch := range genState.Epochs {
 		// Initialize empty epoch values via Cosmos SDK
@@ -39,11 +43,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 		k.SetEpochInfo(ctx, epoch)
 	}
+
+	for _, record := range genState.History {
+		k.SetEpochRecord(ctx, record)
+	}
+
+	if err := k.EnsureRequiredEpochs(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the epochs module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Epochs: k.AllEpochInfos(ctx),
+		Epochs:  k.AllEpochInfos(ctx),
+		Params:  k.GetParams(ctx),
+		History: k.AllEpochRecords(ctx),
 	}
 }
@@ -36,8 +36,7 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		// Has it not started, and is the block time > initial epoch start time
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
 
-		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
-		shouldEpochEnd := ctx.BlockTime().After(epochEndTime) && !shouldInitialEpochStart && !epochInfo.StartTime.After(ctx.BlockTime())
+		shouldEpochEnd := epochInfo.ShouldEnd(ctx.BlockHeight(), ctx.BlockTime()) && !shouldInitialEpochStart && !epochInfo.StartTime.After(ctx.BlockTime())
 
 		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
 
@@ -49,21 +48,36 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		case shouldEpochEnd:
 			epochInfo.EndEpoch()
 
-			logger.Info("ending epoch", "identifier", epochInfo.Identifier)
+			var skipped int64
+			switch {
+			case epochInfo.IsHeightBased():
+				// a height based epoch can't be missed, it starts in the block which ends the last one.
+				epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+			case epochInfo.CatchUpMode == types.CatchUpModeFastForward:
+				skipped = epochInfo.FastForward(ctx.BlockTime())
+			}
+
+			logger.Info("ending epoch", "identifier", epochInfo.Identifier, "skipped", skipped)
 
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeEpochEnd,
 					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+					sdk.NewAttribute(types.AttributeSkippedEpochs, strconv.FormatInt(skipped, 10)),
 				),
 			)
 			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+
+			if skipped > 0 {
+				k.AfterEpochsSkipped(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, skipped)
+			}
 		default:
 			// continue
 			return false
 		}
 
 		k.SetEpochInfo(ctx, epochInfo)
+		k.recordEpochStart(ctx, epochInfo)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
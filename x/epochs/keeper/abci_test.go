@@ -2,9 +2,16 @@ package keeper_test
 
 import (
 	"fmt"
+	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celinium-network/celinium/app"
+	"github.com/celinium-network/celinium/testutil"
 	"github.com/celinium-network/celinium/x/epochs"
+	"github.com/celinium-network/celinium/x/epochs/keeper"
 	"github.com/celinium-network/celinium/x/epochs/types"
 )
 
@@ -212,3 +219,90 @@ func (suite *KeeperTestSuite) TestEpochStartingOneMonthAfterInitGenesis() {
 	suite.Require().Equal(epochInfo.CurrentEpochStartTime.UTC().String(), now.Add(month).UTC().String())
 	suite.Require().Equal(epochInfo.EpochCountingStarted, true)
 }
+
+// recordEpochHooks records the epoch numbers of the ended epochs and the skipped epochs of identifier.
+type recordEpochHooks struct {
+	identifier string
+	ended      *[]int64
+	skipped    *[]int64
+}
+
+func (h recordEpochHooks) AfterEpochEnd(_ sdk.Context, identifier string, epochNumber int64) {
+	if identifier == h.identifier {
+		*h.ended = append(*h.ended, epochNumber)
+	}
+}
+
+func (h recordEpochHooks) BeforeEpochStart(sdk.Context, string, int64) {}
+
+func (h recordEpochHooks) AfterEpochsSkipped(_ sdk.Context, identifier string, _ int64, skipped int64) {
+	if identifier == h.identifier {
+		*h.skipped = append(*h.skipped, skipped)
+	}
+}
+
+func TestBeginBlockerCatchUpModes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		mode       types.CatchUpMode
+		expEnded   []int64
+		expSkipped []int64
+		expEpoch   int64
+	}{
+		{"one per block", types.CatchUpModeOnePerBlock, []int64{2, 3}, nil, 3},
+		{"fast forward", types.CatchUpModeFastForward, []int64{6}, []int64{4}, 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			celiniumApp := app.Setup(t, false)
+			start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			ctx := celiniumApp.BaseApp.NewContext(false, testutil.NewHeader(1, start, "test", nil, nil, nil))
+
+			var ended, skipped []int64
+			k := keeper.NewKeeper(celiniumApp.AppCodec(), celiniumApp.GetKey(types.StoreKey), celiniumApp.EpochsKeeper.GetAuthority())
+			k.SetHooks(recordEpochHooks{identifier: "catch_up", ended: &ended, skipped: &skipped})
+
+			require.NoError(t, k.CreateEpoch(ctx, "catch_up", start, time.Hour, tc.mode))
+			k.BeginBlocker(ctx)
+
+			// the chain halts for more than 5 epochs, then produces 2 blocks.
+			for i := int64(2); i <= 3; i++ {
+				ctx = ctx.WithBlockHeight(i).WithBlockTime(start.Add(5*time.Hour + time.Duration(i)*time.Second))
+				k.BeginBlocker(ctx)
+			}
+
+			epochInfo, found := k.GetEpochInfo(ctx, "catch_up")
+			require.True(t, found)
+			require.Equal(t, tc.expEnded, ended)
+			require.Equal(t, tc.expSkipped, skipped)
+			require.Equal(t, tc.expEpoch, epochInfo.CurrentEpoch)
+		})
+	}
+}
+
+func TestBeginBlockerHeightEpoch(t *testing.T) {
+	celiniumApp := app.Setup(t, false)
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := celiniumApp.BaseApp.NewContext(false, testutil.NewHeader(1, start, "test", nil, nil, nil))
+
+	var ended []int64
+	k := keeper.NewKeeper(celiniumApp.AppCodec(), celiniumApp.GetKey(types.StoreKey), celiniumApp.EpochsKeeper.GetAuthority())
+	k.SetHooks(recordEpochHooks{identifier: "blocks", ended: &ended, skipped: new([]int64)})
+
+	require.NoError(t, k.CreateHeightEpoch(ctx, "blocks", start, 3))
+	k.BeginBlocker(ctx)
+
+	// the block time doesn't matter, the epoch ends every 3 blocks.
+	for i := int64(2); i <= 10; i++ {
+		ctx = ctx.WithBlockHeight(i).WithBlockTime(start.Add(time.Duration(i) * 24 * time.Hour))
+		k.BeginBlocker(ctx)
+	}
+	require.Equal(t, []int64{2, 3, 4}, ended)
+
+	res, err := k.CurrentEpoch(sdk.WrapSDKContext(ctx), &types.QueryCurrentEpochRequest{Identifier: "blocks"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), res.CurrentEpoch)
+	require.Equal(t, int64(13), res.EndHeight)
+	require.Nil(t, res.EndTime)
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/epochs/types"
+)
+
+// GetEpochRecord returns the history record of the epoch by identifier and epoch number
+func (k Keeper) GetEpochRecord(ctx sdk.Context, identifier string, epochNumber int64) (types.EpochRecord, bool) {
+	record := types.EpochRecord{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetEpochRecordKey(identifier, epochNumber))
+	if len(bz) == 0 {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetEpochRecord set the history record of the epoch
+func (k Keeper) SetEpochRecord(ctx sdk.Context, record types.EpochRecord) {
+	bz := k.cdc.MustMarshal(&record)
+	ctx.KVStore(k.storeKey).Set(types.GetEpochRecordKey(record.Identifier, record.EpochNumber), bz)
+}
+
+// IterateEpochHistory iterate through the history records of the epochs of identifier in the order
+// of the epoch number
+func (k Keeper) IterateEpochHistory(ctx sdk.Context, identifier string, fn func(record types.EpochRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(identifier))
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.EpochRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// AllEpochRecords returns the history records of all epochs
+func (k Keeper) AllEpochRecords(ctx sdk.Context) []types.EpochRecord {
+	records := []types.EpochRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochHistory)
+
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.EpochRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// DeleteEpochHistory deletes all history records of the epochs of identifier
+func (k Keeper) DeleteEpochHistory(ctx sdk.Context, identifier string) {
+	k.pruneEpochHistory(ctx, identifier, func(types.EpochRecord) bool { return true })
+}
+
+// recordEpochStart ends the record of the last epoch and records the start of the current epoch,
+// then prunes the records which are out of the history retention.
+func (k Keeper) recordEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) {
+	retention := int64(k.GetParams(ctx).HistoryRetention)
+
+	if last, found := k.lastEpochRecord(ctx, epochInfo.Identifier); found && last.EndHeight == 0 {
+		last.EndHeight = ctx.BlockHeight()
+		k.SetEpochRecord(ctx, last)
+	}
+
+	if retention > 0 {
+		k.SetEpochRecord(ctx, types.EpochRecord{
+			Identifier:  epochInfo.Identifier,
+			EpochNumber: epochInfo.CurrentEpoch,
+			StartHeight: epochInfo.CurrentEpochStartHeight,
+			StartTime:   epochInfo.CurrentEpochStartTime,
+		})
+	}
+
+	k.pruneEpochHistory(ctx, epochInfo.Identifier, func(record types.EpochRecord) bool {
+		return record.EpochNumber <= epochInfo.CurrentEpoch-retention
+	})
+}
+
+// lastEpochRecord returns the record of the largest epoch number in the history of identifier.
+func (k Keeper) lastEpochRecord(ctx sdk.Context, identifier string) (types.EpochRecord, bool) {
+	record := types.EpochRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(identifier))
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record, true
+}
+
+// pruneEpochHistory deletes the records of identifier from the oldest one until shouldPrune returns false.
+func (k Keeper) pruneEpochHistory(ctx sdk.Context, identifier string, shouldPrune func(record types.EpochRecord) bool) {
+	var keys [][]byte
+	k.IterateEpochHistory(ctx, identifier, func(record types.EpochRecord) bool {
+		if !shouldPrune(record) {
+			return true
+		}
+		keys = append(keys, types.GetEpochRecordKey(record.Identifier, record.EpochNumber))
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
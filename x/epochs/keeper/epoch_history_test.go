@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celinium-network/celinium/app"
+	"github.com/celinium-network/celinium/testutil"
+	"github.com/celinium-network/celinium/x/epochs/types"
+)
+
+func TestEpochHistory(t *testing.T) {
+	celiniumApp := app.Setup(t, false)
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := celiniumApp.BaseApp.NewContext(false, testutil.NewHeader(1, start, "test", nil, nil, nil))
+
+	k := celiniumApp.EpochsKeeper
+	require.NoError(t, k.SetParams(ctx, types.NewParams(types.DefaultHookGasLimit, 3)))
+	require.NoError(t, k.CreateHeightEpoch(ctx, "blocks", start, 2))
+
+	// the epochs start at the heights 1, 3, 5, 7 and 9.
+	for i := int64(1); i <= 9; i++ {
+		ctx = ctx.WithBlockHeight(i).WithBlockTime(start.Add(time.Duration(i) * time.Minute))
+		k.BeginBlocker(ctx)
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx)
+	res, err := k.EpochHistory(goCtx, &types.QueryEpochHistoryRequest{Identifier: "blocks"})
+	require.NoError(t, err)
+	require.Equal(t, []types.EpochRecord{
+		{Identifier: "blocks", EpochNumber: 3, StartHeight: 5, StartTime: start.Add(5 * time.Minute), EndHeight: 7},
+		{Identifier: "blocks", EpochNumber: 4, StartHeight: 7, StartTime: start.Add(7 * time.Minute), EndHeight: 9},
+		{Identifier: "blocks", EpochNumber: 5, StartHeight: 9, StartTime: start.Add(9 * time.Minute)},
+	}, res.Records)
+
+	res, err = k.EpochHistory(goCtx, &types.QueryEpochHistoryRequest{
+		Identifier: "blocks",
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, int64(5), res.Records[0].EpochNumber)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// disabling the history prunes the records at the next epoch.
+	require.NoError(t, k.SetParams(ctx, types.NewParams(types.DefaultHookGasLimit, 0)))
+	ctx = ctx.WithBlockHeight(11)
+	k.BeginBlocker(ctx)
+	require.Empty(t, k.AllEpochRecords(ctx))
+}
@@ -17,6 +17,9 @@
 package keeper
 
 import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -43,6 +46,91 @@ func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
 	store.Set([]byte(epoch.Identifier), bz)
 }
 
+// CreateEpoch creates a new time based epoch which starts counting at startTime, the block time
+// is used if startTime is zero.
+func (k Keeper) CreateEpoch(ctx sdk.Context, identifier string, startTime time.Time, duration time.Duration, catchUpMode types.CatchUpMode) error {
+	return k.createEpoch(ctx, types.EpochInfo{
+		Identifier:  identifier,
+		StartTime:   startTime,
+		Duration:    duration,
+		CatchUpMode: catchUpMode,
+	})
+}
+
+// CreateHeightEpoch creates a new height based epoch which ends every blockDuration blocks, it
+// starts counting at startTime, the block time is used if startTime is zero.
+func (k Keeper) CreateHeightEpoch(ctx sdk.Context, identifier string, startTime time.Time, blockDuration int64) error {
+	return k.createEpoch(ctx, types.EpochInfo{
+		Identifier:    identifier,
+		StartTime:     startTime,
+		BlockDuration: blockDuration,
+	})
+}
+
+func (k Keeper) createEpoch(ctx sdk.Context, epoch types.EpochInfo) error {
+	if _, found := k.GetEpochInfo(ctx, epoch.Identifier); found {
+		return sdkerrors.Wrapf(types.ErrEpochExists, "identifier %s", epoch.Identifier)
+	}
+
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = ctx.BlockTime()
+	}
+
+	epoch.CurrentEpoch = 0
+	epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+	epoch.CurrentEpochStartTime = time.Time{}
+	epoch.EpochCountingStarted = false
+	if err := epoch.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidEpoch, err.Error())
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+	return nil
+}
+
+// UpdateEpochDuration updates the duration of epoch, it takes effect at the next epoch boundary
+// if the epoch counting has started.
+func (k Keeper) UpdateEpochDuration(ctx sdk.Context, identifier string, duration time.Duration) error {
+	epoch, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrEpochNotFound, "identifier %s", identifier)
+	}
+
+	if epoch.IsHeightBased() {
+		return sdkerrors.Wrapf(types.ErrInvalidEpoch, "epoch %s is height based", identifier)
+	}
+
+	epoch.UpdateDuration(duration)
+	if err := epoch.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidEpoch, err.Error())
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+	return nil
+}
+
+// EnsureRequiredEpochs checks that the epochs required by the hooks exist. The missing epochs are
+// created with their default duration, an error is returned if one has no default duration.
+func (k Keeper) EnsureRequiredEpochs(ctx sdk.Context) error {
+	for _, required := range k.RequiredEpochs() {
+		if _, found := k.GetEpochInfo(ctx, required.Identifier); found {
+			continue
+		}
+
+		if required.DefaultDuration <= 0 {
+			return sdkerrors.Wrapf(types.ErrEpochNotFound, "required epoch %s", required.Identifier)
+		}
+
+		if err := k.CreateEpoch(ctx, required.Identifier, time.Time{}, required.DefaultDuration, types.CatchUpModeOnePerBlock); err != nil {
+			return err
+		}
+
+		k.Logger(ctx).Info("created required epoch", "identifier", required.Identifier)
+	}
+
+	return nil
+}
+
 // DeleteEpochInfo delete epoch info
 func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
@@ -78,7 +78,68 @@ func (k Keeper) CurrentEpoch(
 		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
 	}
 
-	return &types.QueryCurrentEpochResponse{
+	res := &types.QueryCurrentEpochResponse{
 		CurrentEpoch: info.CurrentEpoch,
+	}
+	switch {
+	case !info.EpochCountingStarted:
+		// the end of the current epoch is unknown until the counting starts.
+	case info.IsHeightBased():
+		res.EndHeight = info.EndHeight()
+	default:
+		endTime := info.EndTime()
+		res.EndTime = &endTime
+	}
+
+	return res, nil
+}
+
+// EpochHistory provides the history records of the epochs of specified identifier
+func (k Keeper) EpochHistory(
+	c context.Context,
+	req *types.QueryEpochHistoryRequest,
+) (*types.QueryEpochHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateEpochIdentifierString(req.Identifier); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.EpochRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochHistoryPrefix(req.Identifier))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.EpochRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EpochListeners provides the modules which subscribe to the epochs
+func (k Keeper) EpochListeners(
+	_ context.Context,
+	req *types.QueryEpochListenersRequest,
+) (*types.QueryEpochListenersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryEpochListenersResponse{
+		Listeners: k.GetEpochListeners(req.Identifier),
 	}, nil
 }
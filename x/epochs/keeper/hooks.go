@@ -17,12 +17,17 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/celinium-network/celinium/x/epochs/types"
 )
 
-var _ types.EpochHooks = MultiEpochHooks{}
+var (
+	_ types.EpochHooks     = MultiEpochHooks{}
+	_ types.EpochsRequirer = MultiEpochHooks{}
+)
 
 // combine multiple epoch hooks, all hook functions are run in array sequence
 type MultiEpochHooks []types.EpochHooks
@@ -47,18 +52,189 @@ func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier stri
 	}
 }
 
-// AfterEpochEnd executes the indicated hook after epochs ends
+// RequiredEpochs returns the epochs required by all hooks
+func (mh MultiEpochHooks) RequiredEpochs() []types.RequiredEpoch {
+	var epochs []types.RequiredEpoch
+	for i := range mh {
+		if requirer, ok := mh[i].(types.EpochsRequirer); ok {
+			epochs = append(epochs, requirer.RequiredEpochs()...)
+		}
+	}
+	return epochs
+}
+
+// RequiredEpochs returns the epochs required by the hooks and the subscribers
+func (k Keeper) RequiredEpochs() []types.RequiredEpoch {
+	var epochs []types.RequiredEpoch
+	if requirer, ok := k.hooks.(types.EpochsRequirer); ok {
+		epochs = append(epochs, requirer.RequiredEpochs()...)
+	}
+
+	for _, subscriber := range k.subscribers {
+		if requirer, ok := subscriber.(types.EpochsRequirer); ok {
+			epochs = append(epochs, requirer.RequiredEpochs()...)
+		}
+	}
+
+	return epochs
+}
+
+// moduleSubscription is the epoch subscription of a module.
+type moduleSubscription struct {
+	module string
+	types.EpochSubscription
+}
+
+// GetEpochListeners returns the modules which subscribe to the epochs of identifier in the order
+// of their handlers, the listeners of all epochs are returned if identifier is empty.
+func (k Keeper) GetEpochListeners(identifier string) []types.EpochListener {
+	listeners := []types.EpochListener{}
+	for _, subscription := range k.subscriptions {
+		if identifier != "" && subscription.Identifier != identifier {
+			continue
+		}
+
+		listeners = append(listeners, types.EpochListener{
+			Identifier: subscription.Identifier,
+			Module:     subscription.module,
+			Order:      subscription.Order,
+		})
+	}
+	return listeners
+}
+
+// AfterEpochEnd executes the indicated hook after epochs ends, the hooks run before the
+// handlers of the subscriptions.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	if k.hooks == nil {
-		return
+	for _, hook := range k.hookList() {
+		hook := hook
+		k.callHook(ctx, hookModuleName(hook), "after_epoch_end", identifier, func(ctx sdk.Context) {
+			hook.AfterEpochEnd(ctx, identifier, epochNumber)
+		})
+	}
+
+	for _, subscription := range k.subscriptionsOf(identifier) {
+		if handler := subscription.AfterEpochEnd; handler != nil {
+			k.callHook(ctx, subscription.module, "after_epoch_end", identifier, func(ctx sdk.Context) {
+				handler(ctx, epochNumber)
+			})
+		}
 	}
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
 }
 
-// BeforeEpochStart executes the indicated hook before the epochs
+// BeforeEpochStart executes the indicated hook before the epochs, the hooks run before the
+// handlers of the subscriptions.
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
+	for _, hook := range k.hookList() {
+		hook := hook
+		k.callHook(ctx, hookModuleName(hook), "before_epoch_start", identifier, func(ctx sdk.Context) {
+			hook.BeforeEpochStart(ctx, identifier, epochNumber)
+		})
+	}
+
+	for _, subscription := range k.subscriptionsOf(identifier) {
+		if handler := subscription.BeforeEpochStart; handler != nil {
+			k.callHook(ctx, subscription.module, "before_epoch_start", identifier, func(ctx sdk.Context) {
+				handler(ctx, epochNumber)
+			})
+		}
+	}
+}
+
+// AfterEpochsSkipped executes the hooks which handle the epochs skipped by the fast forward catch up
+func (k Keeper) AfterEpochsSkipped(ctx sdk.Context, identifier string, epochNumber int64, skipped int64) {
+	for _, hook := range k.hookList() {
+		skippedHook, ok := hook.(types.EpochsSkippedHooks)
+		if !ok {
+			continue
+		}
+
+		k.callHook(ctx, hookModuleName(hook), "after_epochs_skipped", identifier, func(ctx sdk.Context) {
+			skippedHook.AfterEpochsSkipped(ctx, identifier, epochNumber, skipped)
+		})
+	}
+
+	for _, subscription := range k.subscriptionsOf(identifier) {
+		if handler := subscription.AfterEpochsSkipped; handler != nil {
+			k.callHook(ctx, subscription.module, "after_epochs_skipped", identifier, func(ctx sdk.Context) {
+				handler(ctx, epochNumber, skipped)
+			})
+		}
+	}
+}
+
+// subscriptionsOf returns the subscriptions to the epochs of identifier in the order of their handlers.
+func (k Keeper) subscriptionsOf(identifier string) []moduleSubscription {
+	var subscriptions []moduleSubscription
+	for _, subscription := range k.subscriptions {
+		if subscription.Identifier == identifier {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions
+}
+
+// hookList returns the hooks which are invoked separately, so the failure of one hook
+// doesn't affect the others.
+func (k Keeper) hookList() []types.EpochHooks {
 	if k.hooks == nil {
+		return nil
+	}
+
+	if mh, ok := k.hooks.(MultiEpochHooks); ok {
+		return mh
+	}
+
+	return []types.EpochHooks{k.hooks}
+}
+
+// callHook invokes the hook in a cached context with the gas limit of params. The state
+// changes are committed only if the hook succeeds, otherwise the panic is recovered and
+// an epoch_hook_failed event is emitted.
+func (k Keeper) callHook(ctx sdk.Context, moduleName, hookName, identifier string, fn func(ctx sdk.Context)) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if gasLimit := k.GetParams(ctx).HookGasLimit; gasLimit > 0 {
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	}
+
+	if err := applyHook(cacheCtx, fn); err != nil {
+		k.Logger(ctx).Error("epoch hook failed", "module", moduleName, "hook", hookName,
+			"identifier", identifier, "error", err.Error())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochHookFailed,
+				sdk.NewAttribute(types.AttributeModule, moduleName),
+				sdk.NewAttribute(types.AttributeHook, hookName),
+				sdk.NewAttribute(types.AttributeEpochIdentifier, identifier),
+				sdk.NewAttribute(types.AttributeError, err.Error()),
+			),
+		)
 		return
 	}
-	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+
+	writeCache()
+}
+
+func applyHook(ctx sdk.Context, fn func(ctx sdk.Context)) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = fmt.Errorf("out of gas in location: %s", rType.Descriptor)
+			default:
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	fn(ctx)
+	return nil
+}
+
+func hookModuleName(hook types.EpochHooks) string {
+	if named, ok := hook.(types.NamedEpochHooks); ok {
+		return named.ModuleName()
+	}
+	return fmt.Sprintf("%T", hook)
 }
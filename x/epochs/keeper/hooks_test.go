@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celinium-network/celinium/app"
+	"github.com/celinium-network/celinium/testutil"
+	"github.com/celinium-network/celinium/x/epochs/keeper"
+	"github.com/celinium-network/celinium/x/epochs/types"
+)
+
+var hookCalledKey = []byte("hook_called")
+
+// mockEpochHooks writes to the store, then panics or consumes gas as configured.
+type mockEpochHooks struct {
+	name       string
+	storeKey   storetypes.StoreKey
+	panicMsg   string
+	consumeGas uint64
+}
+
+func (h mockEpochHooks) ModuleName() string { return h.name }
+
+func (h mockEpochHooks) AfterEpochEnd(sdk.Context, string, int64) {}
+
+func (h mockEpochHooks) BeforeEpochStart(ctx sdk.Context, _ string, _ int64) {
+	ctx.KVStore(h.storeKey).Set(append(hookCalledKey, h.name...), []byte{1})
+	ctx.GasMeter().ConsumeGas(h.consumeGas, "mock hook")
+	if h.panicMsg != "" {
+		panic(h.panicMsg)
+	}
+}
+
+func TestHookPanicIsolation(t *testing.T) {
+	celiniumApp := app.Setup(t, false)
+	ctx := celiniumApp.BaseApp.NewContext(false, testutil.NewHeader(1, time.Now().UTC(), "test", nil, nil, nil))
+
+	key := celiniumApp.GetKey(types.StoreKey)
+	k := keeper.NewKeeper(celiniumApp.AppCodec(), key, celiniumApp.EpochsKeeper.GetAuthority())
+	k.SetHooks(keeper.NewMultiEpochHooks(
+		mockEpochHooks{name: "panic", storeKey: key, panicMsg: "mock panic"},
+		mockEpochHooks{name: "gas", storeKey: key, consumeGas: 20000},
+		mockEpochHooks{name: "ok", storeKey: key, consumeGas: 1000},
+	))
+	require.NoError(t, k.SetParams(ctx, types.NewParams(10000, types.DefaultHistoryRetention)))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.BeforeEpochStart(ctx, types.DayEpochID, 1)
+
+	// only the state changes of the succeeded hook are committed.
+	store := ctx.KVStore(key)
+	require.False(t, store.Has(append(hookCalledKey, "panic"...)))
+	require.False(t, store.Has(append(hookCalledKey, "gas"...)))
+	require.True(t, store.Has(append(hookCalledKey, "ok"...)))
+
+	var failedModules []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEpochHookFailed {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeModule {
+				failedModules = append(failedModules, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{"panic", "gas"}, failedModules)
+}
+
+// mockEpochSubscriber subscribes to identifiers and records the order in which its handlers run.
+type mockEpochSubscriber struct {
+	name          string
+	subscriptions map[string]int32
+	calls         *[]string
+}
+
+func (s mockEpochSubscriber) ModuleName() string { return s.name }
+
+func (s mockEpochSubscriber) EpochSubscriptions() []types.EpochSubscription {
+	var subscriptions []types.EpochSubscription
+	for _, identifier := range []string{types.DayEpochID, types.WeekEpochID} {
+		order, ok := s.subscriptions[identifier]
+		if !ok {
+			continue
+		}
+
+		identifier := identifier
+		subscriptions = append(subscriptions, types.EpochSubscription{
+			Identifier: identifier,
+			Order:      order,
+			BeforeEpochStart: func(sdk.Context, int64) {
+				*s.calls = append(*s.calls, s.name+"/"+identifier)
+			},
+		})
+	}
+	return subscriptions
+}
+
+func TestEpochSubscriptions(t *testing.T) {
+	celiniumApp := app.Setup(t, false)
+	ctx := celiniumApp.BaseApp.NewContext(false, testutil.NewHeader(1, time.Now().UTC(), "test", nil, nil, nil))
+
+	var calls []string
+	k := keeper.NewKeeper(celiniumApp.AppCodec(), celiniumApp.GetKey(types.StoreKey), celiniumApp.EpochsKeeper.GetAuthority())
+	k.Subscribe(
+		mockEpochSubscriber{name: "a", subscriptions: map[string]int32{types.DayEpochID: 1, types.WeekEpochID: 0}, calls: &calls},
+		mockEpochSubscriber{name: "b", subscriptions: map[string]int32{types.DayEpochID: 0}, calls: &calls},
+		mockEpochSubscriber{name: "c", subscriptions: map[string]int32{types.DayEpochID: 1}, calls: &calls},
+	)
+
+	// only the subscribers of the identifier are called, in their declared order.
+	k.BeforeEpochStart(ctx, types.DayEpochID, 1)
+	require.Equal(t, []string{"b/day", "a/day", "c/day"}, calls)
+
+	calls = nil
+	k.BeforeEpochStart(ctx, types.HourEpochID, 1)
+	require.Empty(t, calls)
+
+	res, err := k.EpochListeners(sdk.WrapSDKContext(ctx), &types.QueryEpochListenersRequest{Identifier: types.DayEpochID})
+	require.NoError(t, err)
+	require.Equal(t, []types.EpochListener{
+		{Identifier: types.DayEpochID, Module: "b", Order: 0},
+		{Identifier: types.DayEpochID, Module: "a", Order: 1},
+		{Identifier: types.DayEpochID, Module: "c", Order: 1},
+	}, res.Listeners)
+	require.Len(t, k.GetEpochListeners(""), 4)
+
+	// an epoch with subscribers can't be deleted.
+	msgServer := keeper.NewMsgServerImpl(*k)
+	_, err = msgServer.DeleteEpoch(sdk.WrapSDKContext(ctx), &types.MsgDeleteEpoch{
+		Authority:  k.GetAuthority(),
+		Identifier: types.WeekEpochID,
+	})
+	require.ErrorIs(t, err, types.ErrEpochInUse)
+}
@@ -18,6 +18,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -32,16 +33,30 @@ type Keeper struct {
 	cdc      codec.Codec
 	storeKey storetypes.StoreKey
 	hooks    types.EpochHooks
+
+	// the modules which subscribe to specific epochs and their subscriptions in the order of
+	// their handlers
+	subscribers   []types.EpochSubscriber
+	subscriptions []moduleSubscription
+
+	// the address capable of creating, updating and deleting epochs, usually the gov module account
+	authority string
 }
 
 // NewKeeper returns a new instance of epochs Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, authority string) *Keeper {
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the address capable of managing epochs.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetHooks set the epoch hooks
 func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	if k.hooks != nil {
@@ -53,6 +68,27 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 	return k
 }
 
+// Subscribe registers the epoch subscriptions of the subscribers
+func (k *Keeper) Subscribe(subscribers ...types.EpochSubscriber) *Keeper {
+	subscriptions := append([]moduleSubscription{}, k.subscriptions...)
+	for _, subscriber := range subscribers {
+		for _, subscription := range subscriber.EpochSubscriptions() {
+			subscriptions = append(subscriptions, moduleSubscription{
+				module:            subscriber.ModuleName(),
+				EpochSubscription: subscription,
+			})
+		}
+	}
+
+	sort.SliceStable(subscriptions, func(i, j int) bool {
+		return subscriptions[i].Order < subscriptions[j].Order
+	})
+	k.subscribers = append(append([]types.EpochSubscriber{}, k.subscribers...), subscribers...)
+	k.subscriptions = subscriptions
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
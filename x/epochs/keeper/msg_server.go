@@ -0,0 +1,138 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/epochs/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer interface
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// CreateEpoch implements types.MsgServer
+func (ms msgServer) CreateEpoch(goCtx context.Context, msg *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var err error
+	if msg.BlockDuration > 0 {
+		err = ms.Keeper.CreateHeightEpoch(ctx, msg.Identifier, msg.StartTime, msg.BlockDuration)
+	} else {
+		err = ms.Keeper.CreateEpoch(ctx, msg.Identifier, msg.StartTime, msg.Duration, msg.CatchUpMode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, msg.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, msg.Duration.String()),
+			sdk.NewAttribute(types.AttributeEpochBlockDuration, strconv.FormatInt(msg.BlockDuration, 10)),
+		),
+	)
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// UpdateEpochDuration implements types.MsgServer
+func (ms msgServer) UpdateEpochDuration(goCtx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.UpdateEpochDuration(ctx, msg.Identifier, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateEpochDuration,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, msg.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, msg.Duration.String()),
+		),
+	)
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// DeleteEpoch implements types.MsgServer
+func (ms msgServer) DeleteEpoch(goCtx context.Context, msg *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := ms.GetEpochInfo(ctx, msg.Identifier); !found {
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "identifier %s", msg.Identifier)
+	}
+
+	if listeners := ms.GetEpochListeners(msg.Identifier); len(listeners) > 0 {
+		return nil, sdkerrors.Wrapf(types.ErrEpochInUse, "identifier %s, module %s", msg.Identifier, listeners[0].Module)
+	}
+
+	ms.DeleteEpochInfo(ctx, msg.Identifier)
+	ms.DeleteEpochHistory(ctx, msg.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, msg.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.SetParams(ctx, msg.Params); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUpdateParams),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
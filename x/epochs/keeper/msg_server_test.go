@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celinium-network/celinium/app"
+	"github.com/celinium-network/celinium/testutil"
+	"github.com/celinium-network/celinium/x/epochs/keeper"
+	"github.com/celinium-network/celinium/x/epochs/types"
+)
+
+func TestMsgServer(t *testing.T) {
+	celiniumApp := app.Setup(t, false)
+	ctx := celiniumApp.BaseApp.NewContext(false, testutil.NewHeader(1, time.Now().UTC(), "test", nil, nil, nil))
+
+	msgServer := keeper.NewMsgServerImpl(celiniumApp.EpochsKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	authority := celiniumApp.EpochsKeeper.GetAuthority()
+	identifier := "monthly"
+
+	_, err := msgServer.CreateEpoch(goCtx, &types.MsgCreateEpoch{
+		Authority:  sdk.AccAddress("invalid_authority___").String(),
+		Identifier: identifier,
+		Duration:   time.Hour,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.CreateEpoch(goCtx, &types.MsgCreateEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   time.Hour,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.CreateEpoch(goCtx, &types.MsgCreateEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   time.Hour,
+	})
+	require.ErrorIs(t, err, types.ErrEpochExists)
+
+	epoch, found := celiniumApp.EpochsKeeper.GetEpochInfo(ctx, identifier)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), epoch.StartTime)
+
+	// start the epoch, then the new duration takes effect at the next epoch.
+	celiniumApp.EpochsKeeper.BeginBlocker(ctx)
+	_, err = msgServer.UpdateEpochDuration(goCtx, &types.MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   time.Hour * 2,
+	})
+	require.NoError(t, err)
+
+	epoch, _ = celiniumApp.EpochsKeeper.GetEpochInfo(ctx, identifier)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+	require.Equal(t, time.Hour, epoch.Duration)
+	require.Equal(t, time.Hour*2, epoch.NextDuration)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour + time.Second))
+	celiniumApp.EpochsKeeper.BeginBlocker(ctx)
+	epoch, _ = celiniumApp.EpochsKeeper.GetEpochInfo(ctx, identifier)
+	require.Equal(t, int64(2), epoch.CurrentEpoch)
+	require.Equal(t, time.Hour*2, epoch.Duration)
+	require.Zero(t, epoch.NextDuration)
+
+	_, err = msgServer.UpdateEpochDuration(goCtx, &types.MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: "unknown",
+		Duration:   time.Hour,
+	})
+	require.ErrorIs(t, err, types.ErrEpochNotFound)
+
+	// a height based epoch can't update its duration.
+	_, err = msgServer.CreateEpoch(goCtx, &types.MsgCreateEpoch{
+		Authority:     authority,
+		Identifier:    "blocks",
+		BlockDuration: 10,
+	})
+	require.NoError(t, err)
+	epoch, _ = celiniumApp.EpochsKeeper.GetEpochInfo(ctx, "blocks")
+	require.Equal(t, int64(10), epoch.BlockDuration)
+
+	_, err = msgServer.UpdateEpochDuration(goCtx, &types.MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: "blocks",
+		Duration:   time.Hour,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidEpoch)
+
+	_, err = msgServer.DeleteEpoch(goCtx, &types.MsgDeleteEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+	})
+	require.NoError(t, err)
+	_, found = celiniumApp.EpochsKeeper.GetEpochInfo(ctx, identifier)
+	require.False(t, found)
+}
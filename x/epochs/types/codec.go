@@ -13,12 +13,33 @@
 //
 // You should have received a copy of the GNU Lesser General Public License
 // along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
-
 package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// RegisterLegacyAminoCodec registers the epochs messages on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateEpoch{}, "epochs/MsgCreateEpoch", nil)
+	cdc.RegisterConcrete(&MsgUpdateEpochDuration{}, "epochs/MsgUpdateEpochDuration", nil)
+	cdc.RegisterConcrete(&MsgDeleteEpoch{}, "epochs/MsgDeleteEpoch", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "epochs/MsgUpdateParams", nil)
+}
+
+// RegisterInterfaces registers the epochs messages on the provided InterfaceRegistry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateEpoch{},
+		&MsgUpdateEpochDuration{},
+		&MsgDeleteEpoch{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
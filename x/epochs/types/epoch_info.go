@@ -20,8 +20,58 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
+// CatchUpMode defines how the epochs missed during a chain halt are processed.
+type CatchUpMode uint32
+
+const (
+	// CatchUpModeOnePerBlock ends each missed epoch in successive blocks.
+	CatchUpModeOnePerBlock CatchUpMode = iota
+	// CatchUpModeFastForward jumps to the latest epoch boundary and reports the missed epochs as skipped.
+	CatchUpModeFastForward
+)
+
+func (m CatchUpMode) String() string {
+	switch m {
+	case CatchUpModeOnePerBlock:
+		return "one_per_block"
+	case CatchUpModeFastForward:
+		return "fast_forward"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(m))
+	}
+}
+
+// IsValid return true if the catch up mode is known.
+func (m CatchUpMode) IsValid() bool {
+	return m <= CatchUpModeFastForward
+}
+
+// IsHeightBased returns true if the epoch ends every BlockDuration blocks instead of every Duration.
+func (ei EpochInfo) IsHeightBased() bool {
+	return ei.BlockDuration > 0
+}
+
+// EndHeight returns the height at which the current height based epoch ends.
+func (ei EpochInfo) EndHeight() int64 {
+	return ei.CurrentEpochStartHeight + ei.BlockDuration
+}
+
+// EndTime returns the time after which the current time based epoch ends.
+func (ei EpochInfo) EndTime() time.Time {
+	return ei.CurrentEpochStartTime.Add(ei.Duration)
+}
+
+// ShouldEnd returns true if the current epoch ends in the block of height and blockTime.
+func (ei EpochInfo) ShouldEnd(height int64, blockTime time.Time) bool {
+	if ei.IsHeightBased() {
+		return height >= ei.EndHeight()
+	}
+	return blockTime.After(ei.EndTime())
+}
+
 // StartInitialEpoch sets the epoch info fields to their start values
 func (ei *EpochInfo) StartInitialEpoch() {
 	ei.EpochCountingStarted = true
@@ -29,10 +79,45 @@ func (ei *EpochInfo) StartInitialEpoch() {
 	ei.CurrentEpochStartTime = ei.StartTime
 }
 
-// EndEpoch increments the epoch counter and resets the epoch start time
+// EndEpoch increments the epoch counter and resets the epoch start time, the pending
+// duration takes effect from the new epoch.
 func (ei *EpochInfo) EndEpoch() {
 	ei.CurrentEpoch++
 	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration)
+
+	if ei.NextDuration > 0 {
+		ei.Duration = ei.NextDuration
+		ei.NextDuration = 0
+	}
+}
+
+// FastForward skips the epochs which have ended before blockTime, so the current epoch
+// contains blockTime. It returns the number of the skipped epochs.
+func (ei *EpochInfo) FastForward(blockTime time.Time) int64 {
+	elapsed := blockTime.Sub(ei.CurrentEpochStartTime)
+	if elapsed <= ei.Duration {
+		return 0
+	}
+
+	// the epoch ends in the first block after its end time, so an epoch ending exactly at
+	// blockTime is not skipped.
+	skipped := int64((elapsed - 1) / ei.Duration)
+	ei.CurrentEpoch += skipped
+	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(time.Duration(skipped) * ei.Duration)
+
+	return skipped
+}
+
+// UpdateDuration sets the duration of the epoch. The duration takes effect immediately if the
+// epoch counting has not started, otherwise it takes effect at the next epoch boundary.
+func (ei *EpochInfo) UpdateDuration(duration time.Duration) {
+	if !ei.EpochCountingStarted {
+		ei.Duration = duration
+		ei.NextDuration = 0
+		return
+	}
+
+	ei.NextDuration = duration
 }
 
 // Validate performs a stateless validation of the epoch info fields
@@ -40,12 +125,28 @@ func (ei EpochInfo) Validate() error {
 	if strings.TrimSpace(ei.Identifier) == "" {
 		return errors.New("epoch identifier cannot be blank")
 	}
-	if ei.Duration == 0 {
-		return errors.New("epoch duration cannot be 0")
+	if ei.BlockDuration < 0 {
+		return fmt.Errorf("epoch block duration cannot be negative: %d", ei.BlockDuration)
+	}
+	if ei.IsHeightBased() {
+		if ei.Duration != 0 || ei.NextDuration != 0 {
+			return errors.New("height based epoch cannot have a duration")
+		}
+		if ei.CatchUpMode != CatchUpModeOnePerBlock {
+			return fmt.Errorf("height based epoch cannot use catch up mode %s", ei.CatchUpMode)
+		}
+	} else if ei.Duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+	if ei.NextDuration < 0 {
+		return fmt.Errorf("next epoch duration cannot be negative: %s", ei.NextDuration)
 	}
 	if ei.CurrentEpoch < 0 {
 		return fmt.Errorf("current epoch cannot be negative: %d", ei.CurrentEpochStartHeight)
 	}
+	if !ei.CatchUpMode.IsValid() {
+		return fmt.Errorf("invalid catch up mode: %s", ei.CatchUpMode)
+	}
 	if ei.CurrentEpochStartHeight < 0 {
 		return fmt.Errorf("current epoch start height cannot be negative: %d", ei.CurrentEpochStartHeight)
 	}
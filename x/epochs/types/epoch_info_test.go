@@ -30,6 +30,27 @@ func (suite *EpochInfoTestSuite) TestStartEndEpoch() {
 	suite.Require().Equal(startTime.Add(duration), ei.CurrentEpochStartTime)
 }
 
+func (suite *EpochInfoTestSuite) TestUpdateDuration() {
+	startTime := time.Now()
+	duration := time.Hour * 24
+	ei := EpochInfo{StartTime: startTime, Duration: duration}
+
+	// takes effect immediately before the epoch counting starts.
+	ei.UpdateDuration(time.Hour)
+	suite.Require().Equal(time.Hour, ei.Duration)
+
+	ei.StartInitialEpoch()
+	ei.UpdateDuration(duration)
+	suite.Require().Equal(time.Hour, ei.Duration)
+	suite.Require().Equal(duration, ei.NextDuration)
+
+	// the current epoch ends with the old duration.
+	ei.EndEpoch()
+	suite.Require().Equal(startTime.Add(time.Hour), ei.CurrentEpochStartTime)
+	suite.Require().Equal(duration, ei.Duration)
+	suite.Require().Zero(ei.NextDuration)
+}
+
 func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 	testCases := []struct {
 		name       string
@@ -46,6 +67,9 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				CatchUpModeOnePerBlock,
+				0,
 			},
 			false,
 		},
@@ -59,6 +83,9 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				CatchUpModeOnePerBlock,
+				0,
 			},
 			false,
 		},
@@ -72,6 +99,9 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				CatchUpModeOnePerBlock,
+				0,
 			},
 			false,
 		},
@@ -85,9 +115,108 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				0,
+				CatchUpModeOnePerBlock,
+				0,
+			},
+			false,
+		},
+		{
+			"invalid - negative next epoch duration",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				-time.Hour,
+				CatchUpModeOnePerBlock,
+				0,
+			},
+			false,
+		},
+		{
+			"invalid - unknown catch up mode",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				CatchUpMode(2),
+				0,
+			},
+			false,
+		},
+		{
+			"invalid - negative block duration",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				CatchUpModeOnePerBlock,
+				-1,
+			},
+			false,
+		},
+		{
+			"invalid - height based epoch with duration",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				CatchUpModeOnePerBlock,
+				10,
+			},
+			false,
+		},
+		{
+			"invalid - height based epoch with fast forward",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				CatchUpModeFastForward,
+				10,
 			},
 			false,
 		},
+		{
+			"pass - height based epoch",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				CatchUpModeOnePerBlock,
+				10,
+			},
+			true,
+		},
 		{
 			"pass",
 			EpochInfo{
@@ -98,6 +227,9 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				CatchUpModeOnePerBlock,
+				0,
 			},
 			true,
 		},
@@ -113,3 +245,49 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 		}
 	}
 }
+
+func (suite *EpochInfoTestSuite) TestFastForward() {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		blockTime     time.Time
+		expSkipped    int64
+		expEpoch      int64
+		expEpochStart time.Time
+	}{
+		{"within the current epoch", start.Add(time.Minute), 0, 1, start},
+		{"exactly at the end of the current epoch", start.Add(time.Hour), 0, 1, start},
+		{"one epoch missed", start.Add(time.Hour + time.Minute), 1, 2, start.Add(time.Hour)},
+		{"at the end of the missed epoch", start.Add(2 * time.Hour), 1, 2, start.Add(time.Hour)},
+		{"several epochs missed", start.Add(5*time.Hour + time.Minute), 5, 6, start.Add(5 * time.Hour)},
+	}
+
+	for _, tc := range testCases {
+		ei := EpochInfo{
+			Identifier:            HourEpochID,
+			Duration:              time.Hour,
+			CurrentEpoch:          1,
+			CurrentEpochStartTime: start,
+			EpochCountingStarted:  true,
+			CatchUpMode:           CatchUpModeFastForward,
+		}
+
+		skipped := ei.FastForward(tc.blockTime)
+		suite.Require().Equal(tc.expSkipped, skipped, tc.name)
+		suite.Require().Equal(tc.expEpoch, ei.CurrentEpoch, tc.name)
+		suite.Require().Equal(tc.expEpochStart, ei.CurrentEpochStartTime, tc.name)
+	}
+}
+
+func (suite *EpochInfoTestSuite) TestShouldEnd() {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	timeEpoch := EpochInfo{Duration: time.Hour, CurrentEpochStartTime: start, CurrentEpochStartHeight: 10}
+	suite.Require().False(timeEpoch.ShouldEnd(1000, start.Add(time.Hour)))
+	suite.Require().True(timeEpoch.ShouldEnd(11, start.Add(time.Hour+time.Second)))
+
+	heightEpoch := EpochInfo{BlockDuration: 5, CurrentEpochStartTime: start, CurrentEpochStartHeight: 10}
+	suite.Require().False(heightEpoch.ShouldEnd(14, start.Add(24*time.Hour)))
+	suite.Require().True(heightEpoch.ShouldEnd(15, start))
+}
@@ -0,0 +1,40 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Validate performs a stateless validation of the epoch record fields
+func (r EpochRecord) Validate() error {
+	if strings.TrimSpace(r.Identifier) == "" {
+		return errors.New("epoch identifier cannot be blank")
+	}
+	if r.EpochNumber <= 0 {
+		return fmt.Errorf("epoch number must be positive: %d", r.EpochNumber)
+	}
+	if r.StartHeight < 0 {
+		return fmt.Errorf("epoch start height cannot be negative: %d", r.StartHeight)
+	}
+	if r.EndHeight != 0 && r.EndHeight < r.StartHeight {
+		return fmt.Errorf("epoch end height %d is lower than start height %d", r.EndHeight, r.StartHeight)
+	}
+	return nil
+}
@@ -21,6 +21,19 @@ const (
 	EventTypeEpochEnd   = "epoch_end"
 	EventTypeEpochStart = "epoch_start"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	EventTypeCreateEpoch         = "create_epoch"
+	EventTypeUpdateEpochDuration = "update_epoch_duration"
+	EventTypeDeleteEpoch         = "delete_epoch"
+	EventTypeUpdateParams        = "update_params"
+	EventTypeEpochHookFailed     = "epoch_hook_failed"
+
+	AttributeEpochNumber        = "epoch_number"
+	AttributeEpochStartTime     = "start_time"
+	AttributeEpochIdentifier    = "identifier"
+	AttributeEpochDuration      = "duration"
+	AttributeEpochBlockDuration = "block_duration"
+	AttributeSkippedEpochs      = "skipped"
+	AttributeModule             = "module"
+	AttributeHook               = "hook"
+	AttributeError              = "error"
 )
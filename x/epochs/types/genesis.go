@@ -25,7 +25,7 @@ import (
 
 // NewGenesisState creates a new genesis state instance
 func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+	return &GenesisState{Epochs: epochs, Params: DefaultParams()}
 }
 
 // ProductionGenesisState returns the default epochs genesis state
@@ -76,6 +76,24 @@ func ProductionGenesisState() *GenesisState {
 			CurrentEpochStartTime:   time.Time{},
 			EpochCountingStarted:    false,
 		},
+		{
+			Identifier:              appparams.RefreshAgentDelegationEpochIdentifier,
+			StartTime:               time.Time{},
+			Duration:                time.Hour,
+			CurrentEpoch:            0,
+			CurrentEpochStartHeight: 0,
+			CurrentEpochStartTime:   time.Time{},
+			EpochCountingStarted:    false,
+		},
+		{
+			Identifier:              appparams.CollectAgentRewardEpochIdentifier,
+			StartTime:               time.Time{},
+			Duration:                time.Hour * 2,
+			CurrentEpoch:            0,
+			CurrentEpochStartHeight: 0,
+			CurrentEpochStartTime:   time.Time{},
+			EpochCountingStarted:    false,
+		},
 	}
 	return NewGenesisState(epochs)
 }
@@ -108,6 +126,10 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	epochIdentifiers := make(map[string]bool)
 
 	for _, epoch := range gs.Epochs {
@@ -120,5 +142,20 @@ func (gs GenesisState) Validate() error {
 		epochIdentifiers[epoch.Identifier] = true
 	}
 
+	records := make(map[string]bool)
+	for _, record := range gs.History {
+		if !epochIdentifiers[record.Identifier] {
+			return fmt.Errorf("epoch record of unknown epoch %s", record.Identifier)
+		}
+		key := string(GetEpochRecordKey(record.Identifier, record.EpochNumber))
+		if records[key] {
+			return fmt.Errorf("duplicated epoch record %s %d", record.Identifier, record.EpochNumber)
+		}
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		records[key] = true
+	}
+
 	return nil
 }
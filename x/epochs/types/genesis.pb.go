@@ -45,6 +45,15 @@ type EpochInfo struct {
 	EpochCountingStarted bool `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	// current_epoch_start_height of the epoch
 	CurrentEpochStartHeight int64 `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// next_duration is the duration which takes effect at the next epoch boundary, zero means no change
+	NextDuration time.Duration `protobuf:"bytes,8,opt,name=next_duration,json=nextDuration,proto3,stdduration" json:"next_duration,omitempty" yaml:"next_duration"`
+	// catch_up_mode defines how the epochs missed during a chain halt are processed.
+	// 0) OnePerBlock: each missed epoch ends in successive blocks.
+	// 1) FastForward: the epoch jumps to the latest boundary, the missed epochs are reported as skipped.
+	CatchUpMode CatchUpMode `protobuf:"varint,9,opt,name=catch_up_mode,json=catchUpMode,proto3,casttype=CatchUpMode" json:"catch_up_mode,omitempty" yaml:"catch_up_mode"`
+	// block_duration is the number of blocks of a height based epoch, zero for a time based epoch.
+	// A height based epoch ends every block_duration blocks, its duration must be zero.
+	BlockDuration int64 `protobuf:"varint,10,opt,name=block_duration,json=blockDuration,proto3" json:"block_duration,omitempty" yaml:"block_duration"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -129,17 +138,180 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetNextDuration() time.Duration {
+	if m != nil {
+		return m.NextDuration
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetCatchUpMode() CatchUpMode {
+	if m != nil {
+		return m.CatchUpMode
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetBlockDuration() int64 {
+	if m != nil {
+		return m.BlockDuration
+	}
+	return 0
+}
+
+// EpochRecord defines the history record of an epoch.
+type EpochRecord struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// epoch_number is the number of the epoch
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// start_height is the height of the block in which the epoch started
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// start_time is the start time of the epoch
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// end_height is the height of the block in which the epoch ended, zero if the epoch has not ended
+	EndHeight int64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *EpochRecord) Reset()         { *m = EpochRecord{} }
+func (m *EpochRecord) String() string { return proto.CompactTextString(m) }
+func (*EpochRecord) ProtoMessage()    {}
+func (*EpochRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e317ecf9eba3d13c, []int{1}
+}
+func (m *EpochRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRecord.Merge(m, src)
+}
+func (m *EpochRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRecord proto.InternalMessageInfo
+
+func (m *EpochRecord) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochRecord) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochRecord) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// Params defines the parameters of the epochs module.
+type Params struct {
+	// hook_gas_limit is the gas limit of each epoch hook invocation, zero means no limit
+	HookGasLimit uint64 `protobuf:"varint,1,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty"`
+	// history_retention is the number of the latest epochs whose records are kept for each
+	// identifier, zero disables the epoch history
+	HistoryRetention uint64 `protobuf:"varint,2,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e317ecf9eba3d13c, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetHookGasLimit() uint64 {
+	if m != nil {
+		return m.HookGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	// params defines all the parameters of the module
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// history is the epoch records of all epochs
+	History []EpochRecord `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e317ecf9eba3d13c, []int{1}
+	return fileDescriptor_e317ecf9eba3d13c, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,44 +347,76 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetHistory() []EpochRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "celinium.epochs.v1.EpochInfo")
+	proto.RegisterType((*EpochRecord)(nil), "celinium.epochs.v1.EpochRecord")
+	proto.RegisterType((*Params)(nil), "celinium.epochs.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "celinium.epochs.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("celinium/epochs/v1/genesis.proto", fileDescriptor_e317ecf9eba3d13c) }
 
 var fileDescriptor_e317ecf9eba3d13c = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x18, 0xad, 0x69, 0x29, 0x57, 0xdf, 0x21, 0x84, 0x75, 0x70, 0xa6, 0xd2, 0x39, 0x51, 0x58, 0x22,
-	0x81, 0x1c, 0xf5, 0x60, 0xe2, 0xb6, 0x00, 0x02, 0xc4, 0x96, 0x32, 0x20, 0x96, 0x2a, 0x97, 0xba,
-	0x89, 0xa5, 0xc6, 0x8e, 0x12, 0xe7, 0x44, 0x37, 0x66, 0xa6, 0x1b, 0xf9, 0x49, 0x37, 0xde, 0xc8,
-	0x14, 0x50, 0xbb, 0x31, 0xde, 0x2f, 0x40, 0xb1, 0x93, 0x50, 0x28, 0x88, 0x2d, 0xf1, 0x7b, 0xdf,
-	0x7b, 0x7e, 0x4f, 0x9f, 0xa1, 0x1d, 0xb1, 0x25, 0x17, 0xbc, 0x4c, 0x3d, 0x96, 0xc9, 0x28, 0x29,
-	0xbc, 0xf3, 0x89, 0x17, 0x33, 0xc1, 0x0a, 0x5e, 0xd0, 0x2c, 0x97, 0x4a, 0x22, 0xd4, 0x32, 0xa8,
-	0x61, 0xd0, 0xf3, 0xc9, 0xf8, 0x30, 0x96, 0xb1, 0xd4, 0xb0, 0x57, 0x7f, 0x19, 0xe6, 0x98, 0xc4,
-	0x52, 0xc6, 0x4b, 0xe6, 0xe9, 0xbf, 0xb3, 0x72, 0xe1, 0xcd, 0xcb, 0x3c, 0x54, 0x5c, 0x8a, 0x06,
-	0xb7, 0xfe, 0xc4, 0x15, 0x4f, 0x59, 0xa1, 0xc2, 0x34, 0x33, 0x04, 0xe7, 0xf3, 0x00, 0x8e, 0x5e,
-	0xd6, 0x26, 0x6f, 0xc4, 0x42, 0x22, 0x02, 0x21, 0x9f, 0x33, 0xa1, 0xf8, 0x82, 0xb3, 0x1c, 0x03,
-	0x1b, 0xb8, 0xa3, 0x60, 0xeb, 0x04, 0xbd, 0x87, 0xb0, 0x50, 0x61, 0xae, 0x66, 0xb5, 0x0c, 0xbe,
-	0x61, 0x03, 0x77, 0xff, 0x64, 0x4c, 0x8d, 0x07, 0x6d, 0x3d, 0xe8, 0xbb, 0xd6, 0xc3, 0x3f, 0xbe,
-	0xac, 0xac, 0xde, 0x75, 0x65, 0xdd, 0x5d, 0x85, 0xe9, 0xf2, 0x99, 0xf3, 0x6b, 0xd6, 0xb9, 0xf8,
-	0x66, 0x81, 0x60, 0xa4, 0x0f, 0x6a, 0x3a, 0x4a, 0xe0, 0x5e, 0x7b, 0x75, 0xdc, 0xd7, 0xba, 0x0f,
-	0x76, 0x74, 0x5f, 0x34, 0x04, 0x7f, 0x52, 0xcb, 0xfe, 0xa8, 0x2c, 0xd4, 0x8e, 0x3c, 0x96, 0x29,
-	0x57, 0x2c, 0xcd, 0xd4, 0xea, 0xba, 0xb2, 0xee, 0x18, 0xb3, 0x16, 0x73, 0xbe, 0xd4, 0x56, 0x9d,
-	0x3a, 0x7a, 0x08, 0x6f, 0x47, 0x65, 0x9e, 0x33, 0xa1, 0x66, 0xba, 0x5d, 0x3c, 0xb0, 0x81, 0xdb,
-	0x0f, 0x0e, 0x9a, 0x43, 0x5d, 0x06, 0xfa, 0x04, 0x20, 0xfe, 0x8d, 0x35, 0xdb, 0xca, 0x7d, 0xf3,
-	0xbf, 0xb9, 0x1f, 0x35, 0xb9, 0x2d, 0x73, 0x95, 0x7f, 0x29, 0x99, 0x16, 0xee, 0x6d, 0x3b, 0x4f,
-	0xbb, 0x46, 0x9e, 0xc2, 0xfb, 0x86, 0x1f, 0xc9, 0x52, 0x28, 0x2e, 0x62, 0x33, 0xc8, 0xe6, 0x78,
-	0x68, 0x03, 0x77, 0x2f, 0x38, 0xd4, 0xe8, 0xf3, 0x06, 0x9c, 0x1a, 0x0c, 0x9d, 0xc2, 0xf1, 0xdf,
-	0xdc, 0x12, 0xc6, 0xe3, 0x44, 0xe1, 0x5b, 0x3a, 0xea, 0xd1, 0x8e, 0xe1, 0x6b, 0x0d, 0x3b, 0x6f,
-	0xe1, 0xc1, 0x2b, 0xb3, 0x88, 0x53, 0x15, 0x2a, 0x86, 0x4e, 0xe1, 0xd0, 0x2c, 0x20, 0x06, 0x76,
-	0xdf, 0xdd, 0x3f, 0x39, 0xa6, 0xbb, 0x8b, 0x49, 0xbb, 0xed, 0xf1, 0x07, 0x75, 0xea, 0xa0, 0x19,
-	0xf1, 0x27, 0x97, 0x6b, 0x02, 0xae, 0xd6, 0x04, 0x7c, 0x5f, 0x13, 0x70, 0xb1, 0x21, 0xbd, 0xab,
-	0x0d, 0xe9, 0x7d, 0xdd, 0x90, 0xde, 0x87, 0xa3, 0xee, 0x01, 0x7c, 0x6c, 0x9f, 0x80, 0x5a, 0x65,
-	0xac, 0x38, 0x1b, 0xea, 0x2a, 0x9f, 0xfc, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x40, 0x2b, 0x80, 0xa7,
-	0x22, 0x03, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0xcd, 0xbc, 0xa4, 0x69, 0x33, 0x49, 0xfa, 0x5e, 0x47, 0xed, 0xab, 0x1b, 0xa9, 0x76, 0x9e,
+	0x1f, 0x12, 0x91, 0x8a, 0x1c, 0xa5, 0xb0, 0x40, 0x74, 0x01, 0x4a, 0x41, 0x05, 0xf1, 0x21, 0xe4,
+	0x82, 0x84, 0x60, 0x61, 0x39, 0xf6, 0xd4, 0x1e, 0x35, 0xf6, 0x58, 0xf6, 0xb8, 0x6a, 0xc4, 0x86,
+	0x9f, 0xd0, 0x15, 0xe2, 0x17, 0xa1, 0x2e, 0xbb, 0x64, 0x15, 0x50, 0xbb, 0x43, 0xac, 0xba, 0x64,
+	0x85, 0x66, 0xc6, 0x4e, 0x62, 0xda, 0xaa, 0x1b, 0x76, 0xf6, 0x39, 0xe7, 0xde, 0x73, 0x3f, 0x34,
+	0x17, 0xb6, 0x1d, 0x3c, 0x24, 0x21, 0x49, 0x83, 0x2e, 0x8e, 0xa8, 0xe3, 0x27, 0xdd, 0x83, 0x5e,
+	0xd7, 0xc3, 0x21, 0x4e, 0x48, 0x62, 0x44, 0x31, 0x65, 0x14, 0xa1, 0x5c, 0x61, 0x48, 0x85, 0x71,
+	0xd0, 0x6b, 0x2d, 0x7b, 0xd4, 0xa3, 0x82, 0xee, 0xf2, 0x2f, 0xa9, 0x6c, 0xa9, 0x1e, 0xa5, 0xde,
+	0x10, 0x77, 0xc5, 0xdf, 0x20, 0xdd, 0xeb, 0xba, 0x69, 0x6c, 0x33, 0x42, 0xc3, 0x8c, 0xd7, 0x7e,
+	0xe7, 0x19, 0x09, 0x70, 0xc2, 0xec, 0x20, 0x92, 0x02, 0xfd, 0x63, 0x15, 0xd6, 0x1e, 0x71, 0x93,
+	0x27, 0xe1, 0x1e, 0x45, 0x2a, 0x84, 0xc4, 0xc5, 0x21, 0x23, 0x7b, 0x04, 0xc7, 0x0a, 0x68, 0x83,
+	0x4e, 0xcd, 0x9c, 0x41, 0xd0, 0x1b, 0x08, 0x13, 0x66, 0xc7, 0xcc, 0xe2, 0x69, 0x94, 0xbf, 0xda,
+	0xa0, 0x53, 0xdf, 0x6c, 0x19, 0xd2, 0xc3, 0xc8, 0x3d, 0x8c, 0x57, 0xb9, 0x47, 0x7f, 0xfd, 0x78,
+	0xac, 0x95, 0xce, 0xc7, 0xda, 0xd2, 0xc8, 0x0e, 0x86, 0xf7, 0xf4, 0x69, 0xac, 0x7e, 0xf4, 0x55,
+	0x03, 0x66, 0x4d, 0x00, 0x5c, 0x8e, 0x7c, 0xb8, 0x90, 0x97, 0xae, 0x94, 0x45, 0xde, 0xb5, 0x0b,
+	0x79, 0x1f, 0x66, 0x82, 0x7e, 0x8f, 0xa7, 0xfd, 0x3e, 0xd6, 0x50, 0x1e, 0x72, 0x8b, 0x06, 0x84,
+	0xe1, 0x20, 0x62, 0xa3, 0xf3, 0xb1, 0xf6, 0xb7, 0x34, 0xcb, 0x39, 0xfd, 0x13, 0xb7, 0x9a, 0x64,
+	0x47, 0xff, 0xc3, 0xa6, 0x93, 0xc6, 0x31, 0x0e, 0x99, 0x25, 0xa6, 0xab, 0x54, 0xda, 0xa0, 0x53,
+	0x36, 0x1b, 0x19, 0x28, 0x86, 0x81, 0x3e, 0x00, 0xa8, 0x14, 0x54, 0xd6, 0x4c, 0xdf, 0x73, 0xd7,
+	0xf6, 0xbd, 0x91, 0xf5, 0xad, 0xc9, 0x52, 0xae, 0xca, 0x24, 0xa7, 0xb0, 0x32, 0xeb, 0xbc, 0x3b,
+	0x99, 0xc8, 0x1d, 0xf8, 0xaf, 0xd4, 0x3b, 0x34, 0x0d, 0x19, 0x09, 0x3d, 0x19, 0x88, 0x5d, 0xa5,
+	0xda, 0x06, 0x9d, 0x05, 0x73, 0x59, 0xb0, 0xdb, 0x19, 0xb9, 0x2b, 0x39, 0xb4, 0x05, 0x5b, 0x97,
+	0xb9, 0xf9, 0x98, 0x78, 0x3e, 0x53, 0xe6, 0x45, 0xab, 0xab, 0x17, 0x0c, 0x1f, 0x0b, 0x1a, 0xbd,
+	0x87, 0xcd, 0x10, 0x1f, 0x32, 0x6b, 0xb2, 0x89, 0x85, 0xeb, 0x36, 0xb1, 0x95, 0x6d, 0x62, 0xb5,
+	0x10, 0x57, 0x58, 0xc7, 0xb2, 0x9c, 0x41, 0x41, 0x20, 0x77, 0xd2, 0xe0, 0x58, 0x9e, 0x0a, 0x3d,
+	0x85, 0x4d, 0xc7, 0x66, 0x8e, 0x6f, 0xa5, 0x91, 0x15, 0x50, 0x17, 0x2b, 0xb5, 0x36, 0xe8, 0x34,
+	0xfb, 0x37, 0xa7, 0x29, 0x0a, 0xb4, 0xfe, 0x73, 0xac, 0xd5, 0xb7, 0x39, 0xf2, 0x3a, 0x7a, 0x4e,
+	0x5d, 0x6c, 0xd6, 0x9d, 0xe9, 0x0f, 0x7a, 0x00, 0x17, 0x07, 0x43, 0xea, 0xec, 0x4f, 0x5b, 0x81,
+	0xbc, 0xf5, 0xfe, 0xda, 0xf9, 0x58, 0x5b, 0x91, 0xd9, 0x8a, 0xbc, 0x6e, 0x36, 0x05, 0x90, 0x97,
+	0xa3, 0xff, 0x00, 0xb0, 0x2e, 0x06, 0x64, 0x62, 0x87, 0xc6, 0xee, 0xb5, 0x4f, 0xe3, 0x3f, 0xd8,
+	0x90, 0x03, 0x0f, 0xd3, 0x60, 0x80, 0x63, 0xf1, 0x38, 0xca, 0x66, 0x5d, 0x60, 0x2f, 0x04, 0xc4,
+	0x25, 0x85, 0x6d, 0x94, 0xa5, 0x24, 0x99, 0xd9, 0x40, 0xf1, 0x81, 0x55, 0xfe, 0xe0, 0x03, 0x5b,
+	0x87, 0x10, 0x87, 0x6e, 0x6e, 0x3d, 0x27, 0xac, 0x6b, 0x38, 0x74, 0xa5, 0xb1, 0xfe, 0x0e, 0x56,
+	0x5f, 0xda, 0xb1, 0x1d, 0x24, 0xe8, 0x06, 0x5c, 0xf4, 0x29, 0xdd, 0xb7, 0x3c, 0x3b, 0xb1, 0x86,
+	0x24, 0x20, 0x4c, 0x34, 0x5b, 0x31, 0x1b, 0x1c, 0xdd, 0xb1, 0x93, 0x67, 0x1c, 0x43, 0x1b, 0x70,
+	0xc9, 0x27, 0x09, 0xa3, 0xf1, 0xc8, 0x8a, 0x31, 0xe3, 0x53, 0xa0, 0xa1, 0xe8, 0xb9, 0x62, 0xfe,
+	0x93, 0x11, 0x66, 0x8e, 0xeb, 0x9f, 0x01, 0x6c, 0xec, 0xc8, 0x0b, 0xb7, 0xcb, 0x6c, 0x86, 0xd1,
+	0x16, 0xac, 0xca, 0xcb, 0xa6, 0x80, 0x76, 0xb9, 0x53, 0xdf, 0x5c, 0x37, 0x2e, 0x5e, 0x3c, 0x63,
+	0x72, 0x96, 0xfa, 0x15, 0xde, 0xa5, 0x99, 0x85, 0xa0, 0xbb, 0xb0, 0x1a, 0x89, 0x52, 0x27, 0x07,
+	0xe8, 0x92, 0x60, 0xd9, 0x4c, 0x1e, 0x29, 0xf5, 0xe8, 0x3e, 0x9c, 0xcf, 0x6a, 0x53, 0xca, 0xc2,
+	0x57, 0xbb, 0xd2, 0x57, 0x6e, 0x3d, 0x8b, 0xcf, 0xa3, 0xfa, 0xbd, 0xe3, 0x53, 0x15, 0x9c, 0x9c,
+	0xaa, 0xe0, 0xdb, 0xa9, 0x0a, 0x8e, 0xce, 0xd4, 0xd2, 0xc9, 0x99, 0x5a, 0xfa, 0x72, 0xa6, 0x96,
+	0xde, 0xae, 0x4e, 0x8e, 0xfa, 0x61, 0x7e, 0xd6, 0xd9, 0x28, 0xc2, 0xc9, 0xa0, 0x2a, 0xb6, 0x76,
+	0xfb, 0xd7, 0x00, 0xc6, 0x5a, 0x17, 0x0a, 0xf6, 0x05, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -235,6 +439,24 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockDuration))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CatchUpMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CatchUpMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NextDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -250,12 +472,12 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -263,21 +485,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
 		i -= len(m.Identifier)
@@ -289,6 +511,92 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoryRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HookGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HookGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -309,6 +617,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -362,6 +694,53 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CatchUpMode != 0 {
+		n += 1 + sovGenesis(uint64(m.CatchUpMode))
+	}
+	if m.BlockDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockDuration))
+	}
+	return n
+}
+
+func (m *EpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HookGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.HookGasLimit))
+	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryRetention))
+	}
 	return n
 }
 
@@ -377,6 +756,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -604,17 +991,348 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NextDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpMode", wireType)
+			}
+			m.CatchUpMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpMode |= CatchUpMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDuration", wireType)
+			}
+			m.BlockDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimit", wireType)
+			}
+			m.HookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -688,6 +1406,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, EpochRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -92,6 +92,68 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis - with history",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier: WeekEpochID,
+						Duration:   time.Hour * 24 * 7,
+					},
+				},
+				History: []EpochRecord{
+					{Identifier: WeekEpochID, EpochNumber: 1, StartHeight: 1, EndHeight: 10},
+					{Identifier: WeekEpochID, EpochNumber: 2, StartHeight: 10},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - history of unknown epoch",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier: WeekEpochID,
+						Duration:   time.Hour * 24 * 7,
+					},
+				},
+				History: []EpochRecord{
+					{Identifier: DayEpochID, EpochNumber: 1, StartHeight: 1},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated epoch record",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier: WeekEpochID,
+						Duration:   time.Hour * 24 * 7,
+					},
+				},
+				History: []EpochRecord{
+					{Identifier: WeekEpochID, EpochNumber: 1, StartHeight: 1},
+					{Identifier: WeekEpochID, EpochNumber: 1, StartHeight: 1},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - end height lower than start height",
+			&GenesisState{
+				Epochs: []EpochInfo{
+					{
+						Identifier: WeekEpochID,
+						Duration:   time.Hour * 24 * 7,
+					},
+				},
+				History: []EpochRecord{
+					{Identifier: WeekEpochID, EpochNumber: 1, StartHeight: 10, EndHeight: 5},
+				},
+			},
+			false,
+		},
 		{
 			"invalid genesis - invalid Epoch",
 			&GenesisState{
@@ -16,7 +16,11 @@
 
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // EpochHooks event hooks for epoch processing
 type EpochHooks interface {
@@ -25,3 +29,55 @@ type EpochHooks interface {
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
 }
+
+// EpochsSkippedHooks is implemented by the epoch hooks which handle the epochs skipped by
+// the fast forward catch up, it's called once with the number of the skipped epochs.
+type EpochsSkippedHooks interface {
+	AfterEpochsSkipped(ctx sdk.Context, epochIdentifier string, epochNumber int64, skipped int64)
+}
+
+// NamedEpochHooks is implemented by the epoch hooks which report the name of their module,
+// the name is used in the events of the failed hook invocations.
+type NamedEpochHooks interface {
+	EpochHooks
+	ModuleName() string
+}
+
+// EpochHandler handles the epoch of a subscribed identifier.
+type EpochHandler func(ctx sdk.Context, epochNumber int64)
+
+// EpochsSkippedHandler handles the epochs of a subscribed identifier which are skipped by the
+// fast forward catch up.
+type EpochsSkippedHandler func(ctx sdk.Context, epochNumber int64, skipped int64)
+
+// EpochSubscription is the handlers of a module for the epochs of an identifier. The handlers
+// of an identifier run in ascending Order, the handlers with the same order run in the order of
+// subscription. A nil handler is skipped.
+type EpochSubscription struct {
+	Identifier         string
+	Order              int32
+	AfterEpochEnd      EpochHandler
+	BeforeEpochStart   EpochHandler
+	AfterEpochsSkipped EpochsSkippedHandler
+}
+
+// EpochSubscriber is implemented by the modules which handle the epochs of specific identifiers,
+// an epoch can't be deleted while it has subscribers.
+type EpochSubscriber interface {
+	ModuleName() string
+	EpochSubscriptions() []EpochSubscription
+}
+
+// RequiredEpoch is an epoch which a module requires to exist.
+type RequiredEpoch struct {
+	Identifier string
+	// DefaultDuration is the duration of the epoch if it's missing at InitGenesis and created
+	// automatically, the epoch must be defined in genesis if it's zero.
+	DefaultDuration time.Duration
+}
+
+// EpochsRequirer is implemented by the epoch hooks which require epochs to exist, the required
+// epochs are checked at InitGenesis.
+type EpochsRequirer interface {
+	RequiredEpochs() []RequiredEpoch
+}
@@ -16,6 +16,10 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "epochs"
@@ -30,7 +34,34 @@ const (
 // prefix bytes for the epochs persistent store
 const (
 	prefixEpoch = iota + 1
+	prefixParams
+	prefixEpochHistory
+)
+
+var (
+	// KeyPrefixEpoch defines prefix key for storing epochs
+	KeyPrefixEpoch = []byte{prefixEpoch}
+	// KeyParams defines key for storing the module parameters
+	KeyParams = []byte{prefixParams}
+	// KeyPrefixEpochHistory defines prefix key for storing the epoch records
+	KeyPrefixEpochHistory = []byte{prefixEpochHistory}
 )
 
-// KeyPrefixEpoch defines prefix key for storing epochs
-var KeyPrefixEpoch = []byte{prefixEpoch}
+// GetEpochHistoryPrefix returns the key prefix of the epoch records of identifier
+func GetEpochHistoryPrefix(identifier string) []byte {
+	return append(KeyPrefixEpochHistory, lengthPrefix([]byte(identifier))...)
+}
+
+// GetEpochRecordKey returns the key of the epoch record of identifier and epochNumber
+func GetEpochRecordKey(identifier string, epochNumber int64) []byte {
+	return append(GetEpochHistoryPrefix(identifier), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+func lengthPrefix(bz []byte) []byte {
+	bzLen := len(bz)
+	if bzLen == 0 {
+		return bz
+	}
+
+	return append([]byte{byte(bzLen)}, bz...)
+}
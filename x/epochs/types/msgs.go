@@ -0,0 +1,110 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateEpoch{}
+	_ sdk.Msg = &MsgUpdateEpochDuration{}
+	_ sdk.Msg = &MsgDeleteEpoch{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// GetSigners implements types.Msg
+func (msg *MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return err
+	}
+
+	switch {
+	case msg.BlockDuration < 0:
+		return fmt.Errorf("epoch block duration cannot be negative")
+	case msg.BlockDuration > 0 && msg.Duration != 0:
+		return fmt.Errorf("height based epoch cannot have a duration")
+	case msg.BlockDuration == 0 && msg.Duration <= 0:
+		return fmt.Errorf("epoch duration must be positive")
+	}
+
+	if !msg.CatchUpMode.IsValid() {
+		return fmt.Errorf("invalid catch up mode: %s", msg.CatchUpMode)
+	}
+
+	return nil
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgUpdateEpochDuration) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgUpdateEpochDuration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return err
+	}
+
+	if msg.Duration <= 0 {
+		return fmt.Errorf("epoch duration must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return ValidateEpochIdentifierString(msg.Identifier)
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Params.Validate()
+}
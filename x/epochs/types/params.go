@@ -0,0 +1,40 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+// DefaultHookGasLimit is the default gas limit of each epoch hook invocation, zero means no limit.
+const DefaultHookGasLimit uint64 = 0
+
+// DefaultHistoryRetention is the default number of the latest epochs whose records are kept for each identifier.
+const DefaultHistoryRetention uint64 = 100
+
+// NewParams creates a new Params instance
+func NewParams(hookGasLimit, historyRetention uint64) Params {
+	return Params{
+		HookGasLimit:     hookGasLimit,
+		HistoryRetention: historyRetention,
+	}
+}
+
+// DefaultParams returns default epochs module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultHookGasLimit, DefaultHistoryRetention)
+}
+
+// Validate performs basic validation on epochs parameters.
+func (p Params) Validate() error {
+	return nil
+}
@@ -10,19 +10,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -185,6 +189,10 @@ func (m *QueryCurrentEpochRequest) GetIdentifier() string {
 type QueryCurrentEpochResponse struct {
 	// current_epoch is the number of the current epoch
 	CurrentEpoch int64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// end_height is the height at which the current epoch ends, zero for a time based epoch
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// end_time is the time after which the current epoch ends, empty for a height based epoch
+	EndTime *time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
 func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResponse{} }
@@ -227,45 +235,351 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+func (m *QueryCurrentEpochResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryCurrentEpochResponse) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// QueryEpochHistoryRequest is the request type for the Query/EpochHistory RPC
+// method.
+type QueryEpochHistoryRequest struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochHistoryRequest) Reset()         { *m = QueryEpochHistoryRequest{} }
+func (m *QueryEpochHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochHistoryRequest) ProtoMessage()    {}
+func (*QueryEpochHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10fd30e857d7b20c, []int{4}
+}
+func (m *QueryEpochHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochHistoryRequest.Merge(m, src)
+}
+func (m *QueryEpochHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryEpochHistoryRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *QueryEpochHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEpochHistoryResponse is the response type for the Query/EpochHistory RPC
+// method.
+type QueryEpochHistoryResponse struct {
+	// records is the history records ordered by the epoch number
+	Records []EpochRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochHistoryResponse) Reset()         { *m = QueryEpochHistoryResponse{} }
+func (m *QueryEpochHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochHistoryResponse) ProtoMessage()    {}
+func (*QueryEpochHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10fd30e857d7b20c, []int{5}
+}
+func (m *QueryEpochHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochHistoryResponse.Merge(m, src)
+}
+func (m *QueryEpochHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEpochHistoryResponse) GetRecords() []EpochRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryEpochHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// EpochListener defines a module which subscribes to the epochs of an identifier.
+type EpochListener struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// module is the name of the subscribed module
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// order of the module's handlers among the handlers of the identifier, lower runs first
+	Order int32 `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (m *EpochListener) Reset()         { *m = EpochListener{} }
+func (m *EpochListener) String() string { return proto.CompactTextString(m) }
+func (*EpochListener) ProtoMessage()    {}
+func (*EpochListener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10fd30e857d7b20c, []int{6}
+}
+func (m *EpochListener) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochListener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochListener.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochListener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochListener.Merge(m, src)
+}
+func (m *EpochListener) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochListener) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochListener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochListener proto.InternalMessageInfo
+
+func (m *EpochListener) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochListener) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *EpochListener) GetOrder() int32 {
+	if m != nil {
+		return m.Order
+	}
+	return 0
+}
+
+// QueryEpochListenersRequest is the request type for the Query/EpochListeners RPC
+// method.
+type QueryEpochListenersRequest struct {
+	// identifier of the epoch, the listeners of all epochs are returned if it's empty
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryEpochListenersRequest) Reset()         { *m = QueryEpochListenersRequest{} }
+func (m *QueryEpochListenersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochListenersRequest) ProtoMessage()    {}
+func (*QueryEpochListenersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10fd30e857d7b20c, []int{7}
+}
+func (m *QueryEpochListenersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochListenersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochListenersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochListenersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochListenersRequest.Merge(m, src)
+}
+func (m *QueryEpochListenersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochListenersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochListenersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochListenersRequest proto.InternalMessageInfo
+
+func (m *QueryEpochListenersRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// QueryEpochListenersResponse is the response type for the Query/EpochListeners RPC
+// method.
+type QueryEpochListenersResponse struct {
+	// listeners in the order in which their handlers run
+	Listeners []EpochListener `protobuf:"bytes,1,rep,name=listeners,proto3" json:"listeners"`
+}
+
+func (m *QueryEpochListenersResponse) Reset()         { *m = QueryEpochListenersResponse{} }
+func (m *QueryEpochListenersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochListenersResponse) ProtoMessage()    {}
+func (*QueryEpochListenersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10fd30e857d7b20c, []int{8}
+}
+func (m *QueryEpochListenersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochListenersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochListenersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochListenersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochListenersResponse.Merge(m, src)
+}
+func (m *QueryEpochListenersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochListenersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochListenersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochListenersResponse proto.InternalMessageInfo
+
+func (m *QueryEpochListenersResponse) GetListeners() []EpochListener {
+	if m != nil {
+		return m.Listeners
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "celinium.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "celinium.epochs.v1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "celinium.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "celinium.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryEpochHistoryRequest)(nil), "celinium.epochs.v1.QueryEpochHistoryRequest")
+	proto.RegisterType((*QueryEpochHistoryResponse)(nil), "celinium.epochs.v1.QueryEpochHistoryResponse")
+	proto.RegisterType((*EpochListener)(nil), "celinium.epochs.v1.EpochListener")
+	proto.RegisterType((*QueryEpochListenersRequest)(nil), "celinium.epochs.v1.QueryEpochListenersRequest")
+	proto.RegisterType((*QueryEpochListenersResponse)(nil), "celinium.epochs.v1.QueryEpochListenersResponse")
 }
 
 func init() { proto.RegisterFile("celinium/epochs/v1/query.proto", fileDescriptor_10fd30e857d7b20c) }
 
 var fileDescriptor_10fd30e857d7b20c = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0x1e, 0x9c, 0x84, 0xef, 0x58, 0x2c, 0xc4, 0x95, 0xe8, 0x30, 0x25, 0x27, 0xc1,
-	0x71, 0x80, 0xad, 0x94, 0x0d, 0x16, 0x74, 0x08, 0x10, 0x1b, 0x64, 0x64, 0x81, 0x34, 0xbc, 0x0b,
-	0x96, 0xee, 0xec, 0x34, 0x76, 0x2a, 0xba, 0xb2, 0xb1, 0x21, 0x21, 0xb1, 0xf2, 0x05, 0xf8, 0x20,
-	0x1d, 0x2b, 0xb1, 0x30, 0x21, 0xd4, 0xf2, 0x41, 0x50, 0x6c, 0xb7, 0xa4, 0x6a, 0xd0, 0x75, 0x8b,
-	0xec, 0xf7, 0x7b, 0xef, 0xf7, 0xfe, 0x31, 0xa6, 0x19, 0x9c, 0x0a, 0x29, 0xaa, 0x33, 0x0e, 0x85,
-	0xca, 0xde, 0x6b, 0x3e, 0x8a, 0xf9, 0xb0, 0x82, 0x72, 0xcc, 0x8a, 0x52, 0x19, 0x45, 0xc8, 0xe2,
-	0x9e, 0xb9, 0x7b, 0x36, 0x8a, 0xc3, 0xa3, 0x4c, 0xe9, 0x33, 0xa5, 0xf9, 0x20, 0xd5, 0xe0, 0x8a,
-	0xf9, 0x28, 0x1e, 0x80, 0x49, 0x63, 0x5e, 0xa4, 0xb9, 0x90, 0xa9, 0x11, 0x4a, 0x3a, 0x3e, 0xec,
-	0xb5, 0xf4, 0xcf, 0x41, 0x82, 0x16, 0xda, 0x57, 0x5c, 0xc9, 0x55, 0xae, 0xec, 0x27, 0xaf, 0xbf,
-	0xfc, 0xe9, 0x7e, 0xae, 0x54, 0x7e, 0x0a, 0x3c, 0x2d, 0x04, 0x4f, 0xa5, 0x54, 0xc6, 0x36, 0xf5,
-	0x4c, 0xf4, 0x16, 0x5f, 0x7d, 0x55, 0xcf, 0x7d, 0x6a, 0x7b, 0xbe, 0x90, 0x27, 0x2a, 0x81, 0x61,
-	0x05, 0xda, 0x90, 0x67, 0x18, 0xff, 0x73, 0xe8, 0xa2, 0x1e, 0x3a, 0xdc, 0xe9, 0xdf, 0x62, 0x4e,
-	0x98, 0xd5, 0xc2, 0xcc, 0x6d, 0xe7, 0x85, 0xd9, 0xcb, 0x34, 0x07, 0xcf, 0x26, 0x0d, 0x32, 0xfa,
-	0x86, 0xf0, 0xde, 0xda, 0x08, 0x5d, 0x28, 0xa9, 0x81, 0x3c, 0xc2, 0xdb, 0x6e, 0x99, 0x2e, 0xea,
-	0x6d, 0x1d, 0xee, 0xf4, 0xaf, 0xb3, 0xf5, 0x90, 0x98, 0xe5, 0x6a, 0xec, 0xf8, 0xc2, 0xe4, 0xd7,
-	0x8d, 0x20, 0xf1, 0x08, 0x79, 0xbe, 0x22, 0xd8, 0xb1, 0x82, 0xb7, 0xcf, 0x15, 0x74, 0x93, 0x57,
-	0x0c, 0x1f, 0xe2, 0xae, 0x15, 0x7c, 0x52, 0x95, 0x25, 0x48, 0x63, 0xe7, 0x2d, 0x52, 0xa0, 0x18,
-	0x8b, 0x77, 0x20, 0x8d, 0x38, 0x11, 0x50, 0xda, 0x14, 0x2e, 0x25, 0x8d, 0x93, 0xe8, 0x31, 0xbe,
-	0xd6, 0xc2, 0xfa, 0xf5, 0x0e, 0xf0, 0xe5, 0xcc, 0x9d, 0xbf, 0xb1, 0xce, 0x96, 0xdf, 0x4a, 0x76,
-	0xb3, 0x46, 0x71, 0xff, 0x7b, 0x07, 0x5f, 0xb4, 0x2d, 0xc8, 0x27, 0x84, 0xf1, 0x72, 0x59, 0x4d,
-	0x8e, 0xda, 0xc2, 0x68, 0xff, 0x59, 0xe1, 0xdd, 0x8d, 0x6a, 0x9d, 0x56, 0x14, 0x7d, 0xfc, 0xf1,
-	0xe7, 0x4b, 0x67, 0x9f, 0x84, 0xbc, 0xe5, 0x49, 0xf9, 0x70, 0xbf, 0x22, 0xbc, 0xdb, 0xdc, 0x89,
-	0xdc, 0xfb, 0xef, 0x84, 0x96, 0xd8, 0xc2, 0xfb, 0x1b, 0x56, 0x7b, 0xa3, 0x3b, 0xd6, 0xe8, 0x80,
-	0xdc, 0x6c, 0x33, 0x5a, 0x89, 0xf0, 0x38, 0x9e, 0xcc, 0x28, 0x9a, 0xce, 0x28, 0xfa, 0x3d, 0xa3,
-	0xe8, 0xf3, 0x9c, 0x06, 0xd3, 0x39, 0x0d, 0x7e, 0xce, 0x69, 0xf0, 0x7a, 0x6f, 0xc9, 0x7e, 0x58,
-	0xd0, 0x66, 0x5c, 0x80, 0x1e, 0x6c, 0xdb, 0xa7, 0xfe, 0xe0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x3e, 0xa9, 0xd9, 0xb1, 0xa2, 0x03, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0x50, 0xfe, 0xfc, 0xfa, 0x02, 0xbf, 0xc3, 0x84, 0x40, 0x5d, 0x61, 0x0b, 0x25, 0x2a,
+	0x82, 0xcc, 0x5a, 0x4c, 0x3c, 0x88, 0x89, 0x09, 0x06, 0xc5, 0xc4, 0x83, 0x6e, 0x3c, 0x99, 0x18,
+	0xdc, 0x76, 0x87, 0xed, 0x26, 0xed, 0xcc, 0xb2, 0x33, 0x25, 0x12, 0xe3, 0x85, 0x9b, 0x37, 0x12,
+	0x13, 0x4f, 0x46, 0x0f, 0xc6, 0xef, 0xc2, 0x91, 0xc4, 0x8b, 0x27, 0x35, 0xe0, 0x37, 0xf0, 0x0b,
+	0x98, 0x9d, 0x99, 0xa5, 0x2d, 0x6c, 0xa1, 0x31, 0xde, 0x76, 0xdf, 0x79, 0x9f, 0x79, 0x9e, 0xf7,
+	0x99, 0x67, 0x06, 0xec, 0x1a, 0x6d, 0x84, 0x2c, 0x6c, 0x35, 0x1d, 0x1a, 0xf1, 0x5a, 0x5d, 0x38,
+	0x3b, 0x15, 0x67, 0xbb, 0x45, 0xe3, 0x5d, 0x12, 0xc5, 0x5c, 0x72, 0x8c, 0xd3, 0x75, 0xa2, 0xd7,
+	0xc9, 0x4e, 0xc5, 0x5a, 0xac, 0x71, 0xd1, 0xe4, 0xc2, 0xa9, 0x7a, 0x82, 0xea, 0x66, 0x67, 0xa7,
+	0x52, 0xa5, 0xd2, 0xab, 0x38, 0x91, 0x17, 0x84, 0xcc, 0x93, 0x21, 0x67, 0x1a, 0x6f, 0xcd, 0x66,
+	0xec, 0x1f, 0x50, 0x46, 0x45, 0x28, 0x4c, 0xc7, 0x44, 0xc0, 0x03, 0xae, 0x3e, 0x9d, 0xe4, 0xcb,
+	0x54, 0xa7, 0x03, 0xce, 0x83, 0x06, 0x75, 0xbc, 0x28, 0x74, 0x3c, 0xc6, 0xb8, 0x54, 0x9b, 0xa6,
+	0x98, 0x92, 0x59, 0x55, 0x7f, 0xd5, 0xd6, 0x96, 0x23, 0xc3, 0x26, 0x15, 0xd2, 0x6b, 0x46, 0xba,
+	0xa1, 0xfc, 0x12, 0x26, 0x9f, 0x26, 0xc2, 0xd6, 0x15, 0xe9, 0x23, 0xb6, 0xc5, 0x5d, 0xba, 0xdd,
+	0xa2, 0x42, 0xe2, 0x07, 0x00, 0x6d, 0x91, 0x45, 0x34, 0x8b, 0x16, 0x46, 0x57, 0xae, 0x12, 0x3d,
+	0x11, 0x49, 0x26, 0x22, 0x7a, 0x7c, 0x33, 0x11, 0x79, 0xe2, 0x05, 0xd4, 0x60, 0xdd, 0x0e, 0x64,
+	0xf9, 0x13, 0x82, 0xa9, 0x33, 0x14, 0x22, 0xe2, 0x4c, 0x50, 0xbc, 0x0a, 0xc3, 0x7a, 0xda, 0x22,
+	0x9a, 0xcd, 0x2f, 0x8c, 0xae, 0xcc, 0x90, 0xb3, 0x2e, 0x12, 0x85, 0x4b, 0x60, 0x6b, 0x83, 0x07,
+	0xdf, 0x4b, 0x39, 0xd7, 0x40, 0xf0, 0xc3, 0x2e, 0x81, 0x03, 0x4a, 0xe0, 0xb5, 0x0b, 0x05, 0x6a,
+	0xe6, 0x2e, 0x85, 0x77, 0xa0, 0xa8, 0x04, 0xde, 0x6f, 0xc5, 0x31, 0x65, 0x52, 0xf1, 0xa5, 0x2e,
+	0xd8, 0x00, 0xa1, 0x4f, 0x99, 0x0c, 0xb7, 0x42, 0x1a, 0x2b, 0x17, 0x0a, 0x6e, 0x47, 0xa5, 0xfc,
+	0x01, 0xc1, 0xa5, 0x0c, 0xb0, 0x99, 0x6f, 0x1e, 0xc6, 0x6b, 0xba, 0xbe, 0xa9, 0x44, 0xab, 0x0d,
+	0xf2, 0xee, 0x58, 0xad, 0xa3, 0x19, 0xcf, 0x00, 0x50, 0xe6, 0x6f, 0xd6, 0x69, 0x18, 0xd4, 0xa5,
+	0x9a, 0x23, 0xef, 0x16, 0x28, 0xf3, 0x37, 0x54, 0x01, 0xaf, 0xc2, 0x7f, 0xc9, 0x72, 0x72, 0x70,
+	0xc5, 0xbc, 0x1a, 0xd2, 0x22, 0xfa, 0x54, 0x49, 0x7a, 0xaa, 0xe4, 0x59, 0x7a, 0xaa, 0x6b, 0x83,
+	0xfb, 0x3f, 0x4a, 0xc8, 0x1d, 0xa1, 0xcc, 0x4f, 0x6a, 0xe5, 0x3d, 0x64, 0x66, 0x53, 0x54, 0x1b,
+	0xa1, 0x90, 0x3c, 0xde, 0xed, 0x73, 0xb6, 0x53, 0x09, 0x18, 0xf8, 0xeb, 0x04, 0x7c, 0x49, 0x3d,
+	0xea, 0x16, 0x61, 0x3c, 0xba, 0x07, 0x23, 0x31, 0xad, 0xf1, 0xd8, 0x4f, 0x43, 0x50, 0xea, 0x19,
+	0x02, 0x57, 0xf5, 0x99, 0x18, 0xa4, 0xa8, 0x7f, 0x97, 0x83, 0x17, 0x30, 0xae, 0x68, 0x1e, 0x87,
+	0x42, 0x52, 0x46, 0xe3, 0x0b, 0x0d, 0x9a, 0x84, 0xe1, 0x26, 0xf7, 0x5b, 0x0d, 0xaa, 0x58, 0x0b,
+	0xae, 0xf9, 0xc3, 0x13, 0x30, 0xc4, 0x63, 0x9f, 0xc6, 0xea, 0xbc, 0x86, 0x5c, 0xfd, 0x53, 0xbe,
+	0x0b, 0x56, 0xdb, 0x85, 0x94, 0x43, 0xf4, 0x1b, 0x34, 0x1f, 0x2e, 0x67, 0xa2, 0x8d, 0x8b, 0xeb,
+	0x50, 0x68, 0xa4, 0x45, 0xe3, 0xe3, 0x5c, 0x4f, 0x1f, 0x53, 0xb8, 0x71, 0xb2, 0x8d, 0x5c, 0xf9,
+	0x3d, 0x08, 0x43, 0x8a, 0x06, 0xbf, 0x45, 0x00, 0x27, 0x37, 0x4f, 0xe0, 0xc5, 0xac, 0xcd, 0xb2,
+	0x5f, 0x0e, 0x6b, 0xa9, 0xaf, 0x5e, 0x2d, 0xbc, 0x5c, 0xde, 0xfb, 0xfa, 0xeb, 0xdd, 0xc0, 0x34,
+	0xb6, 0x9c, 0x8c, 0x07, 0x50, 0x7f, 0xe1, 0xf7, 0x08, 0xc6, 0x3a, 0xef, 0x17, 0xbe, 0xd1, 0x93,
+	0x21, 0xe3, 0x0e, 0x5b, 0xcb, 0x7d, 0x76, 0x1b, 0x45, 0xd7, 0x95, 0xa2, 0x79, 0x3c, 0x97, 0xa5,
+	0xa8, 0xeb, 0x3a, 0xe3, 0xcf, 0x08, 0xc6, 0x3a, 0x43, 0x7d, 0x8e, 0xb0, 0x8c, 0x0b, 0x68, 0x2d,
+	0xf7, 0xd9, 0x6d, 0x84, 0xdd, 0x56, 0xc2, 0x6e, 0x62, 0xd2, 0xd3, 0xaa, 0xcd, 0xba, 0x86, 0x38,
+	0xaf, 0xdb, 0xc9, 0x79, 0x83, 0x3f, 0x22, 0xf8, 0xbf, 0x3b, 0x36, 0x98, 0x9c, 0xcf, 0x7c, 0x3a,
+	0x9d, 0x96, 0xd3, 0x77, 0xbf, 0xd1, 0xba, 0xa4, 0xb4, 0x5e, 0xc1, 0xf3, 0xbd, 0xb5, 0x9e, 0xa4,
+	0x6e, 0xad, 0x72, 0x70, 0x64, 0xa3, 0xc3, 0x23, 0x1b, 0xfd, 0x3c, 0xb2, 0xd1, 0xfe, 0xb1, 0x9d,
+	0x3b, 0x3c, 0xb6, 0x73, 0xdf, 0x8e, 0xed, 0xdc, 0xf3, 0xa9, 0x13, 0xf4, 0xab, 0x14, 0x2f, 0x77,
+	0x23, 0x2a, 0xaa, 0xc3, 0xea, 0xed, 0xbb, 0xf5, 0x67, 0x00, 0x04, 0xa7, 0xf3, 0x1f, 0x97, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -284,6 +598,10 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochHistory provide the history records of the epochs of specified identifier
+	EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error)
+	// EpochListeners provide the modules which subscribe to the epochs
+	EpochListeners(ctx context.Context, in *QueryEpochListenersRequest, opts ...grpc.CallOption) (*QueryEpochListenersResponse, error)
 }
 
 type queryClient struct {
@@ -312,12 +630,34 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error) {
+	out := new(QueryEpochHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celinium.epochs.v1.Query/EpochHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochListeners(ctx context.Context, in *QueryEpochListenersRequest, opts ...grpc.CallOption) (*QueryEpochListenersResponse, error) {
+	out := new(QueryEpochListenersResponse)
+	err := c.cc.Invoke(ctx, "/celinium.epochs.v1.Query/EpochListeners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochHistory provide the history records of the epochs of specified identifier
+	EpochHistory(context.Context, *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error)
+	// EpochListeners provide the modules which subscribe to the epochs
+	EpochListeners(context.Context, *QueryEpochListenersRequest) (*QueryEpochListenersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -330,6 +670,12 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) EpochHistory(ctx context.Context, req *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochHistory not implemented")
+}
+func (*UnimplementedQueryServer) EpochListeners(ctx context.Context, req *QueryEpochListenersRequest) (*QueryEpochListenersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochListeners not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -371,6 +717,42 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.epochs.v1.Query/EpochHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochHistory(ctx, req.(*QueryEpochHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochListenersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochListeners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.epochs.v1.Query/EpochListeners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochListeners(ctx, req.(*QueryEpochListenersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celinium.epochs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -383,6 +765,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "EpochHistory",
+			Handler:    _Query_EpochHistory_Handler,
+		},
+		{
+			MethodName: "EpochListeners",
+			Handler:    _Query_EpochListeners_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celinium/epochs/v1/query.proto",
@@ -522,6 +912,21 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.CurrentEpoch != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
 		i--
@@ -530,31 +935,231 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEpochHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEpochsInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryEpochHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryEpochsInfoResponse) Size() (n int) {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochListener) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochListener) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochListener) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Order != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochListenersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochListenersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochListenersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochListenersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochListenersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochListenersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Listeners) > 0 {
+		for iNdEx := len(m.Listeners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listeners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEpochsInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochsInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -566,45 +1171,548 @@ func (m *QueryEpochsInfoResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryCurrentEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Identifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochListener) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovQuery(uint64(m.Order))
+	}
+	return n
+}
+
+func (m *QueryEpochListenersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochListenersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listeners) > 0 {
+		for _, e := range m.Listeners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochInfo{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryCurrentEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CurrentEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -627,13 +1735,45 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -690,7 +1830,7 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -713,15 +1853,15 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -748,8 +1888,8 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Epochs = append(m.Epochs, EpochInfo{})
-			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, EpochRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -810,7 +1950,7 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *EpochListener) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -833,10 +1973,143 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochListener: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochListener: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochListenersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochListenersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochListenersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -892,7 +2165,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochListenersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -915,17 +2188,17 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochListenersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochListenersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listeners", wireType)
 			}
-			m.CurrentEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -935,11 +2208,26 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listeners = append(m.Listeners, EpochListener{})
+			if err := m.Listeners[len(m.Listeners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -26,6 +26,11 @@ func NewQueryCmd() *cobra.Command {
 		GetProxyDelegationCmd(),
 		GetChainUnbondingCmd(),
 		GetUserProxyDelegationCmd(),
+		GetHostValidatorSnapshotCmd(),
+		GetParamsCmd(),
+		GetRedeemRateHistoryCmd(),
+		GetSourceChainStatusCmd(),
+		GetUserHistoryCmd(),
 	)
 
 	return liquistakeQueryCmd
@@ -160,3 +165,159 @@ func GetUserProxyDelegationCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetHostValidatorSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-validator-snapshot [chain_id]",
+		Short: "Query the latest validator snapshot of a source chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHostValidatorSnapshotRequest{
+				ChainID: args[0],
+			}
+			res, err := queryClient.HostValidatorSnapshot(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Snapshot)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current liquidstake parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetRedeemRateHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-rate-history [chain_id]",
+		Short: "Query the redeem rate history of a source chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRedeemRateHistoryRequest{
+				ChainID:    args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.RedeemRateHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redeem-rate-history")
+
+	return cmd
+}
+
+func GetSourceChainStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sourcechain-status [chain_id]",
+		Short: "Query the status of a source chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySourceChainStatusRequest{
+				ChainID: args[0],
+			}
+			res, err := queryClient.SourceChainStatus(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetUserHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user-history [delegator]",
+		Short: "Query the delegations, undelegations and claims of a delegator across source chains",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryUserHistoryRequest{
+				Delegator:  args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.UserHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "user-history")
+
+	return cmd
+}
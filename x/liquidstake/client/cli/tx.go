@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
 
 	"github.com/celinium-network/celinium/x/liquidstake/types"
@@ -26,10 +27,17 @@ func NewTxCmd() *cobra.Command {
 	liquidStakeTxCmd.AddCommand(NewDelegateCmd())
 	liquidStakeTxCmd.AddCommand(NewUndelegateCmd())
 	liquidStakeTxCmd.AddCommand(NewClaimCmd())
+	liquidStakeTxCmd.AddCommand(NewSubmitHostValidatorSnapshotCmd())
 
 	return liquidStakeTxCmd
 }
 
+// FlagNativeDenomMetadata is the bank metadata json of the native token of source chain.
+const FlagNativeDenomMetadata = "native-denom-metadata"
+
+// FlagDelegationProofs is the json array of the proofs of the delegation amounts in host validator snapshot.
+const FlagDelegationProofs = "delegation-proofs"
+
 type CliValidators struct {
 	Vals []types.Validator
 }
@@ -75,10 +83,23 @@ func NewRegisterSourceChainCmd() *cobra.Command {
 				Caller:                    clientCtx.GetFromAddress().String(),
 			}
 
+			metadataJSON, err := cmd.Flags().GetString(FlagNativeDenomMetadata)
+			if err != nil {
+				return err
+			}
+			if len(metadataJSON) != 0 {
+				var metadata banktypes.Metadata
+				if err := clientCtx.Codec.UnmarshalJSON([]byte(metadataJSON), &metadata); err != nil {
+					return err
+				}
+				msg.NativeDenomMetadata = &metadata
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagNativeDenomMetadata, "", "the bank metadata json of native token, used for derivative token metadata")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -179,3 +200,64 @@ func NewClaimCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewSubmitHostValidatorSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: `submit-host-validator-snapshot [snapshot_json].`,
+		Short: `submit the validator snapshot of a source chain. The [snapshot_json] should be json like \n
+		{
+			"chainID": "xxxxxx",
+			"height": "100",
+			"validators": [{
+				"address": "xxxxxx",
+				"commission": "0.050000000000000000",
+				"uptime": "0.990000000000000000",
+				"votingPower": "1000000",
+				"jailed": false
+			}]
+		}
+		The validators with "delegationAmount" must be proven by the --delegation-proofs of the staking store at the height.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var snapshot types.HostValidatorSnapshot
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &snapshot); err != nil {
+				return err
+			}
+
+			msg := types.MsgSubmitHostValidatorSnapshot{
+				Submitter: clientCtx.GetFromAddress().String(),
+				Snapshot:  snapshot,
+			}
+
+			proofsJSON, err := cmd.Flags().GetString(FlagDelegationProofs)
+			if err != nil {
+				return err
+			}
+			if len(proofsJSON) != 0 {
+				var rawProofs []json.RawMessage
+				if err := json.Unmarshal([]byte(proofsJSON), &rawProofs); err != nil {
+					return err
+				}
+				for _, rawProof := range rawProofs {
+					var proof types.HostDelegationProof
+					if err := clientCtx.Codec.UnmarshalJSON(rawProof, &proof); err != nil {
+						return err
+					}
+					msg.Proofs = append(msg.Proofs, proof)
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDelegationProofs, "", "the json array of the proofs of delegation amounts, each is like {\"validator\", \"delegation\", \"delegationProof\", \"validatorInfo\", \"validatorProof\"}")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
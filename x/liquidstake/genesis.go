@@ -0,0 +1,22 @@
+package liquidstake
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// InitGenesis initializes the liquidstake module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the liquidstake module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+}
@@ -61,8 +61,8 @@ func (IBCModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress)
 }
 
 // OnTimeoutPacket implements types.IBCModule
-func (IBCModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
-	return nil
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	return im.keeper.HandleIBCTimeout(ctx, &packet)
 }
 
 func NewIBCModule(k keeper.Keeper, cdc codec.Codec) IBCModule {
@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker of liquidstake module
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.ProcessMatureProxyUnbondings(ctx)
+
+	k.ContinueEpochProcessing(ctx)
+}
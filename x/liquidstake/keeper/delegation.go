@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
 
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -24,6 +26,14 @@ func (k *Keeper) Delegate(ctx sdk.Context, chainID string, amount math.Int, call
 		return nil, sdkerrors.Wrapf(types.ErrUnknownSourceChain, "unknown source chain, chainID: %s", chainID)
 	}
 
+	if sourceChain.Halted {
+		return nil, sdkerrors.Wrapf(types.ErrSourceChainHalted, "chainID: %s", chainID)
+	}
+
+	if !sourceChain.Status.DepositAllowed() {
+		return nil, sdkerrors.Wrapf(types.ErrSourceChainPaused, "chainID: %s, status: %s", chainID, sourceChain.Status)
+	}
+
 	epochInfo, found := k.epochKeeper.GetEpochInfo(ctx, appparams.DelegationEpochIdentifier)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnknownEpoch, "unknown epoch, epoch identifier: %s", appparams.DelegationEpochIdentifier)
@@ -56,31 +66,107 @@ func (k *Keeper) Delegate(ctx sdk.Context, chainID string, amount math.Int, call
 
 	k.SetProxyDelegation(ctx, delegationID, proxyDelegation)
 
+	k.recordUserHistory(ctx, caller.String(), types.UserHistoryDelegate, chainID, currentEpoch,
+		sdk.NewCoin(sourceChain.IbcDenom, amount), sdk.NewCoin(sourceChain.DerivativeDenom, derivativeAmount))
+
 	return proxyDelegation, nil
 }
 
-// ProcessProxyDelegation start liquid stake on source chain with provide delegation records.
+// delegationProcessingStatuses are the statuses of ProxyDelegation which are advanced by the delegation epoch,
+// in the order of the status index. A ProxyDelegation always moves into a status with lower index key or a status
+// which is not processed, so it's never processed twice in an epoch.
+var delegationProcessingStatuses = []types.ProxyDelegationStatus{
+	types.ProxyDelegationPending,
+	types.ProxyDelegationTransferred,
+	types.ProxyDelegationFailed,
+}
+
+// ProcessProxyDelegation start liquid stake on source chain with the ProxyDelegations of the past epochs.
 // This process will continue to advance the status of the ProxyDelegation according to the IBC ack.
-// So here just start and restart the process.
-func (k *Keeper) ProcessProxyDelegation(ctx sdk.Context, curEpochNumber uint64, records []types.ProxyDelegation) {
-	for i := 0; i < len(records); i++ {
-		if curEpochNumber <= records[i].EpochNumber {
+// So here just start and restart the process. The ProxyDelegations are found by the status index and
+// processed in batches, the left ones are processed in the subsequent blocks.
+func (k *Keeper) ProcessProxyDelegation(ctx sdk.Context, curEpochNumber uint64) {
+	cursor := types.ProcessingCursor{Epoch: curEpochNumber}
+
+	k.processProxyDelegationBatch(ctx, &cursor)
+}
+
+// processProxyDelegationBatch process the ProxyDelegations from the cursor within the budget of block.
+func (k *Keeper) processProxyDelegationBatch(ctx sdk.Context, cursor *types.ProcessingCursor) {
+	store := ctx.KVStore(k.storeKey)
+	budget := k.GetParams(ctx).EpochProcessingBudget
+
+	var (
+		ids     []uint64
+		nextKey []byte
+	)
+	for _, status := range delegationProcessingStatuses {
+		statusPrefix := types.GetProxyDelegationStatusPrefix(status)
+		end := storetypes.PrefixEndBytes(statusPrefix)
+
+		start := statusPrefix
+		if bytes.Compare(cursor.NextKey, start) > 0 {
+			start = cursor.NextKey
+		}
+		if bytes.Compare(start, end) >= 0 {
+			continue
+		}
+
+		iterator := store.Iterator(start, end)
+		for ; iterator.Valid(); iterator.Next() {
+			if budget != 0 && uint64(len(ids)) >= budget {
+				nextKey = iterator.Key()
+				break
+			}
+			ids = append(ids, types.ParseProxyDelegationStatusKey(iterator.Key()))
+		}
+		iterator.Close()
+
+		if nextKey != nil {
+			break
+		}
+	}
+
+	pausedChains := make(map[string]bool)
+	for _, id := range ids {
+		delegation, found := k.GetProxyDelegation(ctx, id)
+		if !found || cursor.Epoch <= delegation.EpochNumber {
 			continue
 		}
 
-		switch records[i].Status {
+		// the ProxyDelegation of the source chain which paused all operations is kept until it's resumed.
+		paused, checked := pausedChains[delegation.ChainID]
+		if !checked {
+			sourceChain, found := k.GetSourceChain(ctx, delegation.ChainID)
+			paused = found && !sourceChain.Status.WithdrawAllowed()
+			pausedChains[delegation.ChainID] = paused
+		}
+		if paused {
+			continue
+		}
+
+		switch delegation.Status {
 		case types.ProxyDelegationPending:
-			k.handlePendingProxyDelegation(ctx, records[i])
+			k.handlePendingProxyDelegation(ctx, *delegation)
 		case types.ProxyDelegationFailed:
 			// become transferred, retry delegate next epoch
-			records[i].Status = types.ProxyDelegationTransferred
-			k.SetProxyDelegation(ctx, curEpochNumber, &records[i])
+			delegation.Status = types.ProxyDelegationTransferred
+			k.SetProxyDelegation(ctx, id, delegation)
 		case types.ProxyDelegationTransferred:
-			k.afterProxyDelegationTransfer(ctx, &records[i])
+			k.afterProxyDelegationTransfer(ctx, delegation)
 		default:
 			// do nothing
 		}
 	}
+
+	if nextKey == nil {
+		store.Delete(types.DelegationProcessingCursorKey)
+		return
+	}
+
+	cursor.NextKey = nextKey
+	cursor.Height = ctx.BlockHeight()
+	k.setProcessingCursor(ctx, types.DelegationProcessingCursorKey, cursor)
 }
 
 func (k Keeper) handlePendingProxyDelegation(ctx sdk.Context, delegation types.ProxyDelegation) error {
@@ -228,6 +314,7 @@ func (k Keeper) afterProxyDelegationDone(ctx sdk.Context, delegateCallbackArgs *
 	k.SetProxyDelegation(ctx, delegation.Id, delegation)
 
 	sourceChain.UpdateWithDelegatedValidators(delegateCallbackArgs.Validators)
+	k.recordTokenAmountHostHeight(ctx, sourceChain)
 
 	k.SetSourceChain(ctx, sourceChain)
 
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	appparams "github.com/celinium-network/celinium/app/params"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
 )
 
 func (suite *KeeperTestSuite) TestCreateNewProxyDelegationAtEpochStart() {
@@ -114,3 +115,33 @@ func (suite *KeeperTestSuite) delegationEpoch() *epochtypes.EpochInfo {
 		CurrentEpochStartHeight: suite.controlChain.GetContext().BlockHeight(),
 	}
 }
+
+func (suite *KeeperTestSuite) TestProcessProxyDelegationSkipPausedAll() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	sourceChain := suite.mockSourceChainParams()
+	sourceChain.Status = types.SourceChainPausedAll
+	keeper.SetSourceChain(ctx, sourceChain)
+
+	delegation := types.ProxyDelegation{
+		Id:          100,
+		Coin:        sdk.NewCoin(sourceChain.IbcDenom, sdk.NewInt(1000)),
+		Status:      types.ProxyDelegationFailed,
+		EpochNumber: 1,
+		ChainID:     sourceChain.ChainID,
+	}
+	keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+
+	keeper.ProcessProxyDelegation(ctx, 3)
+	paused, _ := keeper.GetProxyDelegation(ctx, delegation.Id)
+	suite.Equal(types.ProxyDelegationFailed, paused.Status)
+
+	sourceChain.Status = types.SourceChainActive
+	keeper.SetSourceChain(ctx, sourceChain)
+
+	keeper.ProcessProxyDelegation(ctx, 3)
+	resumed, _ := keeper.GetProxyDelegation(ctx, delegation.Id)
+	suite.Equal(types.ProxyDelegationTransferred, resumed.Status)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// validateDerivativeDenom check the derivative denom of the new source chain doesn't collide with
+// the existing supply, ibc denoms or the derivative denom of other source chains.
+func (k Keeper) validateDerivativeDenom(ctx sdk.Context, sourceChain *types.SourceChain) error {
+	denom := sourceChain.DerivativeDenom
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDerivativeDenom, err.Error())
+	}
+
+	if strings.HasPrefix(denom, "ibc/") || denom == sourceChain.IbcDenom || denom == sourceChain.NativeDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidDerivativeDenom, "%s collides with ibc or native denom", denom)
+	}
+
+	if k.bankKeeper.HasSupply(ctx, denom) {
+		return sdkerrors.Wrapf(types.ErrInvalidDerivativeDenom, "%s already has supply", denom)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.SouceChainKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		other := types.SourceChain{}
+		k.cdc.MustUnmarshal(iterator.Value(), &other)
+		if other.DerivativeDenom == denom {
+			return sdkerrors.Wrapf(types.ErrInvalidDerivativeDenom, "%s is used by source chain %s", denom, other.ChainID)
+		}
+	}
+
+	return nil
+}
+
+// setDerivativeDenomMetadata register the bank metadata of derivative token. If the metadata of native
+// token is not provided, the metadata of its ibc denom on this chain is used.
+func (k Keeper) setDerivativeDenomMetadata(ctx sdk.Context, sourceChain *types.SourceChain, native *banktypes.Metadata) error {
+	if native == nil {
+		if ibcMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, sourceChain.IbcDenom); found {
+			native = &ibcMetadata
+		}
+	}
+
+	metadata := sourceChain.DerivativeDenomMetadata(native)
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenomMetadata, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	// the denom of a removed source chain is registered again.
+	ctx.KVStore(k.storeKey).Delete(types.GetDeregisteredDenomKey(sourceChain.DerivativeDenom))
+
+	return nil
+}
+
+// setDerivativeDenomDeregistered mark the derivative denom of the removed source chain as deregistered,
+// the bank metadata of it is kept.
+func (k Keeper) setDerivativeDenomDeregistered(ctx sdk.Context, sourceChain *types.SourceChain) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetDeregisteredDenomKey(sourceChain.DerivativeDenom), []byte(sourceChain.ChainID))
+}
+
+// GetDeregisteredDenomChainID return the chainID of the removed source chain whose derivative denom is denom.
+func (k Keeper) GetDeregisteredDenomChainID(ctx sdk.Context, denom string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetDeregisteredDenomKey(denom))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// UpdateDerivativeDenomMetadata update the bank metadata of the derivative token of source chain.
+func (k Keeper) UpdateDerivativeDenomMetadata(ctx sdk.Context, chainID string, metadata banktypes.Metadata) error {
+	sourceChain, found := k.GetSourceChain(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID: %s", chainID)
+	}
+
+	if metadata.Base != sourceChain.DerivativeDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidDenomMetadata, "base denom must be %s, get %s", sourceChain.DerivativeDenom, metadata.Base)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenomMetadata, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return nil
+}
@@ -0,0 +1,453 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// DeregisterSourceChain mark the source chain as deregistering. The deposits are stopped immediately,
+// all the remaining stake will be undelegated in the undelegation epoch once the processing delegations
+// and unbondings are finished.
+func (k Keeper) DeregisterSourceChain(ctx sdk.Context, chainID string) error {
+	sourceChain, found := k.GetSourceChain(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID: %s", chainID)
+	}
+
+	if sourceChain.Status == types.SourceChainDeregistering {
+		return sdkerrors.Wrapf(types.ErrInvalidSourceChainStatus, "source chain %s is already deregistering", chainID)
+	}
+
+	oldStatus := sourceChain.Status
+	sourceChain.Status = types.SourceChainDeregistering
+	k.SetSourceChain(ctx, sourceChain)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterSourceChain,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, chainID),
+			sdk.NewAttribute(types.AttributeKeyOldStatus, oldStatus.String()),
+		),
+	)
+
+	return nil
+}
+
+// ProcessDeregisteringSourceChains advance the wind down of deregistering source chains. The remaining
+// stake is undelegated in the normal unbonding batch of current epoch, and the source chain is removed
+// after all the derivative tokens are redeemed and claimed.
+func (k Keeper) ProcessDeregisteringSourceChains(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.SouceChainKeyPrefix)
+
+	var sourceChains []*types.SourceChain
+	for ; iterator.Valid(); iterator.Next() {
+		sourceChain := &types.SourceChain{}
+		k.cdc.MustUnmarshal(iterator.Value(), sourceChain)
+		if sourceChain.Status == types.SourceChainDeregistering {
+			sourceChains = append(sourceChains, sourceChain)
+		}
+	}
+	iterator.Close()
+
+	for _, sourceChain := range sourceChains {
+		if sourceChain.WindDown == nil {
+			if sourceChain.Halted || !k.sourceChainAvaiable(ctx, sourceChain) || !k.readyForWindDown(ctx, sourceChain.ChainID, epoch) {
+				continue
+			}
+			k.startWindDown(ctx, sourceChain, epoch)
+			continue
+		}
+
+		if !sourceChain.WindDown.Complete {
+			// the withdrawn token may be received after the withdrawal is acknowledged.
+			k.tryCompleteWindDown(ctx, sourceChain)
+			continue
+		}
+
+		if k.bankKeeper.GetSupply(ctx, sourceChain.DerivativeDenom).Amount.IsPositive() ||
+			k.hasUnclaimedUserUnbonding(ctx, sourceChain.ChainID) {
+			continue
+		}
+
+		k.removeSourceChain(ctx, sourceChain, epoch)
+	}
+}
+
+// readyForWindDown return true if no delegation of source chain is processing, and no unbonding
+// except the pending unbonding of current epoch is waiting for undelegating on source chain.
+func (k Keeper) readyForWindDown(ctx sdk.Context, chainID string, epoch uint64) bool {
+	if processingAmount, _ := k.GetProxyDelegationProcessingAmount(ctx, chainID); processingAmount.IsPositive() {
+		return false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.EpochUnbondingsPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epochProxyUnbondings := types.EpochProxyUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &epochProxyUnbondings)
+		if epochProxyUnbondings.Epoch == epoch {
+			continue
+		}
+
+		for _, unbonding := range epochProxyUnbondings.Unbondings {
+			if unbonding.ChainID != chainID {
+				continue
+			}
+			switch unbonding.Status {
+			case types.ProxyUnbondingPending, types.ProxyUnbondingStart, types.ProxyUnbondingStartFailed:
+				return false
+			default:
+			}
+		}
+	}
+
+	return true
+}
+
+// startWindDown add all the remaining stake of source chain into the ProxyUnbonding of current epoch.
+func (k Keeper) startWindDown(ctx sdk.Context, sourceChain *types.SourceChain, epoch uint64) {
+	epochProxyUnbondings, found := k.GetEpochProxyUnboundings(ctx, epoch)
+	if !found {
+		epochProxyUnbondings = k.CreateProxyUnbondingForEpoch(ctx, epoch)
+	}
+
+	chainProxyUnbondingIndex := -1
+	pendingAmount := math.ZeroInt()
+	for i, unbonding := range epochProxyUnbondings.Unbondings {
+		if unbonding.ChainID == sourceChain.ChainID {
+			chainProxyUnbondingIndex = i
+			pendingAmount = unbonding.RedeemNativeToken.Amount
+		}
+	}
+
+	amount := sourceChain.StakedAmount.Sub(pendingAmount)
+	if amount.IsNegative() {
+		amount = math.ZeroInt()
+	}
+
+	sourceChain.WindDown = &types.WindDown{
+		Epoch:        epoch,
+		Amount:       amount,
+		RewardAmount: math.ZeroInt(),
+	}
+
+	if amount.IsPositive() {
+		if chainProxyUnbondingIndex == -1 {
+			epochProxyUnbondings.Unbondings = append(epochProxyUnbondings.Unbondings, types.ProxyUnbonding{
+				ChainID:                sourceChain.ChainID,
+				BurnedDerivativeAmount: sdk.ZeroInt(),
+				RedeemNativeToken:      sdk.NewCoin(sourceChain.NativeDenom, amount),
+				UserUnbondingIds:       []string{},
+			})
+		} else {
+			unbonding := &epochProxyUnbondings.Unbondings[chainProxyUnbondingIndex]
+			unbonding.RedeemNativeToken = unbonding.RedeemNativeToken.AddAmount(amount)
+		}
+		k.SetEpochProxyUnboundings(ctx, epochProxyUnbondings)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStartWindDown,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, sourceChain.ChainID),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyUnbondAmt, amount.String()),
+		),
+	)
+
+	if amount.IsZero() {
+		// nothing is staked on source chain, there is nothing to withdraw.
+		sourceChain.WindDown.Withdrawn = true
+		k.SetSourceChain(ctx, sourceChain)
+		k.tryCompleteWindDown(ctx, sourceChain)
+		return
+	}
+
+	k.SetSourceChain(ctx, sourceChain)
+}
+
+// tryCompleteWindDown complete the wind down once the withdrawal is acknowledged, all the unbondings of
+// source chain are done and the withdrawn token is received by the delegate account.
+func (k Keeper) tryCompleteWindDown(ctx sdk.Context, sourceChain *types.SourceChain) {
+	windDown := sourceChain.WindDown
+	if windDown == nil || windDown.Complete || !windDown.Withdrawn {
+		return
+	}
+
+	if k.hasUnfinishedProxyUnbonding(ctx, sourceChain.ChainID) {
+		return
+	}
+
+	available, err := k.windDownBalance(ctx, sourceChain)
+	if err != nil {
+		return
+	}
+
+	expected := windDown.Amount
+	if !windDown.RewardAmount.IsNil() {
+		expected = expected.Add(windDown.RewardAmount)
+	}
+	if available.LT(expected) {
+		return
+	}
+
+	k.completeWindDown(ctx, sourceChain, available)
+}
+
+// hasUnfinishedProxyUnbonding return true if an unbonding of source chain is not withdrawn yet.
+func (k Keeper) hasUnfinishedProxyUnbonding(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.EpochUnbondingsPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epochProxyUnbondings := types.EpochProxyUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &epochProxyUnbondings)
+		for _, unbonding := range epochProxyUnbondings.Unbondings {
+			if unbonding.ChainID == chainID && unbonding.Status != types.ProxyUnbondingDone {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// windDownBalance return the balance of delegate account which doesn't belong to the unclaimed UserUnbondings,
+// it's the withdrawn token of the remaining stake and the rewards.
+func (k Keeper) windDownBalance(ctx sdk.Context, sourceChain *types.SourceChain) (math.Int, error) {
+	delegateAddr, err := sdk.AccAddressFromBech32(sourceChain.DelegateAddress)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	available := k.bankKeeper.GetBalance(ctx, delegateAddr, sourceChain.IbcDenom).Amount
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetUserUnbondingPrefix(sourceChain.ChainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		userUnbonding := types.UserUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &userUnbonding)
+		if userUnbonding.CliamStatus != types.UserUnbondingComplete {
+			available = available.Sub(userUnbonding.RedeemCoin.Amount)
+		}
+	}
+
+	if available.IsNegative() {
+		available = math.ZeroInt()
+	}
+
+	return available, nil
+}
+
+// completeWindDown fix the final redeem rate of source chain by the withdrawn token.
+func (k Keeper) completeWindDown(ctx sdk.Context, sourceChain *types.SourceChain, withdrawn math.Int) {
+	oldRate := sourceChain.Redemptionratio
+
+	rate := sdk.OneDec()
+	supply := k.bankKeeper.GetSupply(ctx, sourceChain.DerivativeDenom).Amount
+	if supply.IsPositive() {
+		rate = sdk.NewDecFromInt(withdrawn).Quo(sdk.NewDecFromInt(supply))
+	}
+
+	sourceChain.WindDown.Complete = true
+	sourceChain.Redemptionratio = rate
+	k.SetSourceChain(ctx, sourceChain)
+
+	k.recordRedeemRate(ctx, sourceChain.ChainID, rate, k.GetParams(ctx).RedeemRateHistoryRetention)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteWindDown,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, sourceChain.ChainID),
+			sdk.NewAttribute(types.AttributeKeyOldRedeemRate, oldRate.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemRate, rate.String()),
+		),
+	)
+}
+
+// redeemAfterWindDown burn the derivative token and create a claimable UserUnbonding at the final
+// redeem rate, the native token has been withdrawn to the delegate account.
+func (k Keeper) redeemAfterWindDown(ctx sdk.Context, sourceChain *types.SourceChain, epoch uint64,
+	amount math.Int, delegator sdk.AccAddress,
+) (*types.UserUnbonding, error) {
+	burnedCoins := sdk.Coins{sdk.NewCoin(sourceChain.DerivativeDenom, amount)}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.ModuleName, burnedCoins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnedCoins); err != nil {
+		return nil, err
+	}
+
+	receiveAmount := sdk.NewDecFromInt(amount).Mul(sourceChain.Redemptionratio).TruncateInt()
+	delegatorAddr := delegator.String()
+
+	userUnbonding := types.UserUnbonding{
+		ID:          types.AssembleUserUnbondingID(sourceChain.ChainID, epoch, delegatorAddr),
+		ChainID:     sourceChain.ChainID,
+		Epoch:       epoch,
+		Delegator:   delegatorAddr,
+		RedeemCoin:  sdk.NewCoin(sourceChain.IbcDenom, receiveAmount),
+		CliamStatus: types.UserUnbondingClaimable,
+	}
+
+	k.SetUserUnbonding(ctx, &userUnbonding)
+
+	k.recordUserHistory(ctx, delegatorAddr, types.UserHistoryUndelegate, sourceChain.ChainID, epoch,
+		burnedCoins[0], userUnbonding.RedeemCoin)
+
+	return &userUnbonding, nil
+}
+
+// hasUnclaimedUserUnbonding return true if there is a UserUnbonding of source chain not claimed.
+func (k Keeper) hasUnclaimedUserUnbonding(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetUserUnbondingPrefix(chainID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		userUnbonding := types.UserUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &userUnbonding)
+		if userUnbonding.CliamStatus != types.UserUnbondingComplete {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeSourceChain delete the source chain and all the records which belong to it.
+func (k Keeper) removeSourceChain(ctx sdk.Context, sourceChain *types.SourceChain, epoch uint64) {
+	chainID := sourceChain.ChainID
+	store := ctx.KVStore(k.storeKey)
+
+	var (
+		deleteKeys    [][]byte
+		delegationIDs []uint64
+	)
+
+	// per epoch ProxyDelegation ID keys and the ProxyDelegations
+	chainIDSuffix := types.GetChainProxyDelegationIDForEpochKey(0, []byte(chainID))[len(types.ProxyDelegationIDPrefix)+8:]
+	idIterator := storetypes.KVStorePrefixIterator(store, types.ProxyDelegationIDPrefix)
+	for ; idIterator.Valid(); idIterator.Next() {
+		key := idIterator.Key()
+		if string(key[len(types.ProxyDelegationIDPrefix)+8:]) != string(chainIDSuffix) {
+			continue
+		}
+		deleteKeys = append(deleteKeys, key)
+		delegationIDs = append(delegationIDs, sdk.BigEndianToUint64(idIterator.Value()))
+	}
+	idIterator.Close()
+
+	// the UserUnbondings, all of them have been claimed.
+	userUnbondingIterator := storetypes.KVStorePrefixIterator(store, types.GetUserUnbondingPrefix(chainID))
+	for ; userUnbondingIterator.Valid(); userUnbondingIterator.Next() {
+		deleteKeys = append(deleteKeys, userUnbondingIterator.Key())
+	}
+	userUnbondingIterator.Close()
+
+	// the redeem rate history
+	historyIterator := storetypes.KVStorePrefixIterator(store, types.GetRedeemRateHistoryPrefix([]byte(chainID)))
+	for ; historyIterator.Valid(); historyIterator.Next() {
+		deleteKeys = append(deleteKeys, historyIterator.Key())
+	}
+	historyIterator.Close()
+
+	for _, key := range deleteKeys {
+		store.Delete(key)
+	}
+
+	for _, id := range delegationIDs {
+		k.deleteProxyDelegation(ctx, id)
+	}
+
+	// remove the ProxyUnbondings of source chain, and the past EpochProxyUnbonding which becomes empty.
+	var epochProxyUnbondings []types.EpochProxyUnbonding
+	for _, prefix := range [][]byte{types.EpochUnbondingsPrefix, types.EpochUnbondingsArchivePrefix} {
+		unbondingIterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; unbondingIterator.Valid(); unbondingIterator.Next() {
+			epochProxyUnbonding := types.EpochProxyUnbonding{}
+			k.cdc.MustUnmarshal(unbondingIterator.Value(), &epochProxyUnbonding)
+			epochProxyUnbondings = append(epochProxyUnbondings, epochProxyUnbonding)
+		}
+		unbondingIterator.Close()
+	}
+
+	for i := range epochProxyUnbondings {
+		epochProxyUnbonding := &epochProxyUnbondings[i]
+
+		unbondings := make([]types.ProxyUnbonding, 0, len(epochProxyUnbonding.Unbondings))
+		for _, unbonding := range epochProxyUnbonding.Unbondings {
+			if unbonding.ChainID != chainID {
+				unbondings = append(unbondings, unbonding)
+			}
+		}
+
+		switch {
+		case len(unbondings) == 0 && epochProxyUnbonding.Epoch < epoch:
+			k.deleteEpochProxyUnboundings(ctx, epochProxyUnbonding.Epoch)
+		case len(unbondings) != len(epochProxyUnbonding.Unbondings):
+			epochProxyUnbonding.Unbondings = unbondings
+			k.SetEpochProxyUnboundings(ctx, epochProxyUnbonding)
+		default:
+		}
+	}
+
+	k.removeSourceChainCallbacks(ctx, sourceChain)
+	k.setDerivativeDenomDeregistered(ctx, sourceChain)
+
+	store.Delete(types.GetHostValidatorSnapshotKey([]byte(chainID)))
+	store.Delete(types.GetSourceChainKey([]byte(chainID)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveSourceChain,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, chainID),
+		),
+	)
+}
+
+// removeSourceChainCallbacks delete the pending callbacks of the interchain accounts and the transfer channel of
+// source chain, and stop routing the packets of the interchain accounts to this module.
+func (k Keeper) removeSourceChainCallbacks(ctx sdk.Context, sourceChain *types.SourceChain) {
+	ports := map[string]bool{}
+	for _, owner := range []string{sourceChain.DelegateAddress, sourceChain.WithdrawAddress} {
+		portID, err := icatypes.NewControllerPortID(owner)
+		if err != nil {
+			continue
+		}
+		ports[portID] = true
+		k.icaCtlKeeper.DeleteMiddlewareEnabled(ctx, portID, sourceChain.ConnectionID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.IBCCallbackPrefix)
+
+	var deleteKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		channel, port := types.ParseIBCCallbackKey(iterator.Key())
+		if ports[port] || (port == transfertypes.PortID && channel == sourceChain.TransferChannelID) {
+			deleteKeys = append(deleteKeys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range deleteKeys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func (suite *KeeperTestSuite) TestDeregisterSourceChain() {
+	srcChainParams := suite.mockSourceChainParams()
+	delegationEpochInfo := suite.delegationEpoch()
+	suite.setSourceChainAndEpoch(srcChainParams, delegationEpochInfo)
+
+	testCoin := suite.testCoin
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctlChainUserAccAddr := suite.controlChain.SenderAccount.GetAddress()
+	ctlChainUserAddr := ctlChainUserAccAddr.String()
+
+	ctx := suite.controlChain.GetContext()
+	_, err := ctlChainApp.LiquidStakeKeeper.Delegate(ctx, srcChainParams.ChainID, testCoin.Amount, ctlChainUserAccAddr)
+	suite.NoError(err)
+
+	suite.advanceEpochAndRelayIBC(delegationEpochInfo)
+
+	unbondingEpochInfo := suite.unbondEpoch()
+	ctx = suite.controlChain.GetContext()
+	ctlChainApp.EpochsKeeper.SetEpochInfo(ctx, *unbondingEpochInfo)
+	suite.controlChain.Coordinator.IncrementTimeBy(unbondingEpochInfo.Duration)
+	suite.transferPath.EndpointA.UpdateClient()
+
+	ctx = suite.controlChain.GetContext()
+	msgServer := keeper.NewMsgServerImpl(&ctlChainApp.LiquidStakeKeeper)
+	_, err = msgServer.DeregisterSourceChain(ctx, &types.MsgDeregisterSourceChain{
+		Authority: ctlChainUserAddr,
+		ChainID:   srcChainParams.ChainID,
+	})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.DeregisterSourceChain(ctx, &types.MsgDeregisterSourceChain{
+		Authority: ctlChainApp.LiquidStakeKeeper.GetAuthority(),
+		ChainID:   srcChainParams.ChainID,
+	})
+	suite.NoError(err)
+
+	_, err = ctlChainApp.LiquidStakeKeeper.Delegate(ctx, srcChainParams.ChainID, testCoin.Amount, ctlChainUserAccAddr)
+	suite.ErrorIs(err, types.ErrSourceChainPaused)
+
+	_, err = ctlChainApp.LiquidStakeKeeper.SetSourceChainStatus(ctx, srcChainParams.ChainID, types.SourceChainActive)
+	suite.ErrorIs(err, types.ErrInvalidSourceChainStatus)
+
+	// all the stake is undelegated in the unbonding of current epoch.
+	epochInfo, _ := ctlChainApp.EpochsKeeper.GetEpochInfo(ctx, unbondingEpochInfo.Identifier)
+	unbondingEpoch := uint64(epochInfo.CurrentEpoch)
+	ctlChainApp.LiquidStakeKeeper.ProcessDeregisteringSourceChains(ctx, unbondingEpoch)
+	sourceChain, _ := ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
+	suite.NotNil(sourceChain.WindDown)
+	suite.True(sourceChain.WindDown.Amount.Equal(testCoin.Amount))
+	suite.False(sourceChain.WindDown.Complete)
+
+	_, err = ctlChainApp.LiquidStakeKeeper.Undelegate(ctx, srcChainParams.ChainID, testCoin.Amount, ctlChainUserAccAddr)
+	suite.ErrorIs(err, types.ErrSourceChainWindingDown)
+
+	nextBlockTime := suite.advanceToNextEpoch(unbondingEpochInfo)
+	_, nextBlockBeginRes := nextBlockWithRes(suite.controlChain, nextBlockTime)
+	nextBlockWithRes(suite.sourceChain, nextBlockTime)
+
+	suite.controlChain.NextBlock()
+	suite.transferPath.EndpointA.UpdateClient()
+	suite.relayIBCPacketFromCtlToSrc(nextBlockBeginRes.Events, ctlChainUserAddr)
+
+	suite.WaitForUnbondingComplete(srcChainParams, unbondingEpoch)
+
+	ctx = suite.controlChain.GetContext()
+	sourceChain, _ = ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
+	suite.True(sourceChain.WindDown.Withdrawn)
+	suite.True(sourceChain.WindDown.Complete)
+	suite.True(sourceChain.StakedAmount.IsZero())
+
+	// the final redeem rate is computed from the withdrawn token, which includes the rewards of wind down.
+	withdrawn := sourceChain.WindDown.Amount.Add(sourceChain.WindDown.RewardAmount)
+	delegateAddr := sdk.MustAccAddressFromBech32(sourceChain.DelegateAddress)
+	suite.True(ctlChainApp.BankKeeper.GetBalance(ctx, delegateAddr, sourceChain.IbcDenom).Amount.Equal(withdrawn))
+	supply := ctlChainApp.BankKeeper.GetSupply(ctx, srcChainParams.DerivativeDenom).Amount
+	suite.True(sourceChain.Redemptionratio.Equal(sdk.NewDecFromInt(withdrawn).Quo(sdk.NewDecFromInt(supply))))
+	suite.True(sourceChain.Redemptionratio.GTE(sdk.OneDec()))
+
+	// redeem at the final redeem rate and claim it immediately.
+	userUnbonding, err := ctlChainApp.LiquidStakeKeeper.Undelegate(ctx, srcChainParams.ChainID, testCoin.Amount, ctlChainUserAccAddr)
+	suite.NoError(err)
+	suite.Equal(types.UserUnbondingClaimable, userUnbonding.CliamStatus)
+	suite.True(ctlChainApp.BankKeeper.GetSupply(ctx, srcChainParams.DerivativeDenom).Amount.IsZero())
+
+	_, err = ctlChainApp.LiquidStakeKeeper.ClaimUnbonding(ctx, ctlChainUserAccAddr, userUnbonding.Epoch, srcChainParams.ChainID)
+	suite.NoError(err)
+
+	ctlChainApp.LiquidStakeKeeper.ProcessDeregisteringSourceChains(ctx, userUnbonding.Epoch)
+
+	_, found := ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
+	suite.False(found)
+	_, found = ctlChainApp.LiquidStakeKeeper.GetChianProxyDelegationID(ctx, srcChainParams.ChainID, uint64(delegationEpochInfo.CurrentEpoch))
+	suite.False(found)
+	_, found = ctlChainApp.LiquidStakeKeeper.GetUserUnbondingID(ctx, userUnbonding.ID)
+	suite.False(found)
+	_, found = ctlChainApp.BankKeeper.GetDenomMetaData(ctx, srcChainParams.DerivativeDenom)
+	suite.True(found)
+	deregisteredChainID, found := ctlChainApp.LiquidStakeKeeper.GetDeregisteredDenomChainID(ctx, srcChainParams.DerivativeDenom)
+	suite.True(found)
+	suite.Equal(srcChainParams.ChainID, deregisteredChainID)
+
+	portID, err := icatypes.NewControllerPortID(sourceChain.DelegateAddress)
+	suite.NoError(err)
+	suite.False(ctlChainApp.ICAControllerKeeper.IsMiddlewareEnabled(ctx, portID, sourceChain.ConnectionID))
+}
@@ -1,49 +1,86 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	appparams "github.com/celinium-network/celinium/app/params"
 	epochstypes "github.com/celinium-network/celinium/x/epochs/types"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
 )
 
 type Hooks struct {
 	k Keeper
 }
 
-// AfterEpochEnd implements types.EpochHooks
-func (Hooks) AfterEpochEnd(_ sdk.Context, _ string, _ int64) {
+// EpochSubscriptions implements epochstypes.EpochSubscriber
+func (h Hooks) EpochSubscriptions() []epochstypes.EpochSubscription {
+	return []epochstypes.EpochSubscription{
+		{Identifier: appparams.DelegationEpochIdentifier, BeforeEpochStart: h.beforeDelegationEpochStart},
+		{Identifier: appparams.UndelegationEpochIdentifier, BeforeEpochStart: h.beforeUndelegationEpochStart},
+		{Identifier: appparams.ReinvestEpochIdentifier, BeforeEpochStart: h.beforeReinvestEpochStart},
+	}
 }
 
-// BeforeEpochStart implements types.EpochHooks
-func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+func (h Hooks) beforeDelegationEpochStart(ctx sdk.Context, epochNumber int64) {
 	if epochNumber < 0 {
 		return
 	}
 
 	epoch := uint64(epochNumber)
 
-	switch epochIdentifier {
-	case appparams.DelegationEpochIdentifier:
-		h.k.CreateProxyDelegationForEpoch(ctx, epoch)
+	h.k.ApplyValidatorSelectionPolicy(ctx)
+
+	h.k.CreateProxyDelegationForEpoch(ctx, epoch)
 
-		proxyDelegations := h.k.GetAllProxyDelegation(ctx)
-		h.k.ProcessProxyDelegation(ctx, epoch, proxyDelegations)
+	h.k.ProcessProxyDelegation(ctx, epoch)
 
-		h.k.UpdateRedeemRate(ctx, proxyDelegations)
-	case appparams.UndelegationEpochIdentifier:
-		h.k.CreateProxyUnbondingForEpoch(ctx, epoch)
+	h.k.UpdateRedeemRate(ctx)
 
-		h.k.ProcessUndelegationEpoch(ctx, epoch)
-	case appparams.ReinvestEpochIdentifier:
-		h.k.SetDistriWithdrawAddress(ctx)
+	h.k.PruneProxyDelegations(ctx, epoch)
+}
 
-		h.k.StartReinvest(ctx)
-	default:
+func (h Hooks) beforeUndelegationEpochStart(ctx sdk.Context, epochNumber int64) {
+	if epochNumber < 0 {
+		return
 	}
+
+	epoch := uint64(epochNumber)
+
+	h.k.CreateProxyUnbondingForEpoch(ctx, epoch)
+
+	h.k.ProcessDeregisteringSourceChains(ctx, epoch)
+
+	h.k.ProcessUndelegationEpoch(ctx, epoch)
+
+	h.k.PruneEpochProxyUnbondings(ctx, epoch)
+}
+
+func (h Hooks) beforeReinvestEpochStart(ctx sdk.Context, _ int64) {
+	h.k.SetDistriWithdrawAddress(ctx)
+
+	h.k.StartReinvest(ctx)
+}
+
+// ModuleName implements epochstypes.EpochSubscriber
+func (Hooks) ModuleName() string {
+	return types.ModuleName
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+// RequiredEpochs implements epochstypes.EpochsRequirer
+func (Hooks) RequiredEpochs() []epochstypes.RequiredEpoch {
+	return []epochstypes.RequiredEpoch{
+		{Identifier: appparams.DelegationEpochIdentifier, DefaultDuration: time.Hour},
+		{Identifier: appparams.UndelegationEpochIdentifier, DefaultDuration: time.Hour * 24},
+		{Identifier: appparams.ReinvestEpochIdentifier, DefaultDuration: time.Hour},
+	}
+}
+
+var (
+	_ epochstypes.EpochSubscriber = Hooks{}
+	_ epochstypes.EpochsRequirer  = Hooks{}
+)
 
 func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
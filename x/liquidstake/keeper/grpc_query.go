@@ -9,7 +9,9 @@ import (
 
 	appparams "github.com/celinium-network/celinium/app/params"
 	"github.com/celinium-network/celinium/x/liquidstake/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type Querier struct {
@@ -133,3 +135,119 @@ func (k Querier) UserUnbonding(goCtx context.Context, req *types.QueryUserUnbond
 		UserUnbondings: userUnbondings,
 	}, nil
 }
+
+// HostValidatorSnapshot implements types.QueryServer
+func (k Querier) HostValidatorSnapshot(goCtx context.Context, req *types.QueryHostValidatorSnapshotRequest) (*types.QueryHostValidatorSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	snapshot, found := k.GetHostValidatorSnapshot(ctx, req.ChainID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "snapshot not found for chainID %s", req.ChainID)
+	}
+
+	return &types.QueryHostValidatorSnapshotResponse{
+		Snapshot: snapshot,
+	}, nil
+}
+
+// Params implements types.QueryServer
+func (k Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
+
+// RedeemRateHistory implements types.QueryServer
+func (k Querier) RedeemRateHistory(goCtx context.Context, req *types.QueryRedeemRateHistoryRequest) (*types.QueryRedeemRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainID == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty chainID")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var records []types.RedeemRateRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRedeemRateHistoryPrefix([]byte(req.ChainID)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.RedeemRateRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedeemRateHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SourceChainStatus implements types.QueryServer
+func (k Querier) SourceChainStatus(goCtx context.Context, req *types.QuerySourceChainStatusRequest) (*types.QuerySourceChainStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sourceChain, found := k.GetSourceChain(ctx, req.ChainID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "unknown chainID %s", req.ChainID)
+	}
+
+	return &types.QuerySourceChainStatusResponse{
+		Status: sourceChain.Status.String(),
+		Halted: sourceChain.Halted,
+	}, nil
+}
+
+// UserHistory implements types.QueryServer
+func (k Querier) UserHistory(goCtx context.Context, req *types.QueryUserHistoryRequest) (*types.QueryUserHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Delegator == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var entries []types.UserHistoryEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetUserHistoryPrefix(req.Delegator))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var entry types.UserHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	appparams "github.com/celinium-network/celinium/app/params"
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
 	"github.com/celinium-network/celinium/x/liquidstake/types"
 )
 
@@ -304,3 +308,74 @@ func (suite *KeeperTestSuite) TestGRPCQueryUserUnbonding() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryUserHistory() {
+	sourceChainParams := suite.mockSourceChainParams()
+	suite.setSourceChainAndEpoch(sourceChainParams, suite.delegationEpoch())
+
+	ctx := suite.controlChain.GetContext()
+	user := suite.controlChain.SenderAccount.GetAddress()
+	controlChainApp := getCeliniumApp(suite.controlChain)
+
+	ibcCoins := sdk.Coins{sdk.NewCoin(sourceChainParams.IbcDenom, suite.testCoin.Amount.MulRaw(2))}
+	suite.Require().NoError(controlChainApp.BankKeeper.MintCoins(ctx, types.ModuleName, ibcCoins))
+	suite.Require().NoError(controlChainApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, user, ibcCoins))
+
+	for i := 0; i < 2; i++ {
+		_, err := controlChainApp.LiquidStakeKeeper.Delegate(ctx, sourceChainParams.ChainID, suite.testCoin.Amount, user)
+		suite.Require().NoError(err)
+	}
+
+	querier := keeper.Querier{Keeper: controlChainApp.LiquidStakeKeeper}
+	res, err := querier.UserHistory(sdk.WrapSDKContext(ctx), &types.QueryUserHistoryRequest{
+		Delegator:  user.String(),
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Equal(types.UserHistoryDelegate, res.Entries[0].Action)
+	suite.Require().Equal(sourceChainParams.ChainID, res.Entries[0].ChainID)
+	suite.Require().Equal(suite.testCoin.Amount, res.Entries[0].Amount.Amount)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = querier.UserHistory(sdk.WrapSDKContext(ctx), &types.QueryUserHistoryRequest{
+		Delegator:  user.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 1)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	_, err = querier.UserHistory(sdk.WrapSDKContext(ctx), &types.QueryUserHistoryRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUserHistoryLimit() {
+	sourceChainParams := suite.mockSourceChainParams()
+	suite.setSourceChainAndEpoch(sourceChainParams, suite.delegationEpoch())
+
+	ctx := suite.controlChain.GetContext()
+	user := suite.controlChain.SenderAccount.GetAddress()
+	controlChainApp := getCeliniumApp(suite.controlChain)
+
+	params := controlChainApp.LiquidStakeKeeper.GetParams(ctx)
+	params.MaxUserHistoryEntries = 2
+	suite.Require().NoError(controlChainApp.LiquidStakeKeeper.SetParams(ctx, params))
+
+	ibcCoins := sdk.Coins{sdk.NewCoin(sourceChainParams.IbcDenom, sdk.NewInt(6))}
+	suite.Require().NoError(controlChainApp.BankKeeper.MintCoins(ctx, types.ModuleName, ibcCoins))
+	suite.Require().NoError(controlChainApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, user, ibcCoins))
+
+	for i := int64(1); i <= 3; i++ {
+		_, err := controlChainApp.LiquidStakeKeeper.Delegate(ctx, sourceChainParams.ChainID, sdk.NewInt(i), user)
+		suite.Require().NoError(err)
+	}
+
+	// the oldest entry is deleted.
+	querier := keeper.Querier{Keeper: controlChainApp.LiquidStakeKeeper}
+	res, err := querier.UserHistory(sdk.WrapSDKContext(ctx), &types.QueryUserHistoryRequest{Delegator: user.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 2)
+	suite.Require().Equal(sdk.NewInt(2), res.Entries[0].Amount.Amount)
+	suite.Require().Equal(sdk.NewInt(3), res.Entries[1].Amount.Amount)
+}
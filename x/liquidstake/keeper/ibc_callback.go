@@ -77,3 +77,25 @@ func (k Keeper) HandleIBCAcknowledgement(ctx sdk.Context, packet *channeltypes.P
 
 	return nil
 }
+
+// HandleIBCTimeout handles the timeout of the packet which has a callback, the callbacks without
+// a timeout handler are left untouched.
+func (k Keeper) HandleIBCTimeout(ctx sdk.Context, packet *channeltypes.Packet) error {
+	callback, found := k.GetCallBack(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	handler, ok := timeoutHandlerRegistry[callback.CallType]
+	if !ok {
+		return nil
+	}
+
+	if err := handler(&k, ctx, callback); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Handle IBC timeout error: %v", err))
+		return nil
+	}
+	k.RemoveCallBack(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+
+	return nil
+}
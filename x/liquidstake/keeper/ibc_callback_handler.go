@@ -19,6 +19,10 @@ type callbackHandler func(*Keeper, sdk.Context, *types.IBCCallback, []byte) erro
 
 var callbackHandlerRegistry map[types.CallType]callbackHandler
 
+type timeoutHandler func(*Keeper, sdk.Context, *types.IBCCallback) error
+
+var timeoutHandlerRegistry map[types.CallType]timeoutHandler
+
 func init() {
 	callbackHandlerRegistry = make(map[types.CallType]callbackHandler)
 
@@ -29,6 +33,10 @@ func init() {
 	callbackHandlerRegistry[types.WithdrawDelegateRewardCall] = withdrawDelegateRewardCallbackHandler
 	callbackHandlerRegistry[types.TransferRewardCall] = transferRewardCallbackHandler
 	callbackHandlerRegistry[types.SetWithdrawAddressCall] = setWithdrawAddressCallbackHandler
+
+	timeoutHandlerRegistry = make(map[types.CallType]timeoutHandler)
+
+	timeoutHandlerRegistry[types.WithdrawUnbondCall] = withdrawUnbondTimeoutHandler
 }
 
 func delegateTransferCallbackHandler(k *Keeper, ctx sdk.Context, callback *types.IBCCallback, acknowledgement []byte) error {
@@ -115,7 +123,17 @@ func undelegateCallbackHandler(k *Keeper, ctx sdk.Context, callback *types.IBCCa
 
 	respLen := 0
 	var completeTime time.Time
+	var rewards sdk.Coins
 	for _, r := range txMsgData.MsgResponses {
+		if strings.Contains(r.TypeUrl, "MsgWithdrawDelegatorRewardResponse") {
+			response := distrtypes.MsgWithdrawDelegatorRewardResponse{}
+			if err := k.cdc.Unmarshal(r.Value, &response); err != nil {
+				return err
+			}
+			rewards = rewards.Add(response.Amount...)
+			continue
+		}
+
 		if !strings.Contains(r.TypeUrl, "MsgUndelegateResponse") {
 			continue
 		}
@@ -144,6 +162,7 @@ func undelegateCallbackHandler(k *Keeper, ctx sdk.Context, callback *types.IBCCa
 		}
 		epochUnbondings.Unbondings[i].UnbondTime = uint64(completeTime.UnixNano())
 		epochUnbondings.Unbondings[i].Status = types.ProxyUnbondingWaitting
+		k.InsertProxyUnbondingQueue(ctx, unbondCallArgs.Epoch, unbondCallArgs.ChainID, completeTime)
 
 		// update sourcechain
 		sourceChain, found := k.GetSourceChain(ctx, unbondCallArgs.ChainID)
@@ -152,10 +171,19 @@ func undelegateCallbackHandler(k *Keeper, ctx sdk.Context, callback *types.IBCCa
 		}
 
 		sourceChain.UpdateWithUnbondingValidators(unbondCallArgs.Validators)
+		k.recordTokenAmountHostHeight(ctx, sourceChain)
 
-		burnedCoin := sdk.Coins{sdk.NewCoin(sourceChain.DerivativeDenom, epochUnbondings.Unbondings[i].BurnedDerivativeAmount)}
-		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnedCoin); err != nil {
-			return err
+		// the rewards of wind down are withdrawn in the same transaction as the undelegation.
+		if sourceChain.WindDown != nil && sourceChain.WindDown.Epoch == unbondCallArgs.Epoch {
+			sourceChain.WindDown.RewardAmount = rewards.AmountOf(sourceChain.NativeDenom)
+		}
+
+		// the unbonding for wind down of source chain may not burn any derivative token.
+		if epochUnbondings.Unbondings[i].BurnedDerivativeAmount.IsPositive() {
+			burnedCoin := sdk.Coins{sdk.NewCoin(sourceChain.DerivativeDenom, epochUnbondings.Unbondings[i].BurnedDerivativeAmount)}
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnedCoin); err != nil {
+				return err
+			}
 		}
 		k.SetSourceChain(ctx, sourceChain)
 	}
@@ -166,18 +194,22 @@ func undelegateCallbackHandler(k *Keeper, ctx sdk.Context, callback *types.IBCCa
 }
 
 func withdrawUnbondCallbackHandler(k *Keeper, ctx sdk.Context, callback *types.IBCCallback, acknowledgement []byte) error {
+	var unbondCallArgs types.UnbondCallbackArgs
+	k.cdc.MustUnmarshal([]byte(callback.Args), &unbondCallArgs)
+
 	res, err := GetResultFromAcknowledgement(acknowledgement)
 	if err != nil {
-		return err
+		// none of the transfers is executed on the source chain, so the withdrawal is retried.
+		k.Logger(ctx).Error(fmt.Sprintf("withdraw unbonding failed, chainID %s epoch %d, error: %s",
+			unbondCallArgs.ChainID, unbondCallArgs.Epoch, err))
+		k.revertProxyUnbondingWithdraw(ctx, unbondCallArgs.Epoch, unbondCallArgs.ChainID)
+		return nil
 	}
 
 	var txMsgData sdk.TxMsgData
 	if err := k.cdc.Unmarshal(res, &txMsgData); err != nil {
 		return err
 	}
-
-	var unbondCallArgs types.UnbondCallbackArgs
-	k.cdc.MustUnmarshal([]byte(callback.Args), &unbondCallArgs)
 	epochUnbondings, found := k.GetEpochProxyUnboundings(ctx, unbondCallArgs.Epoch)
 	if !found {
 		return nil
@@ -201,6 +233,22 @@ func withdrawUnbondCallbackHandler(k *Keeper, ctx sdk.Context, callback *types.I
 		}
 	}
 	k.SetEpochProxyUnboundings(ctx, epochUnbondings)
+
+	sourceChain, found := k.GetSourceChain(ctx, unbondCallArgs.ChainID)
+	if found && sourceChain.WindDown != nil && !sourceChain.WindDown.Complete &&
+		sourceChain.WindDown.Epoch == unbondCallArgs.Epoch {
+		sourceChain.WindDown.Withdrawn = true
+		k.SetSourceChain(ctx, sourceChain)
+		k.tryCompleteWindDown(ctx, sourceChain)
+	}
+	return nil
+}
+
+func withdrawUnbondTimeoutHandler(k *Keeper, ctx sdk.Context, callback *types.IBCCallback) error {
+	var unbondCallArgs types.UnbondCallbackArgs
+	k.cdc.MustUnmarshal([]byte(callback.Args), &unbondCallArgs)
+
+	k.revertProxyUnbondingWithdraw(ctx, unbondCallArgs.Epoch, unbondCallArgs.ChainID)
 	return nil
 }
 
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// RegisterInvariants registers all liquidstake invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-balance", EscrowBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unburned-derivative", UnburnedDerivativeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "staked-amount", StakedAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claimable-unbonding", ClaimableUnbondingInvariant(k))
+}
+
+// AllInvariants runs all invariants of the liquidstake module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			EscrowBalanceInvariant(k),
+			UnburnedDerivativeInvariant(k),
+			StakedAmountInvariant(k),
+			ClaimableUnbondingInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// EscrowBalanceInvariant checks that the balance of each escrow account covers the user delegations
+// of the pending ProxyDelegations of its source chain. The reinvested reward is not in the escrow account,
+// and the surplus is reported but it doesn't break the invariant.
+func EscrowBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pendingAmts := make(map[string]math.Int)
+		for _, delegation := range k.GetAllProxyDelegation(ctx) {
+			if delegation.Status != types.ProxyDelegationPending {
+				continue
+			}
+
+			amt := delegation.Coin.Amount
+			if !delegation.ReinvestAmount.IsNil() {
+				amt = amt.Sub(delegation.ReinvestAmount)
+			}
+
+			if exist, found := pendingAmts[delegation.ChainID]; found {
+				amt = amt.Add(exist)
+			}
+			pendingAmts[delegation.ChainID] = amt
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, sourceChain := range k.GetAllSourceChain(ctx) {
+			expected, found := pendingAmts[sourceChain.ChainID]
+			if !found {
+				expected = math.ZeroInt()
+			}
+
+			balance := k.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(sourceChain.EcsrowAddress), sourceChain.IbcDenom)
+			switch {
+			case balance.Amount.LT(expected):
+				broken = true
+				msg += fmt.Sprintf("\tchain %s escrow balance %s less than pending delegation %s\n",
+					sourceChain.ChainID, balance.Amount, expected)
+			case balance.Amount.GT(expected):
+				msg += fmt.Sprintf("\tchain %s escrow balance %s, pending delegation %s, surplus %s\n",
+					sourceChain.ChainID, balance.Amount, expected, balance.Amount.Sub(expected))
+			default:
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow balance",
+			fmt.Sprintf("escrow balance less than pending delegation:\n%s", msg)), broken
+	}
+}
+
+// UnburnedDerivativeInvariant checks that the module account holds exactly the derivative tokens
+// of the ProxyUnbondings which has not been undelegated on source chain.
+func UnburnedDerivativeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		unburnedAmts := make(map[string]math.Int)
+
+		store := ctx.KVStore(k.storeKey)
+		iterator := storetypes.KVStorePrefixIterator(store, types.EpochUnbondingsPrefix)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			epochProxyUnbondings := types.EpochProxyUnbonding{}
+			k.cdc.MustUnmarshal(iterator.Value(), &epochProxyUnbondings)
+
+			for _, unbonding := range epochProxyUnbondings.Unbondings {
+				switch unbonding.Status {
+				case types.ProxyUnbondingPending, types.ProxyUnbondingStart, types.ProxyUnbondingStartFailed:
+				default:
+					continue
+				}
+
+				amt := unbonding.BurnedDerivativeAmount
+				if exist, found := unburnedAmts[unbonding.ChainID]; found {
+					amt = amt.Add(exist)
+				}
+				unburnedAmts[unbonding.ChainID] = amt
+			}
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		for _, sourceChain := range k.GetAllSourceChain(ctx) {
+			expected, found := unburnedAmts[sourceChain.ChainID]
+			if !found {
+				expected = math.ZeroInt()
+			}
+
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, sourceChain.DerivativeDenom)
+			if !balance.Amount.Equal(expected) {
+				broken = true
+				msg += fmt.Sprintf("\tchain %s module balance %s, unburned derivative %s\n",
+					sourceChain.ChainID, balance.Amount, expected)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "unburned derivative",
+			fmt.Sprintf("module balance not equal unburned derivative:\n%s", msg)), broken
+	}
+}
+
+// StakedAmountInvariant checks that the staked amount of each source chain equals the sum of
+// the token amount of its validators.
+func StakedAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, sourceChain := range k.GetAllSourceChain(ctx) {
+			total := math.ZeroInt()
+			for _, v := range sourceChain.Validators {
+				if !v.TokenAmount.IsNil() {
+					total = total.Add(v.TokenAmount)
+				}
+			}
+
+			if !sourceChain.StakedAmount.Equal(total) {
+				broken = true
+				msg += fmt.Sprintf("\tchain %s staked amount %s, sum of validators %s\n",
+					sourceChain.ChainID, sourceChain.StakedAmount, total)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "staked amount",
+			fmt.Sprintf("staked amount not equal the sum of validators:\n%s", msg)), broken
+	}
+}
+
+// ClaimableUnbondingInvariant checks that the claimable UserUnbondings of each source chain can be
+// paid by the balance of its delegate account.
+func ClaimableUnbondingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		claimableAmts := make(map[string]math.Int)
+
+		store := ctx.KVStore(k.storeKey)
+		iterator := storetypes.KVStorePrefixIterator(store, types.UndelegationRecrodPrefix)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			userUnbonding := types.UserUnbonding{}
+			k.cdc.MustUnmarshal(iterator.Value(), &userUnbonding)
+			if userUnbonding.CliamStatus != types.UserUnbondingClaimable {
+				continue
+			}
+
+			amt := userUnbonding.RedeemCoin.Amount
+			if exist, found := claimableAmts[userUnbonding.ChainID]; found {
+				amt = amt.Add(exist)
+			}
+			claimableAmts[userUnbonding.ChainID] = amt
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, sourceChain := range k.GetAllSourceChain(ctx) {
+			claimable, found := claimableAmts[sourceChain.ChainID]
+			if !found {
+				continue
+			}
+
+			balance := k.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(sourceChain.DelegateAddress), sourceChain.IbcDenom)
+			if balance.Amount.LT(claimable) {
+				broken = true
+				msg += fmt.Sprintf("\tchain %s delegate account balance %s, claimable %s\n",
+					sourceChain.ChainID, balance.Amount, claimable)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "claimable unbonding",
+			fmt.Sprintf("delegate account balance less than claimable unbonding:\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	srcChainParams := suite.mockSourceChainParams()
+	delegationEpochInfo := suite.delegationEpoch()
+	suite.setSourceChainAndEpoch(srcChainParams, delegationEpochInfo)
+
+	testCoin := suite.testCoin
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctlChainUserAccAddr := suite.controlChain.SenderAccount.GetAddress()
+	k := ctlChainApp.LiquidStakeKeeper
+
+	ctx := suite.controlChain.GetContext()
+	_, err := k.Delegate(ctx, srcChainParams.ChainID, testCoin.Amount, ctlChainUserAccAddr)
+	suite.NoError(err)
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	suite.False(broken)
+
+	suite.advanceEpochAndRelayIBC(delegationEpochInfo)
+
+	unbondingEpochInfo := suite.unbondEpoch()
+	ctx = suite.controlChain.GetContext()
+	ctlChainApp.EpochsKeeper.SetEpochInfo(ctx, *unbondingEpochInfo)
+
+	_, err = k.Undelegate(ctx, srcChainParams.ChainID, testCoin.Amount.QuoRaw(2), ctlChainUserAccAddr)
+	suite.NoError(err)
+
+	_, broken = keeper.AllInvariants(k)(ctx)
+	suite.False(broken)
+
+	// the derivative token in module account is more than the unbondings
+	suite.mintTestCoin(ctlChainApp, ctx, sdk.NewCoin(srcChainParams.DerivativeDenom, sdk.NewInt(1)),
+		ctlChainApp.AccountKeeper.GetModuleAddress(types.ModuleName))
+	_, broken = keeper.UnburnedDerivativeInvariant(k)(ctx)
+	suite.True(broken)
+
+	// the surplus of escrow account is reported, but it doesn't break the invariant.
+	escrowAddr := sdk.MustAccAddressFromBech32(srcChainParams.EcsrowAddress)
+	suite.mintTestCoin(ctlChainApp, ctx, sdk.NewCoin(srcChainParams.IbcDenom, sdk.NewInt(1)), escrowAddr)
+	msg, broken := keeper.EscrowBalanceInvariant(k)(ctx)
+	suite.False(broken)
+	suite.Contains(msg, "surplus 1")
+
+	// the escrow account doesn't cover the pending delegation.
+	suite.mintTestCoin(ctlChainApp, ctx, sdk.NewCoin(srcChainParams.IbcDenom, sdk.NewInt(10)), ctlChainUserAccAddr)
+	_, err = k.Delegate(ctx, srcChainParams.ChainID, sdk.NewInt(10), ctlChainUserAccAddr)
+	suite.NoError(err)
+	suite.NoError(ctlChainApp.BankKeeper.SendCoins(ctx, escrowAddr, ctlChainUserAccAddr,
+		sdk.NewCoins(sdk.NewCoin(srcChainParams.IbcDenom, sdk.NewInt(2)))))
+	_, broken = keeper.EscrowBalanceInvariant(k)(ctx)
+	suite.True(broken)
+
+	sourceChain, _ := k.GetSourceChain(ctx, srcChainParams.ChainID)
+	sourceChain.StakedAmount = sourceChain.StakedAmount.AddRaw(1)
+	k.SetSourceChain(ctx, sourceChain)
+	_, broken = keeper.StakedAmountInvariant(k)(ctx)
+	suite.True(broken)
+}
@@ -24,6 +24,9 @@ type Keeper struct {
 	ibcKeeper         *ibckeeper.Keeper
 	ibcTransferKeeper ibctransferkeeper.Keeper
 	icaCtlKeeper      icacontrollerkeeper.Keeper
+
+	// the address capable of executing governance operations, typically the x/gov module account.
+	authority string
 }
 
 func NewKeeper(
@@ -35,6 +38,7 @@ func NewKeeper(
 	ibcClientKeeper *ibckeeper.Keeper,
 	icaCtlKeeper icacontrollerkeeper.Keeper,
 	ibcTransferKeeper ibctransferkeeper.Keeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		storeKey:          storeKey,
@@ -45,9 +49,15 @@ func NewKeeper(
 		ibcKeeper:         ibcClientKeeper,
 		ibcTransferKeeper: ibcTransferKeeper,
 		icaCtlKeeper:      icaCtlKeeper,
+		authority:         authority,
 	}
 }
 
+// GetAuthority returns the x/liquidstake module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -101,6 +111,7 @@ func (k Keeper) IncreaseProxyDelegationID(ctx sdk.Context) error {
 	return nil
 }
 
+// GetAllProxyDelegation return all the ProxyDelegations which are not done, the done ones are archived.
 func (k Keeper) GetAllProxyDelegation(ctx sdk.Context) []types.ProxyDelegation {
 	store := ctx.KVStore(k.storeKey)
 
@@ -118,6 +129,23 @@ func (k Keeper) GetAllProxyDelegation(ctx sdk.Context) []types.ProxyDelegation {
 	return records
 }
 
+// GetAllSourceChain return all the registered source chains
+func (k Keeper) GetAllSourceChain(ctx sdk.Context) []types.SourceChain {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.SouceChainKeyPrefix)
+	defer iterator.Close()
+
+	var sourceChains []types.SourceChain
+	for ; iterator.Valid(); iterator.Next() {
+		sourceChain := types.SourceChain{}
+		k.cdc.MustUnmarshal(iterator.Value(), &sourceChain)
+		sourceChains = append(sourceChains, sourceChain)
+	}
+
+	return sourceChains
+}
+
 // checkIBCClient check weather the ibcclient of the specific chain is active
 // func (k Keeper) checkIBCClient(ctx sdk.Context, chainID string) error {
 // 	clientState, found := k.ibcClientKeeper.GetClientState(ctx, chainID)
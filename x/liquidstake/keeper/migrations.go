@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2:
+//   - the UserUnbondings are re-keyed from `chainID.epoch.delegator` to the length prefixed key.
+//   - the done ProxyDelegations are archived with the epoch index, the status index and the processing
+//     amounts of the others are built.
+//   - the EpochProxyUnbondings whose ProxyUnbondings are all done are archived and the index of
+//     the started ProxyUnbondings is built.
+//   - the default params are stored, so that the redeem rate bounds are enabled.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !ctx.KVStore(m.keeper.storeKey).Has(types.ParamsKey) {
+		if err := m.keeper.SetParams(ctx, types.DefaultParams()); err != nil {
+			return err
+		}
+	}
+
+	m.migrateUserUnbondings(ctx)
+	m.migrateProxyDelegations(ctx)
+	m.migrateEpochProxyUnbondings(ctx)
+
+	return nil
+}
+
+func (m Migrator) migrateUserUnbondings(ctx sdk.Context) {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	var (
+		oldKeys        [][]byte
+		userUnbondings []types.UserUnbonding
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, types.UndelegationRecrodPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		userUnbonding := types.UserUnbonding{}
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &userUnbonding)
+
+		oldKeys = append(oldKeys, iterator.Key())
+		userUnbondings = append(userUnbondings, userUnbonding)
+	}
+	iterator.Close()
+
+	// all the old keys are deleted first, so that no new key is deleted as an old one.
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+
+	for i := range userUnbondings {
+		m.keeper.SetUserUnbonding(ctx, &userUnbondings[i])
+	}
+}
+
+func (m Migrator) migrateProxyDelegations(ctx sdk.Context) {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	var delegations []types.ProxyDelegation
+	iterator := storetypes.KVStorePrefixIterator(store, types.ProxyDelegationPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := types.ProxyDelegation{}
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &delegation)
+
+		delegations = append(delegations, delegation)
+	}
+	iterator.Close()
+
+	// the old records are deleted first, so that they are not subtracted from the processing amounts.
+	for i := range delegations {
+		store.Delete(types.GetProxyDelegationKey(delegations[i].Id))
+	}
+
+	for i := range delegations {
+		m.keeper.SetProxyDelegation(ctx, delegations[i].Id, &delegations[i])
+	}
+}
+
+func (m Migrator) migrateEpochProxyUnbondings(ctx sdk.Context) {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	var epochProxyUnbondings []types.EpochProxyUnbonding
+	iterator := storetypes.KVStorePrefixIterator(store, types.EpochUnbondingsPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		epochProxyUnbonding := types.EpochProxyUnbonding{}
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &epochProxyUnbonding)
+
+		epochProxyUnbondings = append(epochProxyUnbondings, epochProxyUnbonding)
+	}
+	iterator.Close()
+
+	for i := range epochProxyUnbondings {
+		if epochProxyUnbondings[i].AllDone() {
+			m.keeper.archiveEpochProxyUnboundings(ctx, &epochProxyUnbondings[i])
+			continue
+		}
+
+		m.keeper.SetEpochProxyUnboundings(ctx, &epochProxyUnbondings[i])
+	}
+}
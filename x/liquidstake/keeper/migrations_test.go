@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	cdc := suite.controlChain.Codec
+	store := ctx.KVStore(ctlChainApp.GetKey(types.StoreKey))
+
+	chainID := "source.chain-1"
+	delegator := sdk.AccAddress("delegator___________").String()
+
+	// the records are written in the layout of version 1.
+	userUnbonding := types.UserUnbonding{
+		ID:          types.AssembleUserUnbondingID(chainID, 1, delegator),
+		ChainID:     chainID,
+		Epoch:       1,
+		Delegator:   delegator,
+		Receiver:    delegator,
+		RedeemCoin:  sdk.NewCoin("stake", sdk.NewInt(1000)),
+		CliamStatus: types.UserUnbondingPending,
+	}
+	oldUserUnbondingKey := []byte(string(types.UndelegationRecrodPrefix) + userUnbonding.ID)
+	store.Set(oldUserUnbondingKey, cdc.MustMarshal(&userUnbonding))
+
+	delegations := []types.ProxyDelegation{
+		{Id: 100, Coin: sdk.NewCoin("stake", sdk.NewInt(1000)), Status: types.ProxyDelegationDone, EpochNumber: 1, ChainID: chainID},
+		{Id: 101, Coin: sdk.NewCoin("stake", sdk.NewInt(1000)), Status: types.ProxyDelegationPending, EpochNumber: 2, ChainID: chainID},
+	}
+	for i := range delegations {
+		store.Set(types.GetProxyDelegationKey(delegations[i].Id), cdc.MustMarshal(&delegations[i]))
+	}
+
+	epochUnbondings := []types.EpochProxyUnbonding{
+		{Epoch: 1, Unbondings: []types.ProxyUnbonding{{ChainID: chainID, Status: types.ProxyUnbondingDone}}},
+		{Epoch: 2, Unbondings: []types.ProxyUnbonding{{ChainID: chainID, Status: types.ProxyUnbondingStart}}},
+	}
+	for i := range epochUnbondings {
+		store.Set(types.GetEpochUnbondingsKey(epochUnbondings[i].Epoch), cdc.MustMarshal(&epochUnbondings[i]))
+	}
+
+	store.Delete(types.ParamsKey)
+
+	suite.Require().NoError(keeper.NewMigrator(ctlChainApp.LiquidStakeKeeper).Migrate1to2(ctx))
+
+	// the redeem rate bounds are enabled.
+	suite.Require().True(store.Has(types.ParamsKey))
+	suite.Require().True(ctlChainApp.LiquidStakeKeeper.GetParams(ctx).MaxRedeemRateDecrease.IsPositive())
+
+	// the UserUnbonding is re-keyed.
+	suite.Require().False(store.Has(oldUserUnbondingKey))
+	migrated, found := ctlChainApp.LiquidStakeKeeper.GetUserUnbondingID(ctx, userUnbonding.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(userUnbonding, *migrated)
+
+	// the done ProxyDelegation is archived and the pending one is indexed by status.
+	suite.Require().False(store.Has(types.GetProxyDelegationKey(100)))
+	suite.Require().True(store.Has(types.GetProxyDelegationArchiveKey(100)))
+	suite.Require().True(store.Has(types.GetProxyDelegationArchiveEpochKey(1, 100)))
+	suite.Require().False(store.Has(types.GetProxyDelegationStatusKey(types.ProxyDelegationDone, 100)))
+	suite.Require().True(store.Has(types.GetProxyDelegationKey(101)))
+	suite.Require().True(store.Has(types.GetProxyDelegationStatusKey(types.ProxyDelegationPending, 101)))
+	processingAmount, _ := ctlChainApp.LiquidStakeKeeper.GetProxyDelegationProcessingAmount(ctx, chainID)
+	suite.Require().Equal(sdk.NewInt(1000), processingAmount)
+
+	// the done EpochProxyUnbonding is archived and the started one is indexed.
+	suite.Require().False(store.Has(types.GetEpochUnbondingsKey(1)))
+	suite.Require().True(store.Has(types.GetEpochUnbondingsArchiveKey(1)))
+	suite.Require().True(store.Has(types.GetEpochUnbondingsKey(2)))
+	suite.Require().True(store.Has(types.GetStartedProxyUnbondingKey(chainID, 2)))
+	suite.Require().False(store.Has(types.GetStartedProxyUnbondingKey(chainID, 1)))
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	goctx "context"
 	"strconv"
+	"strings"
 
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -37,7 +39,7 @@ func (ms msgServer) RegisterSourceChain(goCtx goctx.Context, msg *types.MsgRegis
 		StakedAmount:              math.ZeroInt(),
 	}
 
-	if err := ms.keeper.AddSouceChain(ctx, &sourceChain); err != nil {
+	if err := ms.keeper.AddSouceChain(ctx, &sourceChain, msg.NativeDenomMetadata); err != nil {
 		return nil, err
 	}
 
@@ -137,3 +139,173 @@ func (ms msgServer) Claim(goCtx goctx.Context, msg *types.MsgClaim) (*types.MsgC
 func (msgServer) Reinvest(goctx.Context, *types.MsgReinvest) (*types.MsgReinvestResponse, error) {
 	panic("unimplemented")
 }
+
+// UpdateValidatorSelectionPolicy implements types.MsgServer
+func (ms msgServer) UpdateValidatorSelectionPolicy(goCtx goctx.Context, msg *types.MsgUpdateValidatorSelectionPolicy) (*types.MsgUpdateValidatorSelectionPolicyResponse, error) {
+	if ms.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.UpdateValidatorSelectionPolicy(ctx, msg.ChainID, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateSelectionPolicy,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, msg.ChainID),
+			sdk.NewAttribute(types.AttributeKeyStrategy, msg.Policy.Strategy.String()),
+		),
+	)
+
+	return &types.MsgUpdateValidatorSelectionPolicyResponse{}, nil
+}
+
+// SubmitHostValidatorSnapshot implements types.MsgServer
+func (ms msgServer) SubmitHostValidatorSnapshot(goCtx goctx.Context, msg *types.MsgSubmitHostValidatorSnapshot) (*types.MsgSubmitHostValidatorSnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.SubmitHostValidatorSnapshot(ctx, msg.Submitter, msg.Snapshot, msg.Proofs); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitValidatorSnapshot,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, msg.Snapshot.ChainID),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Submitter),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(msg.Snapshot.Height, 10)),
+		),
+	)
+
+	return &types.MsgSubmitHostValidatorSnapshotResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer
+func (ms msgServer) UpdateParams(goCtx goctx.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.SetParams(ctx, msg.Params); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUpdateParams),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ResumeSourceChain implements types.MsgServer
+func (ms msgServer) ResumeSourceChain(goCtx goctx.Context, msg *types.MsgResumeSourceChain) (*types.MsgResumeSourceChainResponse, error) {
+	if ms.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.ResumeSourceChain(ctx, msg.ChainID); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeSourceChain,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, msg.ChainID),
+		),
+	)
+
+	return &types.MsgResumeSourceChainResponse{}, nil
+}
+
+// SetSourceChainStatus implements types.MsgServer
+func (ms msgServer) SetSourceChainStatus(goCtx goctx.Context, msg *types.MsgSetSourceChainStatus) (*types.MsgSetSourceChainStatusResponse, error) {
+	if ms.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	oldStatus, err := ms.keeper.SetSourceChainStatus(ctx, msg.ChainID, msg.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetSourceChainStatus,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, msg.ChainID),
+			sdk.NewAttribute(types.AttributeKeyOldStatus, oldStatus.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, msg.Status.String()),
+		),
+	)
+
+	return &types.MsgSetSourceChainStatusResponse{}, nil
+}
+
+// DeregisterSourceChain implements types.MsgServer
+func (ms msgServer) DeregisterSourceChain(goCtx goctx.Context, msg *types.MsgDeregisterSourceChain) (*types.MsgDeregisterSourceChainResponse, error) {
+	if ms.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.DeregisterSourceChain(ctx, msg.ChainID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeregisterSourceChainResponse{}, nil
+}
+
+// UpdateDerivativeDenomMetadata implements types.MsgServer
+func (ms msgServer) UpdateDerivativeDenomMetadata(goCtx goctx.Context, msg *types.MsgUpdateDerivativeDenomMetadata) (*types.MsgUpdateDerivativeDenomMetadataResponse, error) {
+	if ms.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.UpdateDerivativeDenomMetadata(ctx, msg.ChainID, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, msg.ChainID),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+		),
+	)
+
+	return &types.MsgUpdateDerivativeDenomMetadataResponse{}, nil
+}
+
+// ClearValidatorSlashed implements types.MsgServer
+func (ms msgServer) ClearValidatorSlashed(goCtx goctx.Context, msg *types.MsgClearValidatorSlashed) (*types.MsgClearValidatorSlashedResponse, error) {
+	if ms.keeper.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.keeper.ClearValidatorSlashed(ctx, msg.ChainID, msg.Validators); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClearValidatorSlashed,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, msg.ChainID),
+			sdk.NewAttribute(types.AttributeKeyValidators, strings.Join(msg.Validators, ",")),
+		),
+	)
+
+	return &types.MsgClearValidatorSlashedResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// GetProcessingCursor return the cursor of the epoch processing which is not finished.
+func (k Keeper) GetProcessingCursor(ctx sdk.Context, key []byte) (*types.ProcessingCursor, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return nil, false
+	}
+
+	cursor := types.ProcessingCursor{}
+	k.cdc.MustUnmarshal(bz, &cursor)
+
+	return &cursor, true
+}
+
+func (k Keeper) setProcessingCursor(ctx sdk.Context, key []byte, cursor *types.ProcessingCursor) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(key, k.cdc.MustMarshal(cursor))
+}
+
+// ContinueEpochProcessing process the next batch of the unfinished delegation and undelegation epoch
+// processing. The processing which has handled a batch in current block is skipped.
+func (k Keeper) ContinueEpochProcessing(ctx sdk.Context) {
+	if cursor, found := k.GetProcessingCursor(ctx, types.DelegationProcessingCursorKey); found && cursor.Height < ctx.BlockHeight() {
+		k.processProxyDelegationBatch(ctx, cursor)
+	}
+
+	if cursor, found := k.GetProcessingCursor(ctx, types.UndelegationProcessingCursorKey); found && cursor.Height < ctx.BlockHeight() {
+		k.processUndelegationBatch(ctx, cursor)
+	}
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func (suite *KeeperTestSuite) TestProxyDelegationArchive() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	delegation := types.ProxyDelegation{
+		Id:          100,
+		Coin:        sdk.NewCoin("stake", sdk.NewInt(1000)),
+		Status:      types.ProxyDelegationPending,
+		EpochNumber: 1,
+		ChainID:     "unknown",
+	}
+	keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+	suite.Require().Len(keeper.GetAllProxyDelegation(ctx), 1)
+
+	delegation.Status = types.ProxyDelegationDone
+	keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+
+	// the done ProxyDelegation is archived, but still can be found by id.
+	suite.Require().Empty(keeper.GetAllProxyDelegation(ctx))
+	archived, found := keeper.GetProxyDelegation(ctx, delegation.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(types.ProxyDelegationDone, archived.Status)
+}
+
+func (suite *KeeperTestSuite) TestProcessProxyDelegationWithBudget() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	params := keeper.GetParams(ctx)
+	params.EpochProcessingBudget = 1
+	suite.Require().NoError(keeper.SetParams(ctx, params))
+
+	ids := []uint64{100, 101}
+	for _, id := range ids {
+		keeper.SetProxyDelegation(ctx, id, &types.ProxyDelegation{
+			Id:          id,
+			Coin:        sdk.NewCoin("stake", sdk.NewInt(1000)),
+			Status:      types.ProxyDelegationFailed,
+			EpochNumber: 1,
+			ChainID:     "unknown",
+		})
+	}
+
+	statuses := func() []types.ProxyDelegationStatus {
+		var res []types.ProxyDelegationStatus
+		for _, id := range ids {
+			delegation, found := keeper.GetProxyDelegation(ctx, id)
+			suite.Require().True(found)
+			res = append(res, delegation.Status)
+		}
+		return res
+	}
+
+	keeper.ProcessProxyDelegation(ctx, 3)
+	suite.Require().Equal([]types.ProxyDelegationStatus{types.ProxyDelegationTransferred, types.ProxyDelegationFailed}, statuses())
+
+	// the left one is not processed again in the same block.
+	keeper.ContinueEpochProcessing(ctx)
+	suite.Require().Equal([]types.ProxyDelegationStatus{types.ProxyDelegationTransferred, types.ProxyDelegationFailed}, statuses())
+	_, found := keeper.GetProcessingCursor(ctx, types.DelegationProcessingCursorKey)
+	suite.Require().True(found)
+
+	// the retried one which becomes transferred is not processed again in the same epoch.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	keeper.ContinueEpochProcessing(ctx)
+	suite.Require().Equal([]types.ProxyDelegationStatus{types.ProxyDelegationTransferred, types.ProxyDelegationTransferred}, statuses())
+	_, found = keeper.GetProcessingCursor(ctx, types.DelegationProcessingCursorKey)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestProcessUndelegationEpochWithBudget() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+	store := ctx.KVStore(ctlChainApp.GetKey(types.StoreKey))
+
+	params := keeper.GetParams(ctx)
+	params.EpochProcessingBudget = 2
+	suite.Require().NoError(keeper.SetParams(ctx, params))
+
+	pending := types.ProxyUnbonding{ChainID: "unknown", Status: types.ProxyUnbondingPending}
+	done := types.ProxyUnbonding{ChainID: "unknown", Status: types.ProxyUnbondingDone}
+	keeper.SetEpochProxyUnboundings(ctx, &types.EpochProxyUnbonding{Epoch: 1, Unbondings: []types.ProxyUnbonding{done}})
+	keeper.SetEpochProxyUnboundings(ctx, &types.EpochProxyUnbonding{Epoch: 2, Unbondings: []types.ProxyUnbonding{pending}})
+	keeper.SetEpochProxyUnboundings(ctx, &types.EpochProxyUnbonding{Epoch: 3, Unbondings: []types.ProxyUnbonding{}})
+	keeper.SetEpochProxyUnboundings(ctx, &types.EpochProxyUnbonding{Epoch: 4, Unbondings: []types.ProxyUnbonding{}})
+
+	archived := func(epoch uint64) bool {
+		_, found := keeper.GetEpochProxyUnboundings(ctx, epoch)
+		suite.Require().True(found)
+		return store.Has(types.GetEpochUnbondingsArchiveKey(epoch))
+	}
+
+	keeper.ProcessUndelegationEpoch(ctx, 4)
+	suite.Require().True(archived(1))
+	suite.Require().False(archived(2))
+	suite.Require().False(archived(3))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	keeper.ContinueEpochProcessing(ctx)
+	suite.Require().True(archived(3))
+	// the current epoch is not processed, the unbonding of unknown chain is kept.
+	suite.Require().False(archived(4))
+	suite.Require().False(archived(2))
+
+	_, found := keeper.GetProcessingCursor(ctx, types.UndelegationProcessingCursorKey)
+	suite.Require().False(found)
+}
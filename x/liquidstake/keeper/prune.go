@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// PruneProxyDelegations delete the archived ProxyDelegations which are out of the record retention.
+// At most the epoch processing budget of them are deleted, the left ones are deleted in the next epoch.
+// A summary event is emitted for each source chain.
+func (k Keeper) PruneProxyDelegations(ctx sdk.Context, epoch uint64) {
+	params := k.GetParams(ctx)
+	if params.RecordRetention == 0 || epoch <= params.RecordRetention {
+		return
+	}
+	cutoff := epoch - params.RecordRetention
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ProxyDelegationArchiveEpochPrefix, types.GetProxyDelegationArchiveEpochPrefix(cutoff))
+
+	var delegations []types.ProxyDelegation
+	for ; iterator.Valid(); iterator.Next() {
+		if params.EpochProcessingBudget != 0 && uint64(len(delegations)) >= params.EpochProcessingBudget {
+			break
+		}
+
+		_, id := types.ParseProxyDelegationArchiveEpochKey(iterator.Key())
+		bz := store.Get(types.GetProxyDelegationArchiveKey(id))
+		if bz == nil {
+			continue
+		}
+
+		delegation := types.ProxyDelegation{}
+		k.cdc.MustUnmarshal(bz, &delegation)
+		delegations = append(delegations, delegation)
+	}
+	iterator.Close()
+
+	counts := make(map[string]uint64)
+	amounts := make(map[string]math.Int)
+	for _, delegation := range delegations {
+		k.deleteProxyDelegationArchive(ctx, delegation.Id)
+		store.Delete(types.GetChainProxyDelegationIDForEpochKey(delegation.EpochNumber, []byte(delegation.ChainID)))
+
+		amount, found := amounts[delegation.ChainID]
+		if !found {
+			amount = math.ZeroInt()
+		}
+		amounts[delegation.ChainID] = amount.Add(delegation.Coin.Amount)
+		counts[delegation.ChainID]++
+	}
+
+	for _, chainID := range sortedKeys(counts) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneProxyDelegations,
+				sdk.NewAttribute(types.AttributeKeySourceChainID, chainID),
+				sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.FormatUint(counts[chainID], 10)),
+				sdk.NewAttribute(types.AttributeKeyDelegateAmt, amounts[chainID].String()),
+			),
+		)
+	}
+}
+
+// PruneEpochProxyUnbondings delete the archived EpochProxyUnbondings which are out of the record retention,
+// with the complete UserUnbondings of them. The UserUnbonding which is not claimed is kept, and it's deleted
+// when it's claimed. At most the epoch processing budget of EpochProxyUnbondings are deleted, the left ones
+// are deleted in the next epoch. A summary event is emitted for each source chain.
+func (k Keeper) PruneEpochProxyUnbondings(ctx sdk.Context, epoch uint64) {
+	params := k.GetParams(ctx)
+	if params.RecordRetention == 0 || epoch <= params.RecordRetention {
+		return
+	}
+	cutoff := epoch - params.RecordRetention
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.EpochUnbondingsArchivePrefix, types.GetEpochUnbondingsArchiveKey(cutoff))
+
+	var epochProxyUnbondings []types.EpochProxyUnbonding
+	for ; iterator.Valid(); iterator.Next() {
+		if params.EpochProcessingBudget != 0 && uint64(len(epochProxyUnbondings)) >= params.EpochProcessingBudget {
+			break
+		}
+
+		epochProxyUnbonding := types.EpochProxyUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &epochProxyUnbonding)
+		epochProxyUnbondings = append(epochProxyUnbondings, epochProxyUnbonding)
+	}
+	iterator.Close()
+
+	counts := make(map[string]uint64)
+	userCounts := make(map[string]uint64)
+	amounts := make(map[string]math.Int)
+	for _, epochProxyUnbonding := range epochProxyUnbondings {
+		for _, unbonding := range epochProxyUnbonding.Unbondings {
+			for _, id := range unbonding.UserUnbondingIds {
+				userUnbonding, found := k.GetUserUnbondingID(ctx, id)
+				if !found || userUnbonding.CliamStatus != types.UserUnbondingComplete {
+					continue
+				}
+				k.deleteUserUnbonding(ctx, userUnbonding)
+				userCounts[unbonding.ChainID]++
+			}
+
+			amount, found := amounts[unbonding.ChainID]
+			if !found {
+				amount = math.ZeroInt()
+			}
+			amounts[unbonding.ChainID] = amount.Add(unbonding.RedeemNativeToken.Amount)
+			counts[unbonding.ChainID]++
+		}
+
+		store.Delete(types.GetEpochUnbondingsArchiveKey(epochProxyUnbonding.Epoch))
+	}
+
+	for _, chainID := range sortedKeys(counts) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneProxyUnbondings,
+				sdk.NewAttribute(types.AttributeKeySourceChainID, chainID),
+				sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.FormatUint(counts[chainID], 10)),
+				sdk.NewAttribute(types.AttributeKeyUserCount, strconv.FormatUint(userCounts[chainID], 10)),
+				sdk.NewAttribute(types.AttributeKeyRedeemAmt, amounts[chainID].String()),
+			),
+		)
+	}
+}
+
+func sortedKeys(m map[string]uint64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func (suite *KeeperTestSuite) TestPruneProxyDelegations() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext().WithEventManager(sdk.NewEventManager())
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	params := keeper.GetParams(ctx)
+	params.RecordRetention = 2
+	suite.Require().NoError(keeper.SetParams(ctx, params))
+
+	for i, epoch := range []uint64{1, 3} {
+		delegation := types.ProxyDelegation{
+			Id:          uint64(100 + i),
+			Coin:        sdk.NewCoin("stake", sdk.NewInt(1000)),
+			Status:      types.ProxyDelegationDone,
+			EpochNumber: epoch,
+			ChainID:     "unknown",
+		}
+		keeper.SetChainProxyDelegationID(ctx, delegation.ChainID, epoch, delegation.Id)
+		keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+	}
+
+	keeper.PruneProxyDelegations(ctx, 4)
+
+	_, found := keeper.GetProxyDelegation(ctx, 100)
+	suite.Require().False(found)
+	_, found = keeper.GetChianProxyDelegationID(ctx, "unknown", 1)
+	suite.Require().False(found)
+	_, found = keeper.GetProxyDelegation(ctx, 101)
+	suite.Require().True(found)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypePruneProxyDelegations, events[0].Type)
+	suite.Require().Equal(types.AttributeKeyRecordCount, string(events[0].Attributes[1].Key))
+	suite.Require().Equal("1", string(events[0].Attributes[1].Value))
+}
+
+func (suite *KeeperTestSuite) TestPruneProxyDelegationsByEpoch() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	params := keeper.GetParams(ctx)
+	params.RecordRetention = 2
+	suite.Require().NoError(keeper.SetParams(ctx, params))
+
+	// the ProxyDelegation of the later epoch is done with the lower ID.
+	for id, epoch := range map[uint64]uint64{100: 3, 101: 1} {
+		delegation := types.ProxyDelegation{
+			Id:          id,
+			Coin:        sdk.NewCoin("stake", sdk.NewInt(1000)),
+			Status:      types.ProxyDelegationDone,
+			EpochNumber: epoch,
+			ChainID:     "unknown",
+		}
+		keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+	}
+
+	keeper.PruneProxyDelegations(ctx, 4)
+
+	_, found := keeper.GetProxyDelegation(ctx, 101)
+	suite.Require().False(found)
+	_, found = keeper.GetProxyDelegation(ctx, 100)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestPruneEpochProxyUnbondings() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	params := keeper.GetParams(ctx)
+	params.RecordRetention = 2
+	suite.Require().NoError(keeper.SetParams(ctx, params))
+
+	claimed := types.UserUnbonding{
+		ID:          types.AssembleUserUnbondingID("unknown", 1, "claimed"),
+		ChainID:     "unknown",
+		Epoch:       1,
+		Delegator:   "claimed",
+		RedeemCoin:  sdk.NewCoin("stake", sdk.NewInt(1000)),
+		CliamStatus: types.UserUnbondingComplete,
+	}
+	unclaimed := claimed
+	unclaimed.ID = types.AssembleUserUnbondingID("unknown", 1, "unclaimed")
+	unclaimed.Delegator = "unclaimed"
+	unclaimed.CliamStatus = types.UserUnbondingClaimable
+	keeper.SetUserUnbonding(ctx, &claimed)
+	keeper.SetUserUnbonding(ctx, &unclaimed)
+
+	epochProxyUnbonding := types.EpochProxyUnbonding{
+		Epoch: 1,
+		Unbondings: []types.ProxyUnbonding{{
+			ChainID:                "unknown",
+			BurnedDerivativeAmount: sdk.NewInt(2000),
+			RedeemNativeToken:      sdk.NewCoin("stake", sdk.NewInt(2000)),
+			Status:                 types.ProxyUnbondingDone,
+			UserUnbondingIds:       []string{claimed.ID, unclaimed.ID},
+		}},
+	}
+	keeper.SetEpochProxyUnboundings(ctx, &epochProxyUnbonding)
+
+	// the active one is not pruned until it's archived by the epoch processing.
+	keeper.PruneEpochProxyUnbondings(ctx, 4)
+	_, found := keeper.GetEpochProxyUnboundings(ctx, 1)
+	suite.Require().True(found)
+
+	keeper.ProcessUndelegationEpoch(ctx, 4)
+	keeper.PruneEpochProxyUnbondings(ctx, 4)
+
+	_, found = keeper.GetEpochProxyUnboundings(ctx, 1)
+	suite.Require().False(found)
+	_, found = keeper.GetUserUnbondingID(ctx, claimed.ID)
+	suite.Require().False(found)
+	_, found = keeper.GetUserUnbondingID(ctx, unclaimed.ID)
+	suite.Require().True(found)
+}
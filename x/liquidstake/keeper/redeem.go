@@ -3,80 +3,159 @@ package keeper
 import (
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	appparams "github.com/celinium-network/celinium/app/params"
 	"github.com/celinium-network/celinium/x/liquidstake/types"
 )
 
 // UpdateRedeemRate update redeemrate for each source chain
-// TODO Record the rate in the last few epochs, and then average?
-func (k Keeper) UpdateRedeemRate(ctx sdk.Context, delegations []types.ProxyDelegation) {
+func (k Keeper) UpdateRedeemRate(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, types.SouceChainKeyPrefix)
 
-	chainProcessingAmts := k.GetDelegaionProcessingAmount(delegations)
-
 	for ; iterator.Valid(); iterator.Next() {
 		sourcechain := &types.SourceChain{}
 		bz := iterator.Value()
 		k.cdc.MustUnmarshal(bz, sourcechain)
 
-		if !k.sourceChainAvaiable(ctx, sourcechain) {
+		// the redeem rate of deregistering source chain is fixed when the wind down completed.
+		if !k.sourceChainAvaiable(ctx, sourcechain) || sourcechain.Halted ||
+			sourcechain.Status == types.SourceChainDeregistering {
 			continue
 		}
 
-		// If the status of a ProxyDelegation is `ProxyDelegationDone`, it maybe remove from the store.
-		// So the stakingAmount = stakedAmount.
-		stakingAmount := sourcechain.StakedAmount
-		processingAmount, found := chainProcessingAmts[sourcechain.ChainID]
-		if !found {
-			processingAmount = math.ZeroInt()
-		}
+		_, processingAmount := k.GetProxyDelegationProcessingAmount(ctx, sourcechain.ChainID)
 
-		if stakingAmount.IsNil() {
-			stakingAmount = math.ZeroInt()
-		}
+		k.setRedeemRate(ctx, sourcechain, k.calculateRedeemRate(ctx, sourcechain, processingAmount))
+	}
+}
+
+// setRedeemRate set the redeem rate of source chain and record it to the history. If the change of
+// redeem rate is out of the bounds, the source chain will be halted and the redeem rate keep unchanged.
+func (k Keeper) setRedeemRate(ctx sdk.Context, sourceChain *types.SourceChain, rate sdk.Dec) {
+	oldRate := sourceChain.Redemptionratio
+	params := k.GetParams(ctx)
+
+	if !params.RedeemRateInBounds(oldRate, rate) {
+		sourceChain.Halted = true
+		k.SetSourceChain(ctx, sourceChain)
+
+		k.Logger(ctx).Error("redeem rate out of bounds, halt source chain",
+			"chainID", sourceChain.ChainID, "old", oldRate.String(), "new", rate.String())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHaltSourceChain,
+				sdk.NewAttribute(types.AttributeKeySourceChainID, sourceChain.ChainID),
+				sdk.NewAttribute(types.AttributeKeyOldRedeemRate, oldRate.String()),
+				sdk.NewAttribute(types.AttributeKeyRedeemRate, rate.String()),
+			),
+		)
+		return
+	}
 
-		stakingAmount = stakingAmount.Add(processingAmount)
+	sourceChain.Redemptionratio = rate
+	k.SetSourceChain(ctx, sourceChain)
 
-		derivationAmount := k.bankKeeper.GetSupply(ctx, sourcechain.DerivativeDenom)
+	k.recordRedeemRate(ctx, sourceChain.ChainID, rate, params.RedeemRateHistoryRetention)
 
-		if derivationAmount.IsZero() || stakingAmount.IsZero() {
-			// TODO precise
-			sourcechain.Redemptionratio = sdk.NewDec(1)
-		} else {
-			sourcechain.Redemptionratio = sdk.NewDecFromInt(stakingAmount).Quo(sdk.NewDecFromInt(derivationAmount.Amount))
-		}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRedeemRate,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, sourceChain.ChainID),
+			sdk.NewAttribute(types.AttributeKeyOldRedeemRate, oldRate.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemRate, rate.String()),
+		),
+	)
+}
+
+// recordRedeemRate save the redeem rate of the current delegation epoch, and prune the records
+// which are older than the retention.
+func (k Keeper) recordRedeemRate(ctx sdk.Context, chainID string, rate sdk.Dec, retention uint64) {
+	epochInfo, found := k.epochKeeper.GetEpochInfo(ctx, appparams.DelegationEpochIdentifier)
+	if !found || epochInfo.CurrentEpoch < 0 {
+		return
+	}
+	epoch := uint64(epochInfo.CurrentEpoch)
+
+	store := ctx.KVStore(k.storeKey)
+
+	record := types.RedeemRateRecord{
+		ChainID:         chainID,
+		Epoch:           epoch,
+		Redemptionratio: rate,
+		Height:          ctx.BlockHeight(),
+	}
+	store.Set(types.GetRedeemRateRecordKey([]byte(chainID), epoch), k.cdc.MustMarshal(&record))
+
+	if retention == 0 || epoch < retention {
+		return
+	}
+
+	historyStore := prefix.NewStore(store, types.GetRedeemRateHistoryPrefix([]byte(chainID)))
+	iterator := historyStore.Iterator(nil, sdk.Uint64ToBigEndian(epoch-retention+1))
+	defer iterator.Close()
 
-		k.SetSourceChain(ctx, sourcechain)
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+
+	for _, key := range expiredKeys {
+		historyStore.Delete(key)
 	}
 }
 
-func (k Keeper) GetDelegaionProcessingAmount(delegations []types.ProxyDelegation) map[string]math.Int {
-	chainAmts := make(map[string]math.Int)
-	for _, delegation := range delegations {
-		if !types.IsProxyDelegationProcessing(delegation.Status) {
-			continue
-		}
-		if delegation.Coin.Amount.IsZero() {
-			continue
-		}
+// ResumeSourceChain resume the halted source chain, the redeem rate is recomputed without bounds check.
+func (k Keeper) ResumeSourceChain(ctx sdk.Context, chainID string) error {
+	sourceChain, found := k.GetSourceChain(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID %s", chainID)
+	}
 
-		amt, found := chainAmts[delegation.ChainID]
+	if !sourceChain.Halted {
+		return sdkerrors.Wrapf(types.ErrSourceChainNotHalted, "chainID %s", chainID)
+	}
 
-		userDelegationAmt := delegation.Coin.Amount
-		if !delegation.ReinvestAmount.IsZero() {
-			userDelegationAmt = userDelegationAmt.Sub(delegation.ReinvestAmount)
-		}
+	_, processingAmount := k.GetProxyDelegationProcessingAmount(ctx, chainID)
 
-		if !found {
-			chainAmts[delegation.ChainID] = userDelegationAmt
-		} else {
-			chainAmts[delegation.ChainID] = userDelegationAmt.Add(amt)
-		}
+	sourceChain.Halted = false
+	if sourceChain.WindDown != nil {
+		k.SetSourceChain(ctx, sourceChain)
+		return nil
 	}
-	return chainAmts
+
+	sourceChain.Redemptionratio = k.calculateRedeemRate(ctx, sourceChain, processingAmount)
+	k.SetSourceChain(ctx, sourceChain)
+
+	k.recordRedeemRate(ctx, chainID, sourceChain.Redemptionratio, k.GetParams(ctx).RedeemRateHistoryRetention)
+
+	return nil
+}
+
+// calculateRedeemRate calculate the redeem rate of source chain by the staked amount, the amount of
+// ProxyDelegation which is processing and the supply of derivative token.
+func (k Keeper) calculateRedeemRate(ctx sdk.Context, sourcechain *types.SourceChain, processingAmount math.Int) sdk.Dec {
+	// If the status of a ProxyDelegation is `ProxyDelegationDone`, it maybe remove from the store.
+	// So the stakingAmount = stakedAmount.
+	stakingAmount := sourcechain.StakedAmount
+	if stakingAmount.IsNil() {
+		stakingAmount = math.ZeroInt()
+	}
+
+	stakingAmount = stakingAmount.Add(processingAmount)
+
+	derivationAmount := k.bankKeeper.GetSupply(ctx, sourcechain.DerivativeDenom)
+
+	if derivationAmount.IsZero() || stakingAmount.IsZero() {
+		// TODO precise
+		return sdk.NewDec(1)
+	}
+
+	return sdk.NewDecFromInt(stakingAmount).Quo(sdk.NewDecFromInt(derivationAmount.Amount))
 }
 
 // ClaimUnbonding implement delegator claim reward and stake token.
@@ -97,6 +176,10 @@ func (k Keeper) ClaimUnbonding(ctx sdk.Context, deletator sdk.AccAddress, epoch
 		return math.ZeroInt(), sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID %s", chainID)
 	}
 
+	if !sourceChain.Status.WithdrawAllowed() {
+		return math.ZeroInt(), sdkerrors.Wrapf(types.ErrSourceChainPaused, "chainID: %s, status: %s", chainID, sourceChain.Status)
+	}
+
 	chainDelegatorAccAddress, err := sdk.AccAddressFromBech32(sourceChain.DelegateAddress)
 	if err != nil {
 		return math.ZeroInt(), err
@@ -109,6 +192,15 @@ func (k Keeper) ClaimUnbonding(ctx sdk.Context, deletator sdk.AccAddress, epoch
 
 	undelegationRecord.CliamStatus = types.UserUnbondingComplete
 
-	k.SetUserUnbonding(ctx, undelegationRecord)
+	// the proxy unbondings of the epoch have been pruned, so the complete one is not kept.
+	if _, found := k.GetEpochProxyUnboundings(ctx, epoch); found {
+		k.SetUserUnbonding(ctx, undelegationRecord)
+	} else {
+		k.deleteUserUnbonding(ctx, undelegationRecord)
+	}
+
+	k.recordUserHistory(ctx, deletator.String(), types.UserHistoryClaim, chainID, epoch,
+		undelegationRecord.RedeemCoin, undelegationRecord.RedeemCoin)
+
 	return math.ZeroInt(), nil
 }
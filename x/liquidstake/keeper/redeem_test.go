@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 	"github.com/celinium-network/celinium/app"
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
 	"github.com/celinium-network/celinium/x/liquidstake/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -50,6 +51,15 @@ func (suite *KeeperTestSuite) TestRedeemAfterUnbondingComplete() {
 	balAfter := controlChainApp.BankKeeper.GetBalance(ctx, ctlChainUserAccAddr, sourceChainParams.IbcDenom)
 	suite.NoError(err)
 	suite.True(balAfter.Sub(balBefore).Amount.Equal(testCoin.Amount))
+
+	// the claim is recorded with the redeemed token.
+	querier := keeper.Querier{Keeper: controlChainApp.LiquidStakeKeeper}
+	res, err := querier.UserHistory(sdk.WrapSDKContext(ctx), &types.QueryUserHistoryRequest{Delegator: ctlChainUserAddr})
+	suite.Require().NoError(err)
+	claim := res.Entries[len(res.Entries)-1]
+	suite.Require().Equal(types.UserHistoryClaim, claim.Action)
+	suite.Require().Equal(sdk.NewCoin(sourceChainParams.IbcDenom, testCoin.Amount), claim.Amount)
+	suite.Require().Equal(claim.Amount, claim.Received)
 }
 
 func (suite *KeeperTestSuite) TestUpdateRedeemRateWithMultiDelegationStatus() {
@@ -98,8 +108,13 @@ func (suite *KeeperTestSuite) TestUpdateRedeemRateWithMultiDelegationStatus() {
 	controlChainApp := getCeliniumApp(suite.controlChain)
 	ctx := suite.controlChain.GetContext()
 
+	for i := range proxyDelegations {
+		proxyDelegations[i].Id = uint64(i + 1)
+		controlChainApp.LiquidStakeKeeper.SetProxyDelegation(ctx, proxyDelegations[i].Id, &proxyDelegations[i])
+	}
+
 	controlChainApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{sdk.Coin{Denom: srcChainParams.DerivativeDenom, Amount: deriveAmt}})
-	controlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx, proxyDelegations)
+	controlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx)
 
 	sourceChain, found := controlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
 	suite.True(found)
@@ -120,18 +135,22 @@ func (suite *KeeperTestSuite) TestUpdateRedeemRateEffect() {
 	delegationEpochInfo := suite.delegationEpoch()
 	suite.setSourceChainAndEpoch(srcChainParams, delegationEpochInfo)
 
+	// the redeem rate is doubled by the mocked reinvest, the bounds are disabled.
+	params := types.DefaultParams()
+	params.MaxRedeemRateIncrease = sdk.ZeroDec()
+	params.MaxRedeemRateDecrease = sdk.ZeroDec()
+	suite.NoError(ctlChainApp.LiquidStakeKeeper.SetParams(ctx, params))
+
 	suite.mockEnvAfterDelegate(srcChainParams, ctlChainApp, ctx, ctlAccAddr, amount)
 
-	proxyDelegations := ctlChainApp.LiquidStakeKeeper.GetAllProxyDelegation(ctx)
-	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx, proxyDelegations)
+	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx)
 
 	sourceChain, found := ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
 	suite.True(found)
 	suite.True(sourceChain.Redemptionratio.Equal(sdk.MustNewDecFromStr("1.0")))
 
 	suite.mockEnvAfterReinvest(srcChainParams, ctlChainApp, ctx, amount)
-	proxyDelegations = ctlChainApp.LiquidStakeKeeper.GetAllProxyDelegation(ctx)
-	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx, proxyDelegations)
+	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx)
 
 	sourceChain, found = ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
 	suite.True(found)
@@ -152,7 +171,7 @@ func (suite *KeeperTestSuite) TestUpdateRedeemRateEffect() {
 	suite.burnTestCoin(ctlChainApp, ctx, derivativeCoin, ctlAccAddr2)
 	ctlAccAddrBalance := suite.getBalance(ctlChainApp, ctx, srcChainParams.DerivativeDenom, ctlAccAddr)
 	suite.burnTestCoin(ctlChainApp, ctx, ctlAccAddrBalance, ctlAccAddr)
-	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx, []types.ProxyDelegation{})
+	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx)
 
 	// check ratio
 	sourceChain, found = ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
@@ -160,6 +179,54 @@ func (suite *KeeperTestSuite) TestUpdateRedeemRateEffect() {
 	suite.True(sourceChain.Redemptionratio.Equal(sdk.MustNewDecFromStr("1.0")))
 }
 
+func (suite *KeeperTestSuite) TestRedeemRateCircuitBreaker() {
+	srcChainParams := suite.mockSourceChainParams()
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	ctlAccAddr := suite.controlChain.SenderAccount.GetAddress()
+
+	amount := sdk.NewIntFromUint64(10000000)
+	delegationEpochInfo := suite.delegationEpoch()
+	suite.setSourceChainAndEpoch(srcChainParams, delegationEpochInfo)
+
+	params := types.DefaultParams()
+	params.MaxRedeemRateIncrease = sdk.NewDecWithPrec(5, 1)
+	suite.NoError(ctlChainApp.LiquidStakeKeeper.SetParams(ctx, params))
+
+	suite.mockEnvAfterDelegate(srcChainParams, ctlChainApp, ctx, ctlAccAddr, amount)
+	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx)
+
+	// the rate is doubled by reinvest, which exceed the bound.
+	suite.mockEnvAfterReinvest(srcChainParams, ctlChainApp, ctx, amount)
+	ctlChainApp.LiquidStakeKeeper.UpdateRedeemRate(ctx)
+
+	sourceChain, found := ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
+	suite.True(found)
+	suite.True(sourceChain.Halted)
+	suite.True(sourceChain.Redemptionratio.Equal(sdk.OneDec()))
+
+	_, err := ctlChainApp.LiquidStakeKeeper.Delegate(ctx, srcChainParams.ChainID, amount, ctlAccAddr)
+	suite.ErrorIs(err, types.ErrSourceChainHalted)
+	_, err = ctlChainApp.LiquidStakeKeeper.Undelegate(ctx, srcChainParams.ChainID, amount, ctlAccAddr)
+	suite.ErrorIs(err, types.ErrSourceChainHalted)
+
+	msgServer := keeper.NewMsgServerImpl(&ctlChainApp.LiquidStakeKeeper)
+	_, err = msgServer.ResumeSourceChain(ctx, &types.MsgResumeSourceChain{
+		Authority: ctlChainApp.LiquidStakeKeeper.GetAuthority(),
+		ChainID:   srcChainParams.ChainID,
+	})
+	suite.NoError(err)
+
+	sourceChain, _ = ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, srcChainParams.ChainID)
+	suite.False(sourceChain.Halted)
+	suite.True(sourceChain.Redemptionratio.Equal(sdk.NewDec(2)))
+
+	res, err := suite.queryClient.RedeemRateHistory(ctx, &types.QueryRedeemRateHistoryRequest{ChainID: srcChainParams.ChainID})
+	suite.NoError(err)
+	suite.Len(res.Records, 1)
+	suite.True(res.Records[0].Redemptionratio.Equal(sdk.NewDec(2)))
+}
+
 func (suite *KeeperTestSuite) mockEnvAfterDelegate(srcChainParams *types.SourceChain,
 	app *app.App, ctx sdk.Context, delegator sdk.AccAddress, amount math.Int,
 ) {
@@ -220,3 +287,44 @@ func (suite *KeeperTestSuite) burnTestCoin(app *app.App, ctx sdk.Context, coin s
 func (suite *KeeperTestSuite) getBalance(app *app.App, ctx sdk.Context, denom string, dest sdk.AccAddress) sdk.Coin {
 	return app.BankKeeper.GetBalance(ctx, dest, denom)
 }
+
+func (suite *KeeperTestSuite) TestProxyDelegationProcessingAmount() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+	chainID := "source.chain-1"
+
+	delegation := types.ProxyDelegation{
+		Id:             100,
+		Coin:           sdk.NewCoin("stake", sdk.NewInt(1000)),
+		Status:         types.ProxyDelegationPending,
+		ChainID:        chainID,
+		ReinvestAmount: sdk.NewInt(400),
+	}
+	keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+
+	otherChainDelegation := delegation
+	otherChainDelegation.Id = 101
+	otherChainDelegation.ChainID = "source.chain-2"
+	keeper.SetProxyDelegation(ctx, otherChainDelegation.Id, &otherChainDelegation)
+
+	amount, userAmount := keeper.GetProxyDelegationProcessingAmount(ctx, chainID)
+	suite.Require().Equal(sdk.NewInt(1000), amount)
+	suite.Require().Equal(sdk.NewInt(600), userAmount)
+
+	// the amounts follow the status of the ProxyDelegation.
+	delegation.Status = types.ProxyDelegating
+	keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+	amount, userAmount = keeper.GetProxyDelegationProcessingAmount(ctx, chainID)
+	suite.Require().Equal(sdk.NewInt(1000), amount)
+	suite.Require().Equal(sdk.NewInt(600), userAmount)
+
+	delegation.Status = types.ProxyDelegationDone
+	keeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+	amount, userAmount = keeper.GetProxyDelegationProcessingAmount(ctx, chainID)
+	suite.Require().True(amount.IsZero())
+	suite.Require().True(userAmount.IsZero())
+
+	amount, _ = keeper.GetProxyDelegationProcessingAmount(ctx, otherChainDelegation.ChainID)
+	suite.Require().Equal(sdk.NewInt(1000), amount)
+}
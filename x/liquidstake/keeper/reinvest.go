@@ -21,7 +21,7 @@ func (k Keeper) StartReinvest(ctx sdk.Context) {
 		bz := iterator.Value()
 		k.cdc.MustUnmarshal(bz, sourcechain)
 
-		if !k.sourceChainAvaiable(ctx, sourcechain) {
+		if !k.sourceChainAvaiable(ctx, sourcechain) || !sourcechain.Status.StakingAllowed() {
 			continue
 		}
 
@@ -50,9 +50,19 @@ func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
 
 	app := celiniumapp.NewApp(log.NewNopLogger(), db, nil, true, nil, "", 0, encCdc, celiniumapp.EmptyAppOptions{})
 
-	// replace production epoch module genesis by default epoch module genesis
+	// replace production epoch module genesis by default epoch module genesis, the required
+	// epochs never start by themselves, the tests start them when needed.
+	epochsGenesis := epochtypes.DefaultGenesisState()
+	for _, required := range app.EpochsKeeper.RequiredEpochs() {
+		epochsGenesis.Epochs = append(epochsGenesis.Epochs, epochtypes.EpochInfo{
+			Identifier: required.Identifier,
+			StartTime:  time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC),
+			Duration:   required.DefaultDuration,
+		})
+	}
+
 	genesisState := celiniumapp.NewDefaultGenesisState(encCdc.Codec)
-	genesisState[epochtypes.ModuleName] = encCdc.Codec.MustMarshalJSON(epochtypes.DefaultGenesisState())
+	genesisState[epochtypes.ModuleName] = encCdc.Codec.MustMarshalJSON(epochsGenesis)
 
 	return app, genesisState
 }
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"net/url"
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// HandleSourceChainSlash compare the delegation amount in the host validator snapshot with the recorded
+// `TokenAmount` of validators. If the delegation on source chain is less than the recorded, the validator
+// has been slashed, then write down the amount of validator and recompute the redeem rate immediately.
+// If the recomputed redeem rate exceeds the bounds, nothing is written down but the source chain is halted.
+func (k Keeper) HandleSourceChainSlash(ctx sdk.Context, sourceChain *types.SourceChain, snapshot types.HostValidatorSnapshot) {
+	// the undelegation has been executed on source chain, but the ack has not been received,
+	// so the recorded amount is greater than the delegation on source chain.
+	if k.hasStartedProxyUnbonding(ctx, sourceChain.ChainID) {
+		return
+	}
+
+	// the delegation, which may include the reinvested rewards, is not acknowledged, so the
+	// snapshot may be taken either before or after it's executed on source chain.
+	if k.hasDelegatingProxyDelegation(ctx, sourceChain.ChainID) {
+		return
+	}
+
+	hostDelegations := make(map[string]math.Int)
+	for _, v := range snapshot.Validators {
+		if v.DelegationAmount.IsNil() {
+			continue
+		}
+		hostDelegations[v.Address] = v.DelegationAmount
+	}
+
+	// the slash is applied to a copy, the source chain is written only if the redeem rate is in bounds.
+	slashedChain := *sourceChain
+	slashedChain.Validators = append([]types.Validator(nil), sourceChain.Validators...)
+
+	var slashEvents sdk.Events
+	for i, v := range slashedChain.Validators {
+		hostAmt, found := hostDelegations[v.Address]
+		if !found || v.TokenAmount.IsNil() || hostAmt.GTE(v.TokenAmount) {
+			continue
+		}
+
+		slashAmt := v.TokenAmount.Sub(hostAmt)
+		slashedChain.Validators[i].TokenAmount = hostAmt
+		slashedChain.StakedAmount = slashedChain.StakedAmount.Sub(slashAmt)
+
+		if !v.Slashed {
+			slashedChain.Validators[i].Slashed = true
+			slashedChain.Validators[i].Weight = slashedChain.SelectionPolicy.SlashedWeight(v.Weight)
+		}
+
+		slashEvents = append(slashEvents, sdk.NewEvent(
+			types.EventTypeSourceChainSlash,
+			sdk.NewAttribute(types.AttributeKeySourceChainID, sourceChain.ChainID),
+			sdk.NewAttribute(types.AttributeKeyValidator, v.Address),
+			sdk.NewAttribute(types.AttributeKeySlashAmt, slashAmt.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(snapshot.Height, 10)),
+		))
+	}
+
+	if len(slashEvents) == 0 {
+		return
+	}
+
+	_, processingAmount := k.GetProxyDelegationProcessingAmount(ctx, sourceChain.ChainID)
+
+	rate := k.calculateRedeemRate(ctx, &slashedChain, processingAmount)
+	if !k.GetParams(ctx).RedeemRateInBounds(sourceChain.Redemptionratio, rate) {
+		// halt the source chain with the recorded amounts, governance decides how to handle the slash.
+		k.setRedeemRate(ctx, sourceChain, rate)
+		return
+	}
+
+	ctx.EventManager().EmitEvents(slashEvents)
+
+	slashedChain.TokenAmountHostHeight = snapshot.Height
+	*sourceChain = slashedChain
+	k.setRedeemRate(ctx, sourceChain, rate)
+}
+
+// VerifyHostDelegations verify the delegation amounts in the snapshot by the merkle proofs of the source chain
+// staking store, which are checked against the consensus state of source chain light client at the snapshot height.
+// Every validator in the snapshot with delegation amount must have a proof, and the proven amount must be equal.
+func (k Keeper) VerifyHostDelegations(ctx sdk.Context, sourceChain *types.SourceChain, snapshot types.HostValidatorSnapshot, proofs []types.HostDelegationProof) error {
+	proofOfValidator := make(map[string]types.HostDelegationProof)
+	for _, proof := range proofs {
+		proofOfValidator[proof.Validator] = proof
+	}
+
+	var root []byte
+	for _, v := range snapshot.Validators {
+		if v.DelegationAmount.IsNil() {
+			continue
+		}
+
+		proof, found := proofOfValidator[v.Address]
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidSnapshot, "missing delegation proof of validator %s", v.Address)
+		}
+
+		if root == nil {
+			consensusRoot, err := k.getSourceChainConsensusRoot(ctx, sourceChain, snapshot.Height)
+			if err != nil {
+				return err
+			}
+			root = consensusRoot
+		}
+
+		amount, err := k.verifyHostDelegation(ctx, sourceChain, root, proof)
+		if err != nil {
+			return err
+		}
+
+		if !amount.Equal(v.DelegationAmount) {
+			return sdkerrors.Wrapf(types.ErrInvalidSnapshot, "delegation amount of validator %s is %s, but proven %s",
+				v.Address, v.DelegationAmount, amount)
+		}
+	}
+
+	return nil
+}
+
+// getSourceChainConsensusRoot return the app hash of source chain which commits the state at height.
+func (k Keeper) getSourceChainConsensusRoot(ctx sdk.Context, sourceChain *types.SourceChain, height uint64) ([]byte, error) {
+	connection, found := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, sourceChain.ConnectionID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSnapshot, "connection %s not found", sourceChain.ConnectionID)
+	}
+
+	clientState, found := k.ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSnapshot, "client %s not found", connection.ClientId)
+	}
+
+	// the state of height is committed by the app hash in the header of next height.
+	consensusHeight := clienttypes.NewHeight(clientState.GetLatestHeight().GetRevisionNumber(), height+1)
+	consensusState, found := k.ibcKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, consensusHeight)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSnapshot, "consensus state of client %s at %s not found",
+			connection.ClientId, consensusHeight)
+	}
+
+	return consensusState.GetRoot().GetHash(), nil
+}
+
+// verifyHostDelegation verify the delegation of the delegate interchain account and return its token amount.
+func (k Keeper) verifyHostDelegation(ctx sdk.Context, sourceChain *types.SourceChain, root []byte, proof types.HostDelegationProof) (math.Int, error) {
+	delegatorAddr, err := k.GetSourceChainAddr(ctx, sourceChain.ConnectionID, sourceChain.DelegateAddress)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	_, delegatorBz, err := bech32.DecodeAndConvert(delegatorAddr)
+	if err != nil {
+		return math.Int{}, sdkerrors.Wrap(types.ErrInvalidSnapshot, err.Error())
+	}
+
+	_, validatorBz, err := bech32.DecodeAndConvert(proof.Validator)
+	if err != nil {
+		return math.Int{}, sdkerrors.Wrap(types.ErrInvalidSnapshot, err.Error())
+	}
+
+	delegationKey := stakingtypes.GetDelegationKey(delegatorBz, validatorBz)
+	if err := k.verifyStakingStoreMembership(root, delegationKey, proof.Delegation, proof.DelegationProof); err != nil {
+		return math.Int{}, sdkerrors.Wrapf(types.ErrInvalidSnapshot, "invalid delegation proof of validator %s: %s", proof.Validator, err)
+	}
+
+	validatorKey := stakingtypes.GetValidatorKey(validatorBz)
+	if err := k.verifyStakingStoreMembership(root, validatorKey, proof.ValidatorInfo, proof.ValidatorProof); err != nil {
+		return math.Int{}, sdkerrors.Wrapf(types.ErrInvalidSnapshot, "invalid validator proof of validator %s: %s", proof.Validator, err)
+	}
+
+	delegation, err := stakingtypes.UnmarshalDelegation(k.cdc, proof.Delegation)
+	if err != nil {
+		return math.Int{}, sdkerrors.Wrap(types.ErrInvalidSnapshot, err.Error())
+	}
+
+	validator, err := stakingtypes.UnmarshalValidator(k.cdc, proof.ValidatorInfo)
+	if err != nil {
+		return math.Int{}, sdkerrors.Wrap(types.ErrInvalidSnapshot, err.Error())
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt(), nil
+}
+
+// verifyStakingStoreMembership verify the key value pair is in the staking store of source chain.
+func (k Keeper) verifyStakingStoreMembership(root []byte, key []byte, value []byte, proofBz []byte) error {
+	var proof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(proofBz, &proof); err != nil {
+		return err
+	}
+
+	// the keys of merkle path are url escaped, the binary key must be escaped too.
+	path := commitmenttypes.NewMerklePath(stakingtypes.StoreKey, url.PathEscape(string(key)))
+
+	return proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), commitmenttypes.NewMerkleRoot(root), path, value)
+}
+
+// hasStartedProxyUnbonding return true if there is a ProxyUnbonding of source chain which is
+// undelegating on source chain but not acknowledged.
+func (k Keeper) hasStartedProxyUnbonding(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetStartedProxyUnbondingPrefix(chainID))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// hasDelegatingProxyDelegation return true if there is a ProxyDelegation of source chain which is
+// delegating on source chain but not acknowledged.
+func (k Keeper) hasDelegatingProxyDelegation(ctx sdk.Context, chainID string) bool {
+	amount, _ := k.getProxyDelegationAmount(ctx, chainID, types.ProxyDelegating)
+
+	return amount.IsPositive()
+}
+
+// recordTokenAmountHostHeight record the latest height of source chain known by the light client as the
+// height of the latest change of the validator token amounts. The acknowledgement of the change is proven
+// at a height not less than it's executed, so the snapshot above the recorded height reflects the change.
+func (k Keeper) recordTokenAmountHostHeight(ctx sdk.Context, sourceChain *types.SourceChain) {
+	connection, found := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, sourceChain.ConnectionID)
+	if !found {
+		return
+	}
+
+	clientState, found := k.ibcKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return
+	}
+
+	if height := clientState.GetLatestHeight().GetRevisionHeight(); height > sourceChain.TokenAmountHostHeight {
+		sourceChain.TokenAmountHostHeight = height
+	}
+}
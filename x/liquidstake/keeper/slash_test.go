@@ -0,0 +1,294 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v6/modules/core/23-commitment/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// queryStakingStoreWithProof query the value of key in the staking store of source chain with its merkle proof,
+// the height of the queried state is returned.
+func (suite *KeeperTestSuite) queryStakingStoreWithProof(key []byte) ([]byte, []byte, uint64) {
+	res := suite.sourceChain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", stakingtypes.StoreKey),
+		Height: suite.sourceChain.App.LastBlockHeight() - 1,
+		Data:   key,
+		Prove:  true,
+	})
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	suite.NoError(err)
+
+	proof, err := getCeliniumApp(suite.sourceChain).AppCodec().Marshal(&merkleProof)
+	suite.NoError(err)
+
+	return res.Value, proof, uint64(res.Height)
+}
+
+// mockHostDelegationProofs return the proofs of the delegations of delegator on source chain and the amount of them.
+func (suite *KeeperTestSuite) mockHostDelegationProofs(delegator sdk.AccAddress, validators []types.Validator) ([]types.HostDelegationProof, map[string]math.Int, uint64) {
+	srcChainApp := getCeliniumApp(suite.sourceChain)
+	srcCtx := suite.sourceChain.GetContext()
+
+	var (
+		proofs  []types.HostDelegationProof
+		height  uint64
+		amounts = make(map[string]math.Int)
+	)
+	for _, v := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(v.Address)
+		suite.NoError(err)
+
+		delegation, found := srcChainApp.StakingKeeper.GetDelegation(srcCtx, delegator, valAddr)
+		suite.True(found)
+		validator, found := srcChainApp.StakingKeeper.GetValidator(srcCtx, valAddr)
+		suite.True(found)
+		amounts[v.Address] = validator.TokensFromShares(delegation.Shares).TruncateInt()
+
+		delegationBz, delegationProof, h := suite.queryStakingStoreWithProof(stakingtypes.GetDelegationKey(delegator, valAddr))
+		validatorBz, validatorProof, _ := suite.queryStakingStoreWithProof(stakingtypes.GetValidatorKey(valAddr))
+		height = h
+
+		proofs = append(proofs, types.HostDelegationProof{
+			Validator:       v.Address,
+			Delegation:      delegationBz,
+			DelegationProof: delegationProof,
+			ValidatorInfo:   validatorBz,
+			ValidatorProof:  validatorProof,
+		})
+	}
+
+	// the control chain light client has the consensus state which commits the queried state.
+	suite.NoError(suite.icaPath.EndpointB.UpdateClient())
+
+	return proofs, amounts, height
+}
+
+func (suite *KeeperTestSuite) TestHandleSourceChainSlash() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+
+	// the genesis delegations of source chain are made by its sender account, use it as the delegate ICA.
+	hostDelegator := suite.sourceChain.SenderAccount.GetAddress()
+	sourceChain := suite.mockSourceChainParams()
+	sourceChain.DelegateAddress = suite.controlChain.SenderAccount.GetAddress().String()
+
+	proofs, hostAmounts, height := suite.mockHostDelegationProofs(hostDelegator, sourceChain.Validators)
+
+	ctx := suite.controlChain.GetContext()
+	portID, err := icatypes.NewControllerPortID(sourceChain.DelegateAddress)
+	suite.NoError(err)
+	ctlChainApp.ICAControllerKeeper.SetInterchainAccountAddress(ctx, sourceChain.ConnectionID, portID, hostDelegator.String())
+
+	stakedAmount := math.ZeroInt()
+	for i, v := range sourceChain.Validators {
+		sourceChain.Validators[i].TokenAmount = hostAmounts[v.Address]
+		if i == 0 {
+			// the first validator is slashed, the recorded amount is greater than the delegation on source chain.
+			sourceChain.Validators[i].TokenAmount = hostAmounts[v.Address].MulRaw(10).QuoRaw(9)
+		}
+		stakedAmount = stakedAmount.Add(sourceChain.Validators[i].TokenAmount)
+	}
+	sourceChain.StakedAmount = stakedAmount
+	sourceChain.SelectionPolicy.SnapshotSubmitters = []string{suite.controlChain.SenderAccount.GetAddress().String()}
+	sourceChain.SelectionPolicy.SlashedWeightFactor = sdk.NewDecWithPrec(5, 1)
+	ctlChainApp.LiquidStakeKeeper.SetSourceChain(ctx, sourceChain)
+
+	derivative := sdk.NewCoins(sdk.NewCoin(sourceChain.DerivativeDenom, stakedAmount))
+	suite.NoError(ctlChainApp.BankKeeper.MintCoins(ctx, types.ModuleName, derivative))
+
+	snapshotWithAmount := func(slashedAmt math.Int) types.HostValidatorSnapshot {
+		var hostValidators []types.HostValidator
+		for i, v := range sourceChain.Validators {
+			delegationAmt := hostAmounts[v.Address]
+			if i == 0 {
+				delegationAmt = slashedAmt
+			}
+			hostValidators = append(hostValidators, types.HostValidator{
+				Address:          v.Address,
+				Commission:       sdk.NewDecWithPrec(5, 2),
+				Uptime:           sdk.OneDec(),
+				VotingPower:      math.NewInt(1000000),
+				DelegationAmount: delegationAmt,
+			})
+		}
+
+		return types.HostValidatorSnapshot{
+			ChainID:    sourceChain.ChainID,
+			Height:     height,
+			Validators: hostValidators,
+		}
+	}
+
+	submitter := suite.controlChain.SenderAccount.GetAddress().String()
+	hostSlashedAmt := hostAmounts[sourceChain.Validators[0].Address]
+
+	// the snapshot without proofs is rejected
+	err = ctlChainApp.LiquidStakeKeeper.SubmitHostValidatorSnapshot(ctx, submitter, snapshotWithAmount(hostSlashedAmt), nil)
+	suite.ErrorIs(err, types.ErrInvalidSnapshot)
+
+	// the amount which is not equal to the proven is rejected
+	err = ctlChainApp.LiquidStakeKeeper.SubmitHostValidatorSnapshot(ctx, submitter, snapshotWithAmount(hostSlashedAmt.QuoRaw(2)), proofs)
+	suite.ErrorIs(err, types.ErrInvalidSnapshot)
+
+	// the snapshot which is taken before the latest delegation change is rejected
+	sourceChain.TokenAmountHostHeight = height
+	ctlChainApp.LiquidStakeKeeper.SetSourceChain(ctx, sourceChain)
+	err = ctlChainApp.LiquidStakeKeeper.SubmitHostValidatorSnapshot(ctx, submitter, snapshotWithAmount(hostSlashedAmt), proofs)
+	suite.ErrorIs(err, types.ErrInvalidSnapshot)
+	sourceChain.TokenAmountHostHeight = 0
+	ctlChainApp.LiquidStakeKeeper.SetSourceChain(ctx, sourceChain)
+
+	err = ctlChainApp.LiquidStakeKeeper.SubmitHostValidatorSnapshot(ctx, submitter, snapshotWithAmount(hostSlashedAmt), proofs)
+	suite.NoError(err)
+
+	slashAmt := sourceChain.Validators[0].TokenAmount.Sub(hostSlashedAmt)
+	slashedChain, _ := ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, sourceChain.ChainID)
+	suite.True(slashedChain.StakedAmount.Equal(stakedAmount.Sub(slashAmt)))
+	suite.True(slashedChain.Validators[0].TokenAmount.Equal(hostSlashedAmt))
+	suite.True(slashedChain.Validators[0].Slashed)
+	suite.Equal(sourceChain.Validators[0].Weight/2, slashedChain.Validators[0].Weight)
+	suite.Equal(height, slashedChain.TokenAmountHostHeight)
+	suite.True(slashedChain.Redemptionratio.Equal(
+		sdk.NewDecFromInt(stakedAmount.Sub(slashAmt)).Quo(sdk.NewDecFromInt(stakedAmount))))
+
+	foundEvent := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeSourceChainSlash {
+			foundEvent = true
+		}
+	}
+	suite.True(foundEvent)
+}
+
+func (suite *KeeperTestSuite) TestHasStartedProxyUnbonding() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	// the slash is not handled when the undelegation is started but not acknowledged.
+	sourceChain := suite.mockSourceChainParams()
+	for i := range sourceChain.Validators {
+		sourceChain.Validators[i].TokenAmount = math.NewInt(10000)
+	}
+	sourceChain.StakedAmount = math.NewInt(10000).MulRaw(int64(len(sourceChain.Validators)))
+	keeper.SetSourceChain(ctx, sourceChain)
+
+	started := &types.EpochProxyUnbonding{Epoch: 1, Unbondings: []types.ProxyUnbonding{
+		{ChainID: sourceChain.ChainID, Status: types.ProxyUnbondingStart},
+	}}
+	keeper.SetEpochProxyUnboundings(ctx, started)
+
+	snapshot := types.HostValidatorSnapshot{ChainID: sourceChain.ChainID, Height: 1}
+	for _, v := range sourceChain.Validators {
+		snapshot.Validators = append(snapshot.Validators, types.HostValidator{Address: v.Address, DelegationAmount: math.NewInt(1)})
+	}
+	keeper.HandleSourceChainSlash(ctx, sourceChain, snapshot)
+	notSlashed, _ := keeper.GetSourceChain(ctx, sourceChain.ChainID)
+	suite.True(notSlashed.StakedAmount.Equal(sourceChain.StakedAmount))
+
+	// the index is removed when the unbonding is acknowledged.
+	started.Unbondings[0].Status = types.ProxyUnbondingWaitting
+	keeper.SetEpochProxyUnboundings(ctx, started)
+	store := ctx.KVStore(ctlChainApp.GetKey(types.StoreKey))
+	suite.False(store.Has(types.GetStartedProxyUnbondingKey(sourceChain.ChainID, 1)))
+}
+
+func (suite *KeeperTestSuite) TestHandleSourceChainSlashWhileDelegating() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	lsKeeper := ctlChainApp.LiquidStakeKeeper
+
+	sourceChain := suite.mockSourceChainParams()
+	for i := range sourceChain.Validators {
+		sourceChain.Validators[i].TokenAmount = math.NewInt(10000)
+	}
+	sourceChain.StakedAmount = math.NewInt(10000).MulRaw(int64(len(sourceChain.Validators)))
+	lsKeeper.SetSourceChain(ctx, sourceChain)
+
+	derivative := sdk.NewCoins(sdk.NewCoin(sourceChain.DerivativeDenom, sourceChain.StakedAmount))
+	suite.NoError(ctlChainApp.BankKeeper.MintCoins(ctx, types.ModuleName, derivative))
+
+	delegation := types.ProxyDelegation{
+		Id:          1,
+		Coin:        sdk.NewCoin(sourceChain.IbcDenom, math.NewInt(1000)),
+		Status:      types.ProxyDelegating,
+		EpochNumber: 1,
+		ChainID:     sourceChain.ChainID,
+	}
+	lsKeeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+
+	snapshot := types.HostValidatorSnapshot{ChainID: sourceChain.ChainID, Height: 1}
+	for _, v := range sourceChain.Validators {
+		snapshot.Validators = append(snapshot.Validators, types.HostValidator{Address: v.Address, DelegationAmount: math.NewInt(9000)})
+	}
+
+	// the slash is not handled when the delegation is not acknowledged.
+	lsKeeper.HandleSourceChainSlash(ctx, sourceChain, snapshot)
+	notSlashed, _ := lsKeeper.GetSourceChain(ctx, sourceChain.ChainID)
+	suite.True(notSlashed.StakedAmount.Equal(sourceChain.StakedAmount))
+
+	delegation.Status = types.ProxyDelegationDone
+	lsKeeper.SetProxyDelegation(ctx, delegation.Id, &delegation)
+
+	lsKeeper.HandleSourceChainSlash(ctx, sourceChain, snapshot)
+	slashed, _ := lsKeeper.GetSourceChain(ctx, sourceChain.ChainID)
+	suite.True(slashed.StakedAmount.Equal(math.NewInt(9000).MulRaw(int64(len(sourceChain.Validators)))))
+	suite.True(slashed.Validators[0].Slashed)
+
+	// the slashed flag is cleared by governance.
+	msgServer := keeper.NewMsgServerImpl(&ctlChainApp.LiquidStakeKeeper)
+	_, err := msgServer.ClearValidatorSlashed(ctx, &types.MsgClearValidatorSlashed{
+		Authority:  lsKeeper.GetAuthority(),
+		ChainID:    sourceChain.ChainID,
+		Validators: []string{slashed.Validators[0].Address},
+	})
+	suite.NoError(err)
+	cleared, _ := lsKeeper.GetSourceChain(ctx, sourceChain.ChainID)
+	suite.False(cleared.Validators[0].Slashed)
+}
+
+func (suite *KeeperTestSuite) TestHandleSourceChainSlashOutOfBounds() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	keeper := ctlChainApp.LiquidStakeKeeper
+
+	params := keeper.GetParams(ctx)
+	params.MaxRedeemRateDecrease = sdk.NewDecWithPrec(1, 2)
+	suite.NoError(keeper.SetParams(ctx, params))
+
+	sourceChain := suite.mockSourceChainParams()
+	for i := range sourceChain.Validators {
+		sourceChain.Validators[i].TokenAmount = math.NewInt(10000)
+	}
+	sourceChain.StakedAmount = math.NewInt(10000).MulRaw(int64(len(sourceChain.Validators)))
+	keeper.SetSourceChain(ctx, sourceChain)
+
+	derivative := sdk.NewCoins(sdk.NewCoin(sourceChain.DerivativeDenom, sourceChain.StakedAmount))
+	suite.NoError(ctlChainApp.BankKeeper.MintCoins(ctx, types.ModuleName, derivative))
+
+	// the first validator is slashed 50%, the redeem rate decrease exceeds the bound.
+	snapshot := types.HostValidatorSnapshot{ChainID: sourceChain.ChainID, Height: 1}
+	for i, v := range sourceChain.Validators {
+		amount := v.TokenAmount
+		if i == 0 {
+			amount = amount.QuoRaw(2)
+		}
+		snapshot.Validators = append(snapshot.Validators, types.HostValidator{Address: v.Address, DelegationAmount: amount})
+	}
+	keeper.HandleSourceChainSlash(ctx, sourceChain, snapshot)
+
+	haltedChain, _ := keeper.GetSourceChain(ctx, sourceChain.ChainID)
+	suite.True(haltedChain.Halted)
+	suite.True(haltedChain.StakedAmount.Equal(sourceChain.StakedAmount))
+	suite.True(haltedChain.Validators[0].TokenAmount.Equal(math.NewInt(10000)))
+	suite.False(haltedChain.Validators[0].Slashed)
+	suite.True(haltedChain.Redemptionratio.Equal(sdk.OneDec()))
+}
@@ -2,15 +2,19 @@ package keeper
 
 import (
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 
 	appparams "github.com/celinium-network/celinium/app/params"
 	"github.com/celinium-network/celinium/x/liquidstake/types"
 )
 
-func (k Keeper) AddSouceChain(ctx sdk.Context, sourceChain *types.SourceChain) error {
+// AddSouceChain register a new source chain, create the interchain accounts and the bank metadata of
+// derivative token. The nativeMetadata is optional, see `setDerivativeDenomMetadata`.
+func (k Keeper) AddSouceChain(ctx sdk.Context, sourceChain *types.SourceChain, nativeMetadata *banktypes.Metadata) error {
 	if err := sourceChain.BasicVerify(); err != nil {
 		return sdkerrors.Wrapf(types.ErrSourceChainParameter, "error: %v", err)
 	}
@@ -24,6 +28,14 @@ func (k Keeper) AddSouceChain(ctx sdk.Context, sourceChain *types.SourceChain) e
 		return sdkerrors.Wrapf(types.ErrSourceChainParameter, err.Error())
 	}
 
+	if err := k.validateDerivativeDenom(ctx, sourceChain); err != nil {
+		return err
+	}
+
+	if err := k.setDerivativeDenomMetadata(ctx, sourceChain, nativeMetadata); err != nil {
+		return err
+	}
+
 	connection, found := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, sourceChain.ConnectionID)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrSourceChainParameter, "connection not find: ID %s", sourceChain.ConnectionID)
@@ -74,7 +86,7 @@ func (k Keeper) CreateProxyDelegationForEpoch(ctx sdk.Context, epochNumber uint6
 		bz := iterator.Value()
 		k.cdc.MustUnmarshal(bz, sourcechain)
 
-		if !k.sourceChainAvaiable(ctx, sourcechain) {
+		if !k.sourceChainAvaiable(ctx, sourcechain) || !sourcechain.Status.StakingAllowed() {
 			continue
 		}
 
@@ -126,11 +138,14 @@ func (k Keeper) CreateProxyUnbondingForEpoch(ctx sdk.Context, epochNumber uint64
 	return &epochUnbonding
 }
 
-// GetProxyDelegation return ProxyDelegation by id
+// GetProxyDelegation return ProxyDelegation by id, the done ProxyDelegation is read from the archive.
 func (k Keeper) GetProxyDelegation(ctx sdk.Context, id uint64) (*types.ProxyDelegation, bool) {
 	store := ctx.KVStore(k.storeKey)
 
 	bz := store.Get(types.GetProxyDelegationKey(id))
+	if bz == nil {
+		bz = store.Get(types.GetProxyDelegationArchiveKey(id))
+	}
 	if bz == nil {
 		return nil, false
 	}
@@ -141,13 +156,153 @@ func (k Keeper) GetProxyDelegation(ctx sdk.Context, id uint64) (*types.ProxyDele
 	return delegation, true
 }
 
-// SetProxyDelegation store ProxyDelegation
+// SetProxyDelegation store ProxyDelegation and keep its status index. The done ProxyDelegation
+// is moved into the archive with an epoch index, so that it's never loaded by the epoch processing.
 func (k Keeper) SetProxyDelegation(ctx sdk.Context, id uint64, delegation *types.ProxyDelegation) {
 	store := ctx.KVStore(k.storeKey)
 
+	key := types.GetProxyDelegationKey(id)
+	if bz := store.Get(key); bz != nil {
+		old := types.ProxyDelegation{}
+		k.cdc.MustUnmarshal(bz, &old)
+		store.Delete(types.GetProxyDelegationStatusKey(old.Status, id))
+		k.addProxyDelegationAmount(ctx, &old, true)
+	}
+	k.deleteProxyDelegationArchive(ctx, id)
+
 	bz := k.cdc.MustMarshal(delegation)
 
-	store.Set(types.GetProxyDelegationKey(id), bz)
+	if delegation.Status == types.ProxyDelegationDone {
+		store.Delete(key)
+		store.Set(types.GetProxyDelegationArchiveKey(id), bz)
+		store.Set(types.GetProxyDelegationArchiveEpochKey(delegation.EpochNumber, id), []byte{})
+		return
+	}
+
+	store.Set(key, bz)
+	store.Set(types.GetProxyDelegationStatusKey(delegation.Status, id), []byte{})
+	k.addProxyDelegationAmount(ctx, delegation, false)
+}
+
+// deleteProxyDelegation remove the ProxyDelegation with its status index and archive.
+func (k Keeper) deleteProxyDelegation(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.GetProxyDelegationKey(id)
+	if bz := store.Get(key); bz != nil {
+		delegation := types.ProxyDelegation{}
+		k.cdc.MustUnmarshal(bz, &delegation)
+		store.Delete(types.GetProxyDelegationStatusKey(delegation.Status, id))
+		k.addProxyDelegationAmount(ctx, &delegation, true)
+	}
+
+	store.Delete(key)
+	k.deleteProxyDelegationArchive(ctx, id)
+}
+
+// addProxyDelegationAmount add the amount of the processing ProxyDelegation to the amounts of its chain
+// and status, or subtract it if sub is true.
+func (k Keeper) addProxyDelegationAmount(ctx sdk.Context, delegation *types.ProxyDelegation, sub bool) {
+	amount := delegation.Coin.Amount
+	reinvestAmount := delegation.ReinvestAmount
+	if reinvestAmount.IsNil() {
+		reinvestAmount = math.ZeroInt()
+	}
+	if sub {
+		amount, reinvestAmount = amount.Neg(), reinvestAmount.Neg()
+	}
+
+	for _, entry := range []struct {
+		keyPrefix []byte
+		amount    math.Int
+	}{
+		{types.ProxyDelegationAmountPrefix, amount},
+		{types.ProxyDelegationReinvestAmountPrefix, reinvestAmount},
+	} {
+		if entry.amount.IsNil() || entry.amount.IsZero() {
+			continue
+		}
+
+		key := types.GetProxyDelegationAmountKey(entry.keyPrefix, delegation.ChainID, delegation.Status)
+		k.setIntAmount(ctx, key, k.getIntAmount(ctx, key).Add(entry.amount))
+	}
+}
+
+// getProxyDelegationAmount return the amount and the reinvest amount of the processing ProxyDelegations
+// of chain in status.
+func (k Keeper) getProxyDelegationAmount(ctx sdk.Context, chainID string, status types.ProxyDelegationStatus) (math.Int, math.Int) {
+	return k.getIntAmount(ctx, types.GetProxyDelegationAmountKey(types.ProxyDelegationAmountPrefix, chainID, status)),
+		k.getIntAmount(ctx, types.GetProxyDelegationAmountKey(types.ProxyDelegationReinvestAmountPrefix, chainID, status))
+}
+
+// GetProxyDelegationProcessingAmount return the amount of the processing ProxyDelegations of chain, and
+// the user delegation part of it which excludes the reinvested rewards.
+func (k Keeper) GetProxyDelegationProcessingAmount(ctx sdk.Context, chainID string) (math.Int, math.Int) {
+	amount := k.sumIntAmounts(ctx, types.GetProxyDelegationAmountPrefix(types.ProxyDelegationAmountPrefix, chainID))
+	reinvestAmount := k.sumIntAmounts(ctx, types.GetProxyDelegationAmountPrefix(types.ProxyDelegationReinvestAmountPrefix, chainID))
+
+	return amount, amount.Sub(reinvestAmount)
+}
+
+func (k Keeper) sumIntAmounts(ctx sdk.Context, keyPrefix []byte) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	total := math.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		amount := math.ZeroInt()
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		total = total.Add(amount)
+	}
+
+	return total
+}
+
+func (k Keeper) getIntAmount(ctx sdk.Context, key []byte) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	amount := math.ZeroInt()
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setIntAmount(ctx sdk.Context, key []byte, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// deleteProxyDelegationArchive remove the archived ProxyDelegation with its epoch index.
+func (k Keeper) deleteProxyDelegationArchive(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.GetProxyDelegationArchiveKey(id)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	delegation := types.ProxyDelegation{}
+	k.cdc.MustUnmarshal(bz, &delegation)
+
+	store.Delete(types.GetProxyDelegationArchiveEpochKey(delegation.EpochNumber, id))
+	store.Delete(key)
 }
 
 // GetChianProxyDelegationID get ProxyDelegation's ID of a chain by epoch and chainID
@@ -200,3 +355,26 @@ func (k Keeper) sourceChainAvaiable(ctx sdk.Context, sourceChain *types.SourceCh
 
 	return false
 }
+
+// SetSourceChainStatus set the status of source chain, return the old status.
+func (k Keeper) SetSourceChainStatus(ctx sdk.Context, chainID string, status types.SourceChainStatus) (types.SourceChainStatus, error) {
+	sourceChain, found := k.GetSourceChain(ctx, chainID)
+	if !found {
+		return status, sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID: %s", chainID)
+	}
+
+	if !status.IsValid() {
+		return status, sdkerrors.Wrapf(types.ErrInvalidSourceChainStatus, "status: %d", status)
+	}
+
+	// deregistering is irreversible and must be started by `DeregisterSourceChain`
+	if sourceChain.Status == types.SourceChainDeregistering || status == types.SourceChainDeregistering {
+		return status, sdkerrors.Wrapf(types.ErrInvalidSourceChainStatus, "can't change status from %s to %s", sourceChain.Status, status)
+	}
+
+	oldStatus := sourceChain.Status
+	sourceChain.Status = status
+	k.SetSourceChain(ctx, sourceChain)
+
+	return oldStatus, nil
+}
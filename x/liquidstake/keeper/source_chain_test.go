@@ -5,9 +5,11 @@ import (
 
 	params "github.com/celinium-network/celinium/app/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
 	"github.com/celinium-network/celinium/x/liquidstake/types"
 )
 
@@ -20,7 +22,7 @@ func (suite *KeeperTestSuite) TestAddSourceChain() {
 
 	channelSequence := ctlChainApp.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.controlChain.GetContext())
 
-	err := ctlChainApp.LiquidStakeKeeper.AddSouceChain(suite.controlChain.GetContext(), sourceChain)
+	err := ctlChainApp.LiquidStakeKeeper.AddSouceChain(suite.controlChain.GetContext(), sourceChain, nil)
 	suite.NoError(err)
 	suite.controlChain.NextBlock()
 
@@ -110,3 +112,113 @@ func (suite *KeeperTestSuite) relayICACreatedPacket(packetSequence uint64, ctlAc
 	suite.True(found)
 	suite.Equal(icaFromCtlChain, icaFromSrcChain)
 }
+
+func (suite *KeeperTestSuite) TestSetSourceChainStatus() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	ctlAccAddr := suite.controlChain.SenderAccount.GetAddress()
+
+	sourceChain := suite.mockSourceChainParams()
+	ctlChainApp.LiquidStakeKeeper.SetSourceChain(ctx, sourceChain)
+
+	msgServer := keeper.NewMsgServerImpl(&ctlChainApp.LiquidStakeKeeper)
+	_, err := msgServer.SetSourceChainStatus(ctx, &types.MsgSetSourceChainStatus{
+		Authority: ctlAccAddr.String(),
+		ChainID:   sourceChain.ChainID,
+		Status:    types.SourceChainPausedDeposits,
+	})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.SetSourceChainStatus(ctx, &types.MsgSetSourceChainStatus{
+		Authority: ctlChainApp.LiquidStakeKeeper.GetAuthority(),
+		ChainID:   sourceChain.ChainID,
+		Status:    types.SourceChainPausedDeposits,
+	})
+	suite.NoError(err)
+
+	_, err = ctlChainApp.LiquidStakeKeeper.Delegate(ctx, sourceChain.ChainID, sdk.NewInt(100), ctlAccAddr)
+	suite.ErrorIs(err, types.ErrSourceChainPaused)
+
+	_, err = ctlChainApp.LiquidStakeKeeper.SetSourceChainStatus(ctx, sourceChain.ChainID, types.SourceChainPausedAll)
+	suite.NoError(err)
+
+	_, err = ctlChainApp.LiquidStakeKeeper.Undelegate(ctx, sourceChain.ChainID, sdk.NewInt(100), ctlAccAddr)
+	suite.ErrorIs(err, types.ErrSourceChainPaused)
+
+	res, err := suite.queryClient.SourceChainStatus(ctx, &types.QuerySourceChainStatusRequest{ChainID: sourceChain.ChainID})
+	suite.NoError(err)
+	suite.Equal(types.SourceChainPausedAll.String(), res.Status)
+	suite.False(res.Halted)
+
+	_, err = ctlChainApp.LiquidStakeKeeper.SetSourceChainStatus(ctx, sourceChain.ChainID, types.SourceChainStatus(100))
+	suite.ErrorIs(err, types.ErrInvalidSourceChainStatus)
+}
+
+func (suite *KeeperTestSuite) TestDerivativeDenomMetadata() {
+	sourceChain := suite.mockSourceChainParams()
+
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+	ctlChainApp.EpochsKeeper.SetEpochInfo(ctx, *suite.delegationEpoch())
+
+	nativeMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: sourceChain.NativeDenom, Exponent: 0},
+			{Denom: "celi", Exponent: 6},
+		},
+		Base:    sourceChain.NativeDenom,
+		Display: "celi",
+		Name:    "Celinium",
+		Symbol:  "CELI",
+	}
+
+	err := ctlChainApp.LiquidStakeKeeper.AddSouceChain(ctx, sourceChain, &nativeMetadata)
+	suite.NoError(err)
+
+	metadata, found := ctlChainApp.BankKeeper.GetDenomMetaData(ctx, sourceChain.DerivativeDenom)
+	suite.True(found)
+	suite.Equal("stceli", metadata.Display)
+	suite.Equal("stCELI", metadata.Symbol)
+	suite.Equal(uint32(6), metadata.DenomUnits[1].Exponent)
+	suite.Contains(metadata.Description, sourceChain.ChainID)
+
+	// the derivative denom is used by another source chain
+	otherChain := suite.mockSourceChainParams()
+	otherChain.ChainID = "otherchain"
+	err = ctlChainApp.LiquidStakeKeeper.AddSouceChain(ctx, otherChain, nil)
+	suite.ErrorIs(err, types.ErrInvalidDerivativeDenom)
+
+	otherChain.DerivativeDenom = "ibc/ABCDEF"
+	err = ctlChainApp.LiquidStakeKeeper.AddSouceChain(ctx, otherChain, nil)
+	suite.ErrorIs(err, types.ErrInvalidDerivativeDenom)
+
+	otherChain.DerivativeDenom = sdk.DefaultBondDenom
+	err = ctlChainApp.LiquidStakeKeeper.AddSouceChain(ctx, otherChain, nil)
+	suite.ErrorIs(err, types.ErrInvalidDerivativeDenom)
+
+	msgServer := keeper.NewMsgServerImpl(&ctlChainApp.LiquidStakeKeeper)
+	metadata.Symbol = "DLST"
+	_, err = msgServer.UpdateDerivativeDenomMetadata(ctx, &types.MsgUpdateDerivativeDenomMetadata{
+		Authority: suite.controlChain.SenderAccount.GetAddress().String(),
+		ChainID:   sourceChain.ChainID,
+		Metadata:  metadata,
+	})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.UpdateDerivativeDenomMetadata(ctx, &types.MsgUpdateDerivativeDenomMetadata{
+		Authority: ctlChainApp.LiquidStakeKeeper.GetAuthority(),
+		ChainID:   sourceChain.ChainID,
+		Metadata:  nativeMetadata,
+	})
+	suite.ErrorIs(err, types.ErrInvalidDenomMetadata)
+
+	_, err = msgServer.UpdateDerivativeDenomMetadata(ctx, &types.MsgUpdateDerivativeDenomMetadata{
+		Authority: ctlChainApp.LiquidStakeKeeper.GetAuthority(),
+		ChainID:   sourceChain.ChainID,
+		Metadata:  metadata,
+	})
+	suite.NoError(err)
+
+	metadata, _ = ctlChainApp.BankKeeper.GetDenomMetaData(ctx, sourceChain.DerivativeDenom)
+	suite.Equal("DLST", metadata.Symbol)
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// withdrawBuffer is the time waited after the unbonding completion time on the source chain before
+// the withdrawal, so the unbonded tokens are surely released when the withdrawal is executed.
+const withdrawBuffer = 5 * time.Minute
+
+// ProxyUnbondingQueueIterator returns all the proxy unbonding queue timeslices from time 0 until endTime.
+func (k Keeper) ProxyUnbondingQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ProxyUnbondingQueueKey,
+		sdk.InclusiveEndBytes(types.GetProxyUnbondingQueueTimeKey(endTime)))
+}
+
+// InsertProxyUnbondingQueue inserts the proxy unbonding of chainID in epoch into the time slice of completionTime.
+func (k Keeper) InsertProxyUnbondingQueue(ctx sdk.Context, epoch uint64, chainID string, completionTime time.Time) {
+	pair := types.EpochChainPair{Epoch: epoch, ChainID: chainID}
+
+	timeSlice := k.GetProxyUnbondingQueueTimeSlice(ctx, completionTime)
+	for _, p := range timeSlice {
+		if p.Epoch == pair.Epoch && p.ChainID == pair.ChainID {
+			return
+		}
+	}
+
+	k.SetProxyUnbondingQueueTimeSlice(ctx, completionTime, append(timeSlice, pair))
+}
+
+func (k Keeper) GetProxyUnbondingQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []types.EpochChainPair {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetProxyUnbondingQueueTimeKey(timestamp))
+	if bz == nil {
+		return []types.EpochChainPair{}
+	}
+
+	pairs := types.EpochChainPairs{}
+	k.cdc.MustUnmarshal(bz, &pairs)
+
+	return pairs.Pairs
+}
+
+func (k Keeper) SetProxyUnbondingQueueTimeSlice(ctx sdk.Context, timestamp time.Time, pairs []types.EpochChainPair) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.EpochChainPairs{Pairs: pairs})
+	store.Set(types.GetProxyUnbondingQueueTimeKey(timestamp), bz)
+}
+
+// DequeueAllMatureProxyUnbondingQueue returns and removes all the proxy unbondings which complete
+// until currTime.
+func (k Keeper) DequeueAllMatureProxyUnbondingQueue(ctx sdk.Context, currTime time.Time) (matureUnbondings []types.EpochChainPair) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.ProxyUnbondingQueueIterator(ctx, currTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timeSlice := types.EpochChainPairs{}
+		k.cdc.MustUnmarshal(iterator.Value(), &timeSlice)
+
+		matureUnbondings = append(matureUnbondings, timeSlice.Pairs...)
+
+		store.Delete(iterator.Key())
+	}
+
+	return matureUnbondings
+}
+
+// ProcessMatureProxyUnbondings withdraws the proxy unbondings whose unbonding has completed on the
+// source chain. The unbondings of an unavailable source chain are left waiting, they are withdrawn
+// by the undelegation epoch after the source chain becomes available.
+func (k Keeper) ProcessMatureProxyUnbondings(ctx sdk.Context) {
+	for _, pair := range k.DequeueAllMatureProxyUnbondingQueue(ctx, ctx.BlockTime().Add(-withdrawBuffer)) {
+		epochUnbondings, found := k.GetEpochProxyUnboundings(ctx, pair.Epoch)
+		if !found {
+			continue
+		}
+
+		sourceChain, found := k.GetSourceChain(ctx, pair.ChainID)
+		if !found {
+			continue
+		}
+
+		if !k.sourceChainAvaiable(ctx, sourceChain) || !sourceChain.Status.WithdrawAllowed() {
+			continue
+		}
+
+		amount := sdk.ZeroInt()
+		indexes := make([]int, 0)
+		for i, unbonding := range epochUnbondings.Unbondings {
+			if unbonding.ChainID != pair.ChainID || unbonding.Status != types.ProxyUnbondingWaitting {
+				continue
+			}
+
+			amount = amount.Add(unbonding.RedeemNativeToken.Amount)
+			indexes = append(indexes, i)
+		}
+
+		if amount.IsZero() {
+			continue
+		}
+
+		// the unbondings are left waiting if the withdrawal can't be sent, so the undelegation
+		// epoch retries them.
+		if err := k.withdrawUnbondFromSourceChain(ctx, sourceChain, amount, pair.Epoch); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("withdraw unbonding failed, chainID %s epoch %d, error: %s", pair.ChainID, pair.Epoch, err))
+			continue
+		}
+
+		for _, i := range indexes {
+			epochUnbondings.Unbondings[i].Status = types.ProxyUnbondingWithdraw
+		}
+		k.SetEpochProxyUnboundings(ctx, epochUnbondings)
+	}
+}
+
+// revertProxyUnbondingWithdraw reverts the withdrawing proxy unbondings of chainID in epoch to waiting
+// after the withdrawal failed on the source chain, and queues them to be withdrawn again.
+func (k Keeper) revertProxyUnbondingWithdraw(ctx sdk.Context, epoch uint64, chainID string) {
+	epochUnbondings, found := k.GetEpochProxyUnboundings(ctx, epoch)
+	if !found {
+		return
+	}
+
+	reverted := false
+	for i, unbonding := range epochUnbondings.Unbondings {
+		if unbonding.ChainID != chainID || unbonding.Status != types.ProxyUnbondingWithdraw {
+			continue
+		}
+
+		epochUnbondings.Unbondings[i].Status = types.ProxyUnbondingWaitting
+		reverted = true
+	}
+
+	if !reverted {
+		return
+	}
+
+	k.SetEpochProxyUnboundings(ctx, epochUnbondings)
+	k.InsertProxyUnbondingQueue(ctx, epoch, chainID, ctx.BlockTime())
+}
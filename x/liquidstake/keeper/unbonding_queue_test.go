@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func (suite *KeeperTestSuite) TestProcessMatureProxyUnbondings() {
+	env := suite.mockEpochProxyUnbondingStartedEnv()
+	keeper := env.ctlChainApp.LiquidStakeKeeper
+
+	// make the source chain available by registering its interchain accounts.
+	env.srcChainParams.DelegateAddress = sdk.AccAddress("delegate_address____").String()
+	env.srcChainParams.WithdrawAddress = sdk.AccAddress("withdraw_address____").String()
+	keeper.SetSourceChain(env.ctx, &env.srcChainParams)
+	for _, owner := range []string{env.srcChainParams.DelegateAddress, env.srcChainParams.WithdrawAddress} {
+		portID, err := icatypes.NewControllerPortID(owner)
+		suite.Require().NoError(err)
+		env.ctlChainApp.ICAControllerKeeper.SetInterchainAccountAddress(env.ctx, env.srcChainParams.ConnectionID, portID, owner)
+	}
+
+	completionTime := env.ctx.BlockTime().Add(time.Hour * 24).UTC()
+	undelegateResp, err := codectypes.NewAnyWithValue(&stakingtypes.MsgUndelegateResponse{CompletionTime: completionTime})
+	suite.Require().NoError(err)
+
+	msgResps := make([]*codectypes.Any, len(env.srcChainParams.Validators))
+	for i := range msgResps {
+		msgResps[i] = undelegateResp
+	}
+	ack := channeltypes.NewResultAcknowledgement(env.cdc.MustMarshal(&sdk.TxMsgData{MsgResponses: msgResps}))
+	keeper.HandleIBCAcknowledgement(env.ctx, &env.sendedPacket, channeltypes.SubModuleCdc.MustMarshalJSON(&ack))
+
+	// the completion of the unbonding is scheduled in the queue.
+	suite.Require().Equal([]types.EpochChainPair{{Epoch: env.epoch, ChainID: env.srcChainParams.ChainID}},
+		keeper.GetProxyUnbondingQueueTimeSlice(env.ctx, completionTime))
+
+	unbondingStatus := func(ctx sdk.Context) types.ProxyUnbondingStatus {
+		epochUnbondings, found := keeper.GetEpochProxyUnboundings(ctx, env.epoch)
+		suite.Require().True(found)
+		return epochUnbondings.Unbondings[0].Status
+	}
+
+	ctx := env.ctx.WithBlockTime(completionTime.Add(-time.Second))
+	keeper.EndBlocker(ctx)
+	suite.Require().Equal(types.ProxyUnbondingWaitting, unbondingStatus(ctx))
+
+	// the withdrawal waits for the buffer after the completion time.
+	ctx = env.ctx.WithBlockTime(completionTime.Add(4 * time.Minute))
+	keeper.EndBlocker(ctx)
+	suite.Require().Equal(types.ProxyUnbondingWaitting, unbondingStatus(ctx))
+
+	// the mocked interchain accounts have no open channel, the withdrawal can't be sent so the
+	// unbonding is left waiting for the undelegation epoch to retry it.
+	ctx = env.ctx.WithBlockTime(completionTime.Add(5 * time.Minute))
+	keeper.EndBlocker(ctx)
+	suite.Require().Equal(types.ProxyUnbondingWaitting, unbondingStatus(ctx))
+	suite.Require().Empty(keeper.GetProxyUnbondingQueueTimeSlice(ctx, completionTime))
+}
+
+func (suite *KeeperTestSuite) TestRevertFailedProxyUnbondingWithdraw() {
+	env := suite.mockEpochProxyUnbondingEnv()
+	keeper := env.ctlChainApp.LiquidStakeKeeper
+
+	keeper.SetEpochProxyUnboundings(env.ctx, &types.EpochProxyUnbonding{
+		Epoch: env.epoch,
+		Unbondings: []types.ProxyUnbonding{{
+			ChainID:                env.srcChainParams.ChainID,
+			BurnedDerivativeAmount: env.srcChainParams.StakedAmount,
+			RedeemNativeToken:      sdk.NewCoin(env.srcChainParams.NativeDenom, env.srcChainParams.StakedAmount),
+			Status:                 types.ProxyUnbondingWithdraw,
+		}},
+	})
+
+	callback := types.IBCCallback{
+		CallType: types.WithdrawUnbondCall,
+		Args: string(env.cdc.MustMarshal(&types.UnbondCallbackArgs{
+			Epoch:   env.epoch,
+			ChainID: env.srcChainParams.ChainID,
+		})),
+	}
+
+	tests := []struct {
+		name   string
+		handle func(ctx sdk.Context)
+	}{
+		{
+			"error acknowledgement",
+			func(ctx sdk.Context) {
+				ack := channeltypes.NewErrorAcknowledgement(types.ErrInternalError)
+				keeper.HandleIBCAcknowledgement(ctx, &env.sendedPacket, channeltypes.SubModuleCdc.MustMarshalJSON(&ack))
+			},
+		},
+		{
+			"timeout",
+			func(ctx sdk.Context) {
+				suite.Require().NoError(keeper.HandleIBCTimeout(ctx, &env.sendedPacket))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		ctx, _ := env.ctx.CacheContext()
+		keeper.SetCallBack(ctx, env.sendedPacket.SourceChannel, env.sendedPacket.SourcePort, env.sendedPacket.Sequence, &callback)
+
+		tc.handle(ctx)
+
+		epochUnbondings, found := keeper.GetEpochProxyUnboundings(ctx, env.epoch)
+		suite.Require().True(found, tc.name)
+		suite.Require().Equal(types.ProxyUnbondingWaitting, epochUnbondings.Unbondings[0].Status, tc.name)
+		suite.Require().Equal([]types.EpochChainPair{{Epoch: env.epoch, ChainID: env.srcChainParams.ChainID}},
+			keeper.GetProxyUnbondingQueueTimeSlice(ctx, ctx.BlockTime()), tc.name)
+
+		_, found = keeper.GetCallBack(ctx, env.sendedPacket.SourceChannel, env.sendedPacket.SourcePort, env.sendedPacket.Sequence)
+		suite.Require().False(found, tc.name)
+	}
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"sort"
 	"time"
 
@@ -8,8 +9,8 @@ import (
 
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
-	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -24,6 +25,14 @@ func (k Keeper) Undelegate(ctx sdk.Context, chainID string, amount math.Int, del
 		return nil, sdkerrors.Wrapf(types.ErrUnknownSourceChain, "unknown source chain, chainID: %s", chainID)
 	}
 
+	if sourceChain.Halted {
+		return nil, sdkerrors.Wrapf(types.ErrSourceChainHalted, "chainID: %s", chainID)
+	}
+
+	if !sourceChain.Status.WithdrawAllowed() {
+		return nil, sdkerrors.Wrapf(types.ErrSourceChainPaused, "chainID: %s, status: %s", chainID, sourceChain.Status)
+	}
+
 	epochInfo, found := k.epochKeeper.GetEpochInfo(ctx, appparams.UndelegationEpochIdentifier)
 	if !found {
 		return nil, sdkerrors.Wrapf(types.ErrUnknownEpoch, "unknown epoch, epoch identifier: %s", appparams.UndelegationEpochIdentifier)
@@ -38,6 +47,15 @@ func (k Keeper) Undelegate(ctx sdk.Context, chainID string, amount math.Int, del
 		return nil, sdkerrors.Wrapf(types.ErrRepeatUndelegate, "epoch %d", currentEpoch)
 	}
 
+	// all the stake of a deregistering source chain has been undelegated, the derivative token
+	// can only be redeemed after the wind down completed.
+	if sourceChain.WindDown != nil {
+		if !sourceChain.WindDown.Complete {
+			return nil, sdkerrors.Wrapf(types.ErrSourceChainWindingDown, "chainID: %s", chainID)
+		}
+		return k.redeemAfterWindDown(ctx, sourceChain, currentEpoch, amount, delegator)
+	}
+
 	receiveAmount := sdk.NewDecFromInt(amount).Mul(sourceChain.Redemptionratio).TruncateInt()
 	if sourceChain.StakedAmount.LT(receiveAmount) {
 		return nil, sdkerrors.Wrapf(types.ErrInternalError, "undelegate too mach, max %s, get %s", sourceChain.StakedAmount, receiveAmount)
@@ -101,6 +119,9 @@ func (k Keeper) Undelegate(ctx sdk.Context, chainID string, amount math.Int, del
 
 	k.SetEpochProxyUnboundings(ctx, curEpochProxyUnbondings)
 
+	k.recordUserHistory(ctx, delegatorAddr, types.UserHistoryUndelegate, chainID, currentEpoch,
+		sdk.NewCoin(sourceChain.DerivativeDenom, amount), userUnbonding.RedeemCoin)
+
 	return &userUnbonding, nil
 }
 
@@ -113,7 +134,12 @@ func (k Keeper) GetUserUnbonding(ctx sdk.Context, chainID string, epoch uint64,
 func (k Keeper) GetUserUnbondingID(ctx sdk.Context, id string) (*types.UserUnbonding, bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get([]byte(types.GetUndelegationRecordKeyFromID(id)))
+	key, err := types.GetUndelegationRecordKeyFromID(id)
+	if err != nil {
+		return nil, false
+	}
+
+	bz := store.Get(key)
 	if bz == nil {
 		return nil, false
 	}
@@ -129,13 +155,25 @@ func (k Keeper) SetUserUnbonding(ctx sdk.Context, userUnbonding *types.UserUnbon
 
 	key := types.GetUserUnbondingKey(userUnbonding.ChainID, userUnbonding.Epoch, userUnbonding.Delegator)
 	bz := k.cdc.MustMarshal(userUnbonding)
-	store.Set([]byte(key), bz)
+	store.Set(key, bz)
+}
+
+func (k Keeper) deleteUserUnbonding(ctx sdk.Context, userUnbonding *types.UserUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.GetUserUnbondingKey(userUnbonding.ChainID, userUnbonding.Epoch, userUnbonding.Delegator)
+	store.Delete(key)
 }
 
+// GetEpochProxyUnboundings return the EpochProxyUnbonding of epoch, the archived one is returned if
+// all the ProxyUnbondings of epoch are done.
 func (k Keeper) GetEpochProxyUnboundings(ctx sdk.Context, epoch uint64) (*types.EpochProxyUnbonding, bool) {
 	store := ctx.KVStore(k.storeKey)
 
 	bz := store.Get(types.GetEpochUnbondingsKey(epoch))
+	if bz == nil {
+		bz = store.Get(types.GetEpochUnbondingsArchiveKey(epoch))
+	}
 	if bz == nil {
 		return nil, false
 	}
@@ -151,39 +189,122 @@ func (k Keeper) SetEpochProxyUnboundings(ctx sdk.Context, unbondings *types.Epoc
 
 	bz := k.cdc.MustMarshal(unbondings)
 
+	k.updateStartedProxyUnbondingIndex(ctx, unbondings.Epoch, unbondings.Unbondings)
+
+	store.Delete(types.GetEpochUnbondingsArchiveKey(unbondings.Epoch))
 	store.Set(types.GetEpochUnbondingsKey(unbondings.Epoch), bz)
 }
 
-// ProcessUndelegationEpoch advance the Unbondings in the past epoch into the next status
+// archiveEpochProxyUnboundings move the EpochProxyUnbonding into the archive, it's not visited
+// by the undelegation epoch processing anymore.
+func (k Keeper) archiveEpochProxyUnboundings(ctx sdk.Context, unbondings *types.EpochProxyUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(unbondings)
+
+	k.updateStartedProxyUnbondingIndex(ctx, unbondings.Epoch, unbondings.Unbondings)
+
+	store.Delete(types.GetEpochUnbondingsKey(unbondings.Epoch))
+	store.Set(types.GetEpochUnbondingsArchiveKey(unbondings.Epoch), bz)
+}
+
+// deleteEpochProxyUnboundings remove the EpochProxyUnbonding of epoch whether it's archived or not.
+func (k Keeper) deleteEpochProxyUnboundings(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	k.updateStartedProxyUnbondingIndex(ctx, epoch, nil)
+
+	store.Delete(types.GetEpochUnbondingsKey(epoch))
+	store.Delete(types.GetEpochUnbondingsArchiveKey(epoch))
+}
+
+// updateStartedProxyUnbondingIndex replace the index entries of the started ProxyUnbondings in epoch
+// with the ones of the new ProxyUnbondings.
+func (k Keeper) updateStartedProxyUnbondingIndex(ctx sdk.Context, epoch uint64, unbondings []types.ProxyUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+
+	if old, found := k.GetEpochProxyUnboundings(ctx, epoch); found {
+		for _, unbonding := range old.Unbondings {
+			if unbonding.Status == types.ProxyUnbondingStart {
+				store.Delete(types.GetStartedProxyUnbondingKey(unbonding.ChainID, epoch))
+			}
+		}
+	}
+
+	for _, unbonding := range unbondings {
+		if unbonding.Status == types.ProxyUnbondingStart {
+			store.Set(types.GetStartedProxyUnbondingKey(unbonding.ChainID, epoch), []byte{})
+		}
+	}
+}
+
+// ProcessUndelegationEpoch start to advance the Unbondings in the past epoch into the next status.
+// The EpochProxyUnbondings are processed in batches, the left ones are processed in the subsequent blocks.
 func (k Keeper) ProcessUndelegationEpoch(ctx sdk.Context, epochNumber uint64) {
+	cursor := types.ProcessingCursor{Epoch: epochNumber}
+
+	k.processUndelegationBatch(ctx, &cursor)
+}
+
+// processUndelegationBatch process the EpochProxyUnbondings from the cursor within the budget of block.
+// The EpochProxyUnbonding whose ProxyUnbondings are all done is archived instead.
+func (k Keeper) processUndelegationBatch(ctx sdk.Context, cursor *types.ProcessingCursor) {
 	store := ctx.KVStore(k.storeKey)
+	budget := k.GetParams(ctx).EpochProcessingBudget
 
-	iterator := storetypes.KVStorePrefixIterator(store, types.EpochUnbondingsPrefix)
+	start := types.EpochUnbondingsPrefix
+	if len(cursor.NextKey) != 0 {
+		start = cursor.NextKey
+	}
+	// only the past epochs are processed
+	iterator := store.Iterator(start, types.GetEpochUnbondingsKey(cursor.Epoch))
 
+	var (
+		epochProxyUnbondings []types.EpochProxyUnbonding
+		nextKey              []byte
+	)
 	for ; iterator.Valid(); iterator.Next() {
-		bz := iterator.Value()
-		if bz == nil {
-			continue
+		if budget != 0 && uint64(len(epochProxyUnbondings)) >= budget {
+			nextKey = iterator.Key()
+			break
 		}
-		epochProxyUnbondings := types.EpochProxyUnbonding{}
-		k.cdc.MustUnmarshal(bz, &epochProxyUnbondings)
 
-		// epoch not past
-		if epochProxyUnbondings.Epoch >= epochNumber {
+		epochProxyUnbonding := types.EpochProxyUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &epochProxyUnbonding)
+		epochProxyUnbondings = append(epochProxyUnbondings, epochProxyUnbonding)
+	}
+	iterator.Close()
+
+	for i := range epochProxyUnbondings {
+		epochProxyUnbonding := &epochProxyUnbondings[i]
+
+		if epochProxyUnbonding.AllDone() {
+			k.archiveEpochProxyUnboundings(ctx, epochProxyUnbonding)
 			continue
 		}
-		if err := k.ProcessEpochProxyUnbondings(ctx, epochProxyUnbondings.Epoch, epochProxyUnbondings.Unbondings); err != nil {
+
+		if err := k.ProcessEpochProxyUnbondings(ctx, epochProxyUnbonding.Epoch, epochProxyUnbonding.Unbondings); err != nil {
 			continue
 		}
 		// save the changed epochUnbondings
-		k.SetEpochProxyUnboundings(ctx, &epochProxyUnbondings)
+		k.SetEpochProxyUnboundings(ctx, epochProxyUnbonding)
 	}
+
+	if nextKey == nil {
+		store.Delete(types.UndelegationProcessingCursorKey)
+		return
+	}
+
+	cursor.NextKey = nextKey
+	cursor.Height = ctx.BlockHeight()
+	k.setProcessingCursor(ctx, types.UndelegationProcessingCursorKey, cursor)
 }
 
 func (k Keeper) ProcessEpochProxyUnbondings(ctx sdk.Context, epoch uint64, proxyUnbondings []types.ProxyUnbonding) error {
 	pendingUnbondAmount := make(map[string]math.Int)
 	sourceChainTemp := make(map[string]*types.SourceChain)
 	completeUnbondAmmount := make(map[string]math.Int)
+	completeUnbondIndexes := make(map[string][]int)
 
 	chainIDs := make([]string, 0)
 
@@ -193,7 +314,7 @@ func (k Keeper) ProcessEpochProxyUnbondings(ctx sdk.Context, epoch uint64, proxy
 			continue
 		}
 
-		if !k.sourceChainAvaiable(ctx, sourceChian) {
+		if !k.sourceChainAvaiable(ctx, sourceChian) || !sourceChian.Status.WithdrawAllowed() {
 			continue
 		}
 
@@ -216,7 +337,9 @@ func (k Keeper) ProcessEpochProxyUnbondings(ctx sdk.Context, epoch uint64, proxy
 			// TODO become pending and retry next epoch or retry now ?
 			// retry now maybe deadloop ?
 		case types.ProxyUnbondingWaitting:
-			if ctx.BlockTime().Before(time.Unix(0, int64(unbonding.UnbondTime)).Add(5 * time.Minute)) {
+			// the mature unbondings are withdrawn in EndBlock, the ones left waiting belong to
+			// the source chains which were unavailable when the unbondings completed.
+			if ctx.BlockTime().Before(time.Unix(0, int64(unbonding.UnbondTime)).Add(withdrawBuffer)) {
 				continue
 			}
 
@@ -225,7 +348,7 @@ func (k Keeper) ProcessEpochProxyUnbondings(ctx sdk.Context, epoch uint64, proxy
 				existAmount = sdk.ZeroInt()
 			}
 			completeUnbondAmmount[unbonding.ChainID] = existAmount.Add(unbonding.RedeemNativeToken.Amount)
-			proxyUnbondings[i].Status = types.ProxyUnbondingWithdraw
+			completeUnbondIndexes[unbonding.ChainID] = append(completeUnbondIndexes[unbonding.ChainID], i)
 		default:
 		}
 	}
@@ -245,14 +368,22 @@ func (k Keeper) ProcessEpochProxyUnbondings(ctx sdk.Context, epoch uint64, proxy
 		if !ok || amount.IsZero() {
 			continue
 		}
-		k.withdrawUnbondFromSourceChain(ctx, sourceChainTemp[chainID], completeUnbondAmmount[chainID], epoch)
+		// the unbondings are left waiting if the withdrawal can't be sent, so they are retried later.
+		if err := k.withdrawUnbondFromSourceChain(ctx, sourceChainTemp[chainID], completeUnbondAmmount[chainID], epoch); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("withdraw unbonding failed, chainID %s epoch %d, error: %s", chainID, epoch, err))
+			continue
+		}
+
+		for _, i := range completeUnbondIndexes[chainID] {
+			proxyUnbondings[i].Status = types.ProxyUnbondingWithdraw
+		}
 	}
 
 	return nil
 }
 
 func (k Keeper) undelegateOnSourceChain(ctx sdk.Context, sourceChain *types.SourceChain, amount math.Int, epoch uint64) error {
-	allocVals := sourceChain.AllocateTokenForValidator(amount)
+	allocVals := sourceChain.AllocateUnbondingForValidator(amount)
 
 	undelegateMsgs := make([]proto.Message, 0)
 	sourceChainUnbondAddress, err := k.GetSourceChainAddr(ctx, sourceChain.ConnectionID, sourceChain.DelegateAddress)
@@ -260,6 +391,21 @@ func (k Keeper) undelegateOnSourceChain(ctx sdk.Context, sourceChain *types.Sour
 		return err
 	}
 
+	if sourceChain.WindDown != nil && sourceChain.WindDown.Epoch == epoch {
+		// the rewards accrued during wind down are withdrawn to the delegate account, so that they
+		// are withdrawn together with the undelegated token.
+		undelegateMsgs = append(undelegateMsgs, &distrtypes.MsgSetWithdrawAddress{
+			DelegatorAddress: sourceChainUnbondAddress,
+			WithdrawAddress:  sourceChainUnbondAddress,
+		})
+		for _, valFund := range allocVals.Validators {
+			undelegateMsgs = append(undelegateMsgs, &distrtypes.MsgWithdrawDelegatorReward{
+				DelegatorAddress: sourceChainUnbondAddress,
+				ValidatorAddress: valFund.Address,
+			})
+		}
+	}
+
 	for _, valFund := range allocVals.Validators {
 		undelegateMsgs = append(undelegateMsgs, &stakingtypes.MsgUndelegate{
 			DelegatorAddress: sourceChainUnbondAddress,
@@ -302,6 +448,10 @@ func (k Keeper) withdrawUnbondFromSourceChain(ctx sdk.Context, sourceChain *type
 		return err
 	}
 
+	if sourceChain.WindDown != nil && sourceChain.WindDown.Epoch == epoch && !sourceChain.WindDown.RewardAmount.IsNil() {
+		amount = amount.Add(sourceChain.WindDown.RewardAmount)
+	}
+
 	witdrawMsgs := make([]proto.Message, 0)
 	timeoutTimestamp := ctx.BlockTime().Add(30 * time.Minute).UnixNano()
 	allocVals := sourceChain.AllocateTokenForValidator(amount)
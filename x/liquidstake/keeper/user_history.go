@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// recordUserHistory append the entry into the history of delegator, the entry is kept after the
+// records of the source chain which it relates to are pruned. The oldest entries of delegator are
+// deleted if the history exceeds the max number of entries.
+func (k Keeper) recordUserHistory(ctx sdk.Context, delegator string, action types.UserHistoryAction,
+	chainID string, epoch uint64, amount sdk.Coin, received sdk.Coin,
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if bz := store.Get(types.UserHistorySequenceKey); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.UserHistorySequenceKey, sdk.Uint64ToBigEndian(sequence+1))
+
+	entry := types.UserHistoryEntry{
+		Action:   action,
+		ChainID:  chainID,
+		Epoch:    epoch,
+		Amount:   amount,
+		Received: received,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+	}
+
+	store.Set(types.GetUserHistoryKey(delegator, sequence), k.cdc.MustMarshal(&entry))
+
+	k.trimUserHistory(ctx, delegator, k.GetParams(ctx).MaxUserHistoryEntries)
+}
+
+// trimUserHistory delete the oldest history entries of delegator which exceed the limit.
+func (k Keeper) trimUserHistory(ctx sdk.Context, delegator string, limit uint64) {
+	if limit == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// the entries are iterated from the newest, the ones after the limit are the oldest.
+	var (
+		count    uint64
+		outdated [][]byte
+	)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.GetUserHistoryPrefix(delegator))
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count > limit {
+			outdated = append(outdated, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range outdated {
+		store.Delete(key)
+	}
+}
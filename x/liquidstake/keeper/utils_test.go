@@ -116,7 +116,7 @@ func (suite *KeeperTestSuite) setSourceChainAndEpoch(sourceChain *types.SourceCh
 func (suite *KeeperTestSuite) setSourceChain(chainApp *app.App, sourceChain *types.SourceChain) {
 	channelSequence := chainApp.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.controlChain.GetContext())
 
-	err := chainApp.LiquidStakeKeeper.AddSouceChain(suite.controlChain.GetContext(), sourceChain)
+	err := chainApp.LiquidStakeKeeper.AddSouceChain(suite.controlChain.GetContext(), sourceChain, nil)
 	suite.NoError(err)
 	suite.controlChain.NextBlock()
 
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+// GetHostValidatorSnapshot get the latest host validator snapshot of source chain
+func (k Keeper) GetHostValidatorSnapshot(ctx sdk.Context, chainID string) (types.HostValidatorSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	snapshot := types.HostValidatorSnapshot{}
+	bz := store.Get(types.GetHostValidatorSnapshotKey([]byte(chainID)))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &snapshot)
+
+	return snapshot, true
+}
+
+// SetHostValidatorSnapshot set the host validator snapshot of source chain
+func (k Keeper) SetHostValidatorSnapshot(ctx sdk.Context, snapshot *types.HostValidatorSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(snapshot)
+
+	store.Set(types.GetHostValidatorSnapshotKey([]byte(snapshot.ChainID)), bz)
+}
+
+// UpdateValidatorSelectionPolicy set the validator selection policy of source chain.
+// The new policy will be applied at the start of next delegation epoch.
+func (k Keeper) UpdateValidatorSelectionPolicy(ctx sdk.Context, chainID string, policy types.ValidatorSelectionPolicy) error {
+	sourceChain, found := k.GetSourceChain(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID: %s", chainID)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSelectionPolicy, err.Error())
+	}
+
+	sourceChain.SelectionPolicy = policy
+	k.SetSourceChain(ctx, sourceChain)
+
+	return nil
+}
+
+// ClearValidatorSlashed clear the slashed flag of validators of source chain. The weights of them are
+// no longer reduced since the selection policy is applied next time.
+func (k Keeper) ClearValidatorSlashed(ctx sdk.Context, chainID string, validators []string) error {
+	sourceChain, found := k.GetSourceChain(ctx, chainID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID: %s", chainID)
+	}
+
+	indexes := make(map[string]int)
+	for i, v := range sourceChain.Validators {
+		indexes[v.Address] = i
+	}
+
+	for _, address := range validators {
+		i, found := indexes[address]
+		if !found {
+			return sdkerrors.Wrapf(types.ErrSourceChainParameter, "validator %s not found in %s", address, chainID)
+		}
+		sourceChain.Validators[i].Slashed = false
+	}
+
+	k.SetSourceChain(ctx, sourceChain)
+
+	return nil
+}
+
+// SubmitHostValidatorSnapshot save the host validator snapshot which submitted by relayer.
+// The delegation amounts in snapshot must be proven by the proofs of source chain staking store.
+func (k Keeper) SubmitHostValidatorSnapshot(ctx sdk.Context, submitter string, snapshot types.HostValidatorSnapshot,
+	proofs []types.HostDelegationProof,
+) error {
+	sourceChain, found := k.GetSourceChain(ctx, snapshot.ChainID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSourceChain, "chainID: %s", snapshot.ChainID)
+	}
+
+	if submitter != k.authority && !sourceChain.SelectionPolicy.IsSnapshotSubmitter(submitter) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not allowed to submit snapshot for %s", submitter, snapshot.ChainID)
+	}
+
+	if err := snapshot.Validate(sourceChain.Bech32ValidatorAddrPrefix); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSnapshot, err.Error())
+	}
+
+	if old, found := k.GetHostValidatorSnapshot(ctx, snapshot.ChainID); found && old.Height >= snapshot.Height {
+		return sdkerrors.Wrapf(types.ErrInvalidSnapshot, "snapshot height %d is not greater than the latest %d", snapshot.Height, old.Height)
+	}
+
+	// the recorded amounts are changed after the snapshot height, it can't be compared with them.
+	if snapshot.Height <= sourceChain.TokenAmountHostHeight {
+		return sdkerrors.Wrapf(types.ErrInvalidSnapshot, "snapshot height %d is not greater than the height %d of the latest delegation change",
+			snapshot.Height, sourceChain.TokenAmountHostHeight)
+	}
+
+	if err := k.VerifyHostDelegations(ctx, sourceChain, snapshot, proofs); err != nil {
+		return err
+	}
+
+	k.SetHostValidatorSnapshot(ctx, &snapshot)
+
+	k.HandleSourceChainSlash(ctx, sourceChain, snapshot)
+
+	return nil
+}
+
+// ApplyValidatorSelectionPolicy recompute the weight of validators for each source chain
+// according its selection policy and the latest host validator snapshot.
+func (k Keeper) ApplyValidatorSelectionPolicy(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.SouceChainKeyPrefix)
+	defer iterator.Close()
+
+	var sourceChains []*types.SourceChain
+	for ; iterator.Valid(); iterator.Next() {
+		sourceChain := &types.SourceChain{}
+		k.cdc.MustUnmarshal(iterator.Value(), sourceChain)
+		sourceChains = append(sourceChains, sourceChain)
+	}
+
+	for _, sourceChain := range sourceChains {
+		if sourceChain.SelectionPolicy.Strategy == types.ValidatorSelectionManual {
+			continue
+		}
+
+		snapshot, found := k.GetHostValidatorSnapshot(ctx, sourceChain.ChainID)
+		if !found {
+			continue
+		}
+
+		weights := sourceChain.SelectionPolicy.ComputeWeights(snapshot)
+		if len(weights) == 0 {
+			k.Logger(ctx).Error("no eligible validator for source chain", "chainID", sourceChain.ChainID)
+			continue
+		}
+
+		oldWeights := make(map[string]uint64)
+		for _, v := range sourceChain.Validators {
+			oldWeights[v.Address] = v.Weight
+		}
+
+		sourceChain.ApplyWeights(weights)
+		k.SetSourceChain(ctx, sourceChain)
+
+		// align the old weights with the new validator set, new validator has zero old weight.
+		oldWeightStrs := make([]string, 0, len(sourceChain.Validators))
+		for _, v := range sourceChain.Validators {
+			oldWeightStrs = append(oldWeightStrs, strconv.FormatUint(oldWeights[v.Address], 10))
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdateValidatorWeights,
+				sdk.NewAttribute(types.AttributeKeySourceChainID, sourceChain.ChainID),
+				sdk.NewAttribute(types.AttributeKeyStrategy, sourceChain.SelectionPolicy.Strategy.String()),
+				sdk.NewAttribute(types.AttributeKeyValidators, sourceChain.ValidatorsAddress()),
+				sdk.NewAttribute(types.AttributeKeyOldWeights, strings.Join(oldWeightStrs, ",")),
+				sdk.NewAttribute(types.AttributeKeyNewWeights, sourceChain.ValidatorsWeight()),
+			),
+		)
+	}
+}
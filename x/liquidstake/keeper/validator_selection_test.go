@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/celinium-network/celinium/x/liquidstake/keeper"
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func (suite *KeeperTestSuite) TestApplyValidatorSelectionPolicy() {
+	ctlChainApp := getCeliniumApp(suite.controlChain)
+	ctx := suite.controlChain.GetContext()
+
+	sourceChain := suite.mockSourceChainParams()
+	sourceChain.StakedAmount = math.ZeroInt()
+	for i := range sourceChain.Validators {
+		sourceChain.Validators[i].TokenAmount = math.ZeroInt()
+	}
+	ctlChainApp.LiquidStakeKeeper.SetSourceChain(ctx, sourceChain)
+
+	var hostValidators []types.HostValidator
+	for i, v := range suite.sourceChain.Vals.Validators {
+		hostValidators = append(hostValidators, types.HostValidator{
+			Address:     sdk.ValAddress(v.Address).String(),
+			Commission:  sdk.NewDecWithPrec(int64(i+1), 2),
+			Uptime:      sdk.OneDec(),
+			VotingPower: math.NewInt(1000 * int64(i+1)),
+		})
+	}
+	snapshot := types.HostValidatorSnapshot{
+		ChainID:    sourceChain.ChainID,
+		Height:     10,
+		Validators: hostValidators,
+	}
+
+	submitter := suite.controlChain.SenderAccount.GetAddress().String()
+	msgServer := keeper.NewMsgServerImpl(&ctlChainApp.LiquidStakeKeeper)
+
+	// the submitter is not in the policy
+	_, err := msgServer.SubmitHostValidatorSnapshot(ctx, &types.MsgSubmitHostValidatorSnapshot{
+		Submitter: submitter,
+		Snapshot:  snapshot,
+	})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	policy := types.ValidatorSelectionPolicy{
+		Strategy:           types.ValidatorSelectionInverseVotingPower,
+		SnapshotSubmitters: []string{submitter},
+	}
+
+	_, err = msgServer.UpdateValidatorSelectionPolicy(ctx, &types.MsgUpdateValidatorSelectionPolicy{
+		Authority: submitter,
+		ChainID:   sourceChain.ChainID,
+		Policy:    policy,
+	})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.UpdateValidatorSelectionPolicy(ctx, &types.MsgUpdateValidatorSelectionPolicy{
+		Authority: authority,
+		ChainID:   sourceChain.ChainID,
+		Policy:    policy,
+	})
+	suite.NoError(err)
+
+	_, err = msgServer.SubmitHostValidatorSnapshot(ctx, &types.MsgSubmitHostValidatorSnapshot{
+		Submitter: submitter,
+		Snapshot:  snapshot,
+	})
+	suite.NoError(err)
+
+	// stale snapshot is rejected
+	_, err = msgServer.SubmitHostValidatorSnapshot(ctx, &types.MsgSubmitHostValidatorSnapshot{
+		Submitter: submitter,
+		Snapshot:  snapshot,
+	})
+	suite.ErrorIs(err, types.ErrInvalidSnapshot)
+
+	ctlChainApp.LiquidStakeKeeper.ApplyValidatorSelectionPolicy(ctx)
+
+	updatedChain, found := ctlChainApp.LiquidStakeKeeper.GetSourceChain(ctx, sourceChain.ChainID)
+	suite.True(found)
+
+	expectedWeights := policy.ComputeWeights(snapshot)
+	suite.Len(updatedChain.Validators, len(hostValidators))
+	for _, v := range updatedChain.Validators {
+		suite.Equal(expectedWeights[v.Address], v.Weight)
+	}
+}
@@ -3,6 +3,7 @@ package liquidstake
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -35,8 +36,8 @@ func NewAppModuleBasic(cdc codec.Codec) AppModuleBasic {
 }
 
 // DefaultGenesis implements module.AppModuleBasic
-func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
-	return nil
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
 // GetQueryCmd implements module.AppModuleBasic
@@ -73,8 +74,12 @@ func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 }
 
 // ValidateGenesis implements module.AppModuleBasic
-func (AppModuleBasic) ValidateGenesis(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return genState.Validate()
 }
 
 type AppModule struct {
@@ -94,18 +99,23 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 func (AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
 
 // ExportGenesis implements module.EndBlockAppModule
-func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return nil
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(genState)
 }
 
 // InitGenesis implements module.EndBlockAppModule
-func (AppModule) InitGenesis(sdk.Context, codec.JSONCodec, json.RawMessage) []abci.ValidatorUpdate {
-	return nil
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+
+	InitGenesis(ctx, am.keeper, genState)
+	return []abci.ValidatorUpdate{}
 }
 
 // ConsensusVersion implements module.EndBlockAppModule
 func (AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 2
 }
 
 // LegacyQuerierHandler implements module.EndBlockAppModule
@@ -119,7 +129,8 @@ func (AppModule) QuerierRoute() string {
 }
 
 // RegisterInvariants implements module.EndBlockAppModule
-func (AppModule) RegisterInvariants(sdk.InvariantRegistry) {
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices implements module.EndBlockAppModule
@@ -127,6 +138,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
 	querier := keeper.Querier{Keeper: am.keeper}
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // Route implements module.EndBlockAppModule
@@ -135,6 +151,7 @@ func (AppModule) Route() sdk.Route {
 }
 
 // EndBlock implements module.EndBlockAppModule
-func (AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.EndBlocker(ctx)
 	return nil
 }
@@ -15,6 +15,14 @@ var (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterSourceChain{}, "liquidstake/MsgRegisterSourceChain", nil)
+	cdc.RegisterConcrete(&MsgUpdateValidatorSelectionPolicy{}, "liquidstake/MsgUpdateValidatorSelectionPolicy", nil)
+	cdc.RegisterConcrete(&MsgSubmitHostValidatorSnapshot{}, "liquidstake/MsgSubmitHostValidatorSnapshot", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "liquidstake/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgResumeSourceChain{}, "liquidstake/MsgResumeSourceChain", nil)
+	cdc.RegisterConcrete(&MsgSetSourceChainStatus{}, "liquidstake/MsgSetSourceChainStatus", nil)
+	cdc.RegisterConcrete(&MsgDeregisterSourceChain{}, "liquidstake/MsgDeregisterSourceChain", nil)
+	cdc.RegisterConcrete(&MsgUpdateDerivativeDenomMetadata{}, "liquidstake/MsgUpdateDerivativeDenomMetadata", nil)
+	cdc.RegisterConcrete(&MsgClearValidatorSlashed{}, "liquidstake/MsgClearValidatorSlashed", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,5 +30,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgRegisterSourceChain{},
+		&MsgUpdateValidatorSelectionPolicy{},
+		&MsgSubmitHostValidatorSnapshot{},
+		&MsgUpdateParams{},
+		&MsgResumeSourceChain{},
+		&MsgSetSourceChainStatus{},
+		&MsgDeregisterSourceChain{},
+		&MsgUpdateDerivativeDenomMetadata{},
+		&MsgClearValidatorSlashed{},
 	)
 }
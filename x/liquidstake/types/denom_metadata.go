@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DerivativeDenomPrefix is the prefix of the display denom and symbol of derivative token.
+const DerivativeDenomPrefix = "st"
+
+// DerivativeDenomMetadata build the bank metadata of derivative token. The display, symbol and exponent
+// are copied from the metadata of native token. If the native metadata is nil, the derivative token
+// only has the base denom unit.
+func (s SourceChain) DerivativeDenomMetadata(native *banktypes.Metadata) banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Liquid staking derivative of %s from source chain %s", s.NativeDenom, s.ChainID),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: s.DerivativeDenom, Exponent: 0},
+		},
+		Base:    s.DerivativeDenom,
+		Display: s.DerivativeDenom,
+		Name:    s.DerivativeDenom,
+		Symbol:  strings.ToUpper(s.DerivativeDenom),
+	}
+
+	if native == nil {
+		return metadata
+	}
+
+	if len(native.Name) != 0 {
+		metadata.Name = "Staked " + native.Name
+	}
+	if len(native.Symbol) != 0 {
+		metadata.Symbol = DerivativeDenomPrefix + native.Symbol
+	}
+
+	for _, unit := range native.DenomUnits {
+		if unit.Denom != native.Display || unit.Exponent == 0 {
+			continue
+		}
+
+		display := DerivativeDenomPrefix + native.Display
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: unit.Exponent,
+		})
+		metadata.Display = display
+	}
+
+	return metadata
+}
@@ -21,4 +21,16 @@ var (
 	ErrUserUndelegationNotExist = sdkioerrors.Register(ModuleName, 14, "the undelegation is not exist")
 	ErrUserUndelegationWatting  = sdkioerrors.Register(ModuleName, 15, "the undelegation is waitting")
 	ErrCallbackMismatch         = sdkioerrors.Register(ModuleName, 16, "mismatch callback")
+	ErrUnauthorized             = sdkioerrors.Register(ModuleName, 17, "unauthorized")
+	ErrInvalidSelectionPolicy   = sdkioerrors.Register(ModuleName, 18, "invalid validator selection policy")
+	ErrInvalidSnapshot          = sdkioerrors.Register(ModuleName, 19, "invalid host validator snapshot")
+	ErrSnapshotNotExist         = sdkioerrors.Register(ModuleName, 20, "host validator snapshot not exist")
+	ErrSourceChainHalted        = sdkioerrors.Register(ModuleName, 21, "source chain is halted")
+	ErrSourceChainNotHalted     = sdkioerrors.Register(ModuleName, 22, "source chain is not halted")
+	ErrInvalidParams            = sdkioerrors.Register(ModuleName, 23, "invalid params")
+	ErrSourceChainPaused        = sdkioerrors.Register(ModuleName, 24, "source chain is paused")
+	ErrInvalidSourceChainStatus = sdkioerrors.Register(ModuleName, 25, "invalid source chain status")
+	ErrSourceChainWindingDown   = sdkioerrors.Register(ModuleName, 26, "source chain is winding down")
+	ErrInvalidDerivativeDenom   = sdkioerrors.Register(ModuleName, 27, "invalid derivative denom")
+	ErrInvalidDenomMetadata     = sdkioerrors.Register(ModuleName, 28, "invalid denom metadata")
 )
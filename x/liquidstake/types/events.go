@@ -6,6 +6,24 @@ const (
 	EventTypeDelegate            = "delegate"
 	EventTypeUndelegate          = "undelegate"
 
+	EventTypeUpdateSelectionPolicy   = "update_validator_selection_policy"
+	EventTypeSubmitValidatorSnapshot = "submit_host_validator_snapshot"
+	EventTypeUpdateValidatorWeights  = "update_validator_weights"
+	EventTypeSourceChainSlash        = "source_chain_slash"
+	EventTypeUpdateRedeemRate        = "update_redeem_rate"
+	EventTypeHaltSourceChain         = "halt_source_chain"
+	EventTypeResumeSourceChain       = "resume_source_chain"
+	EventTypeUpdateParams            = "update_params"
+	EventTypeSetSourceChainStatus    = "set_source_chain_status"
+	EventTypeDeregisterSourceChain   = "deregister_source_chain"
+	EventTypeStartWindDown           = "start_wind_down"
+	EventTypeCompleteWindDown        = "complete_wind_down"
+	EventTypeRemoveSourceChain       = "remove_source_chain"
+	EventTypeUpdateDenomMetadata     = "update_denom_metadata"
+	EventTypePruneProxyDelegations   = "prune_proxy_delegations"
+	EventTypePruneProxyUnbondings    = "prune_proxy_unbondings"
+	EventTypeClearValidatorSlashed   = "clear_validator_slashed"
+
 	AttributeKeySourceChainID = "source_chain_id"
 	AttributeKeyDelegator     = "delegator"
 	AttributeKeyValidators    = "source_chain_validators"
@@ -15,4 +33,18 @@ const (
 	AttributeKeyRedeemAmt     = "redeem_amount"
 	AttributeKeyUnbondAmt     = "unbond_amount"
 	AttributeKeyClaimAmt      = "unbond_amount"
+	AttributeKeyStrategy      = "strategy"
+	AttributeKeyOldWeights    = "old_weights"
+	AttributeKeyNewWeights    = "new_weights"
+	AttributeKeySubmitter     = "submitter"
+	AttributeKeyHeight        = "height"
+	AttributeKeyValidator     = "validator"
+	AttributeKeySlashAmt      = "slash_amount"
+	AttributeKeyRedeemRate    = "redeem_rate"
+	AttributeKeyOldRedeemRate = "old_redeem_rate"
+	AttributeKeyStatus        = "status"
+	AttributeKeyOldStatus     = "old_status"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyRecordCount   = "record_count"
+	AttributeKeyUserCount     = "user_unbonding_count"
 )
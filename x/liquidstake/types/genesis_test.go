@@ -0,0 +1,18 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	genState := types.DefaultGenesisState()
+	genState.Params.MaxRedeemRateDecrease = sdk.NewDec(2)
+	require.Error(t, genState.Validate())
+}
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -22,11 +25,23 @@ const (
 
 // Keys for store prefixes
 var (
+	// Key for module parameters
+	ParamsKey = []byte{0x01}
+
 	EpochKey = []byte{0x10}
 
 	// Prefix for source chain
 	SouceChainKeyPrefix = []byte{0x11}
 
+	// Prefix for host validator snapshot of source chain
+	HostValidatorSnapshotPrefix = []byte{0x12}
+
+	// Prefix for key `{chainID + epoch} => RedeemRateRecord`
+	RedeemRateHistoryPrefix = []byte{0x13}
+
+	// Prefix for the derivative denom of the removed source chain `denom => chainID`
+	DeregisteredDenomPrefix = []byte{0x14}
+
 	// Key for delegation record ID.
 	ProxyDelegationIDKey = []byte{0x20}
 
@@ -39,11 +54,47 @@ var (
 	// Prefix for key `{channel + port + sequence} => ProxyDelegationID`
 	IBCCallbackPrefix = []byte{0x23}
 
+	// Prefix for the done ProxyDelegation `ID => ProxyDelegation`
+	ProxyDelegationArchivePrefix = []byte{0x24}
+
+	// Prefix for the status index of the processing ProxyDelegation `{status + ID} => nil`
+	ProxyDelegationStatusPrefix = []byte{0x25}
+
+	// Key for the cursor of the delegation epoch processing
+	DelegationProcessingCursorKey = []byte{0x26}
+
+	// Prefix for the epoch index of the done ProxyDelegation `{epoch + ID} => nil`
+	ProxyDelegationArchiveEpochPrefix = []byte{0x27}
+
+	// Prefix for the amount of the processing ProxyDelegations `{chainID + status} => amount`
+	ProxyDelegationAmountPrefix = []byte{0x28}
+
+	// Prefix for the reinvest amount of the processing ProxyDelegations `{chainID + status} => amount`
+	ProxyDelegationReinvestAmountPrefix = []byte{0x29}
+
 	// Prefix for key `{chainID + epoch + delegator}` => UnProxyDelegation
 	UndelegationRecrodPrefix = []byte{0x31}
 
 	EpochUnbondingsPrefix = []byte{0x32}
 
+	// Prefix for the proxy unbonding completion queue `{completionTime} => EpochChainPairs`
+	ProxyUnbondingQueueKey = []byte{0x33}
+
+	// Prefix for the EpochProxyUnbonding whose ProxyUnbondings are all done `epoch => EpochProxyUnbonding`
+	EpochUnbondingsArchivePrefix = []byte{0x34}
+
+	// Key for the cursor of the undelegation epoch processing
+	UndelegationProcessingCursorKey = []byte{0x35}
+
+	// Prefix for the history of delegator `{delegator + sequence} => UserHistoryEntry`
+	UserHistoryPrefix = []byte{0x36}
+
+	// Key for the sequence of UserHistoryEntry
+	UserHistorySequenceKey = []byte{0x37}
+
+	// Prefix for the index of the ProxyUnbonding which is undelegating on source chain `{chainID + epoch} => nil`
+	StartedProxyUnbondingPrefix = []byte{0x38}
+
 	IBCQueryKey = []byte{0x41}
 )
 
@@ -52,6 +103,26 @@ func GetSourceChainKey(chainID []byte) []byte {
 	return append(SouceChainKeyPrefix, lengthPrefix(chainID)...)
 }
 
+// GetHostValidatorSnapshotKey return key for host validator snapshot, `HostValidatorSnapshotPrefix + len(chainID)+chainID`
+func GetHostValidatorSnapshotKey(chainID []byte) []byte {
+	return append(HostValidatorSnapshotPrefix, lengthPrefix(chainID)...)
+}
+
+// GetRedeemRateHistoryPrefix return prefix for redeem rate history of source chain, `RedeemRateHistoryPrefix + len(chainID)+chainID`
+func GetRedeemRateHistoryPrefix(chainID []byte) []byte {
+	return append(RedeemRateHistoryPrefix, lengthPrefix(chainID)...)
+}
+
+// GetRedeemRateRecordKey return key for redeem rate record, `RedeemRateHistoryPrefix + len(chainID)+chainID + epoch`
+func GetRedeemRateRecordKey(chainID []byte, epoch uint64) []byte {
+	return append(GetRedeemRateHistoryPrefix(chainID), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetDeregisteredDenomKey return key for the derivative denom of removed source chain, `DeregisteredDenomPrefix + denom`
+func GetDeregisteredDenomKey(denom string) []byte {
+	return append(DeregisteredDenomPrefix, []byte(denom)...)
+}
+
 // GetChainProxyDelegationIDForEpochKey return , `SouceChainKeyPrefix + len(chainID)+chainID`
 func GetChainProxyDelegationIDForEpochKey(epoch uint64, chainID []byte) []byte {
 	epochBz := sdk.Uint64ToBigEndian(epoch)
@@ -75,6 +146,62 @@ func GetProxyDelegationKey(id uint64) []byte {
 	return append(ProxyDelegationPrefix, idBz...)
 }
 
+func GetProxyDelegationArchiveKey(id uint64) []byte {
+	idBz := sdk.Uint64ToBigEndian(id)
+
+	return append(ProxyDelegationArchivePrefix, idBz...)
+}
+
+// GetProxyDelegationArchiveEpochPrefix return prefix for the done ProxyDelegation IDs of epoch, `ProxyDelegationArchiveEpochPrefix + epoch`
+func GetProxyDelegationArchiveEpochPrefix(epoch uint64) []byte {
+	return append(ProxyDelegationArchiveEpochPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetProxyDelegationArchiveEpochKey return key for the epoch index of the done ProxyDelegation, `ProxyDelegationArchiveEpochPrefix + epoch + ID`
+func GetProxyDelegationArchiveEpochKey(epoch uint64, id uint64) []byte {
+	return append(GetProxyDelegationArchiveEpochPrefix(epoch), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseProxyDelegationArchiveEpochKey return the epoch and the ProxyDelegation ID of the epoch index key
+func ParseProxyDelegationArchiveEpochKey(key []byte) (uint64, uint64) {
+	key = key[len(ProxyDelegationArchiveEpochPrefix):]
+
+	return sdk.BigEndianToUint64(key[:8]), sdk.BigEndianToUint64(key[8:])
+}
+
+// GetProxyDelegationStatusPrefix return prefix for the ProxyDelegation IDs of status, `ProxyDelegationStatusPrefix + status`
+func GetProxyDelegationStatusPrefix(status ProxyDelegationStatus) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(status))
+
+	return append(ProxyDelegationStatusPrefix, bz...)
+}
+
+// GetProxyDelegationStatusKey return key for the status index of ProxyDelegation, `ProxyDelegationStatusPrefix + status + ID`
+func GetProxyDelegationStatusKey(status ProxyDelegationStatus, id uint64) []byte {
+	return append(GetProxyDelegationStatusPrefix(status), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseProxyDelegationStatusKey return the ProxyDelegation ID of the status index key
+func ParseProxyDelegationStatusKey(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(ProxyDelegationStatusPrefix)+4:])
+}
+
+// GetProxyDelegationAmountPrefix return prefix for the amounts of the processing ProxyDelegations of chain,
+// the keyPrefix is ProxyDelegationAmountPrefix or ProxyDelegationReinvestAmountPrefix.
+func GetProxyDelegationAmountPrefix(keyPrefix []byte, chainID string) []byte {
+	return append(append([]byte{}, keyPrefix...), lengthPrefix([]byte(chainID))...)
+}
+
+// GetProxyDelegationAmountKey return key for the amount of the processing ProxyDelegations of chain
+// in status, `keyPrefix + chainID + status`
+func GetProxyDelegationAmountKey(keyPrefix []byte, chainID string, status ProxyDelegationStatus) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(status))
+
+	return append(GetProxyDelegationAmountPrefix(keyPrefix, chainID), bz...)
+}
+
 func GetIBCCallbackKey(channel []byte, port []byte, sequence uint64) []byte {
 	channelBz := lengthPrefix(channel)
 	portBz := lengthPrefix(port)
@@ -93,26 +220,102 @@ func GetIBCCallbackKey(channel []byte, port []byte, sequence uint64) []byte {
 	return bz
 }
 
-func GetUserUnbondingKey(chainID string, epoch uint64, delegator string) string {
-	id := AssembleUserUnbondingID(chainID, epoch, delegator)
+// ParseIBCCallbackKey return the channel and port of the IBCCallback key.
+func ParseIBCCallbackKey(key []byte) (string, string) {
+	key = key[len(IBCCallbackPrefix):]
+	channelL := int(key[0])
+	channel := string(key[1 : 1+channelL])
+	key = key[1+channelL:]
+	portL := int(key[0])
 
-	return string(UndelegationRecrodPrefix) + id
+	return channel, string(key[1 : 1+portL])
 }
 
-func GetUndelegationRecordKeyFromID(id string) string {
-	return string(UndelegationRecrodPrefix) + id
+// GetUserUnbondingPrefix return prefix for the UserUnbondings of chain, `UndelegationRecrodPrefix + len(chainID)+chainID`
+func GetUserUnbondingPrefix(chainID string) []byte {
+	return append(UndelegationRecrodPrefix, lengthPrefix([]byte(chainID))...)
+}
+
+// GetUserUnbondingKey return key for UserUnbonding, `UndelegationRecrodPrefix + len(chainID)+chainID + epoch + delegator`
+func GetUserUnbondingKey(chainID string, epoch uint64, delegator string) []byte {
+	key := append(GetUserUnbondingPrefix(chainID), sdk.Uint64ToBigEndian(epoch)...)
+
+	return append(key, []byte(delegator)...)
+}
+
+// GetUndelegationRecordKeyFromID return key for the UserUnbonding of id.
+func GetUndelegationRecordKeyFromID(id string) ([]byte, error) {
+	chainID, epoch, delegator, err := ParseUserUnbondingID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetUserUnbondingKey(chainID, epoch, delegator), nil
 }
 
 func AssembleUserUnbondingID(chainID string, epoch uint64, delegator string) string {
 	return strings.Join([]string{chainID, strconv.FormatUint(epoch, 10), delegator}, ".")
 }
 
+// ParseUserUnbondingID split the UserUnbonding id into chainID, epoch and delegator. The chainID
+// may contain dots, so the id is split from the end.
+func ParseUserUnbondingID(id string) (string, uint64, string, error) {
+	delegatorIndex := strings.LastIndex(id, ".")
+	if delegatorIndex < 0 {
+		return "", 0, "", fmt.Errorf("invalid user unbonding id: %s", id)
+	}
+
+	epochIndex := strings.LastIndex(id[:delegatorIndex], ".")
+	if epochIndex < 0 {
+		return "", 0, "", fmt.Errorf("invalid user unbonding id: %s", id)
+	}
+
+	epoch, err := strconv.ParseUint(id[epochIndex+1:delegatorIndex], 10, 64)
+	if err != nil {
+		return "", 0, "", fmt.Errorf("invalid epoch of user unbonding id %s: %w", id, err)
+	}
+
+	return id[:epochIndex], epoch, id[delegatorIndex+1:], nil
+}
+
 func GetEpochUnbondingsKey(epoch uint64) []byte {
 	be := sdk.Uint64ToBigEndian(epoch)
 
 	return append(EpochUnbondingsPrefix, be...)
 }
 
+func GetEpochUnbondingsArchiveKey(epoch uint64) []byte {
+	be := sdk.Uint64ToBigEndian(epoch)
+
+	return append(EpochUnbondingsArchivePrefix, be...)
+}
+
+// GetStartedProxyUnbondingPrefix return prefix for the started ProxyUnbondings of chain, `StartedProxyUnbondingPrefix + len(chainID)+chainID`
+func GetStartedProxyUnbondingPrefix(chainID string) []byte {
+	return append(StartedProxyUnbondingPrefix, lengthPrefix([]byte(chainID))...)
+}
+
+// GetStartedProxyUnbondingKey return key for the started ProxyUnbonding, `StartedProxyUnbondingPrefix + len(chainID)+chainID + epoch`
+func GetStartedProxyUnbondingKey(chainID string, epoch uint64) []byte {
+	return append(GetStartedProxyUnbondingPrefix(chainID), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetProxyUnbondingQueueTimeKey returns the key of the proxy unbonding queue time slice of timestamp.
+func GetProxyUnbondingQueueTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ProxyUnbondingQueueKey, bz...)
+}
+
+// GetUserHistoryPrefix return prefix for the history of delegator, `UserHistoryPrefix + len(delegator)+delegator`
+func GetUserHistoryPrefix(delegator string) []byte {
+	return append(UserHistoryPrefix, lengthPrefix([]byte(delegator))...)
+}
+
+// GetUserHistoryKey return key for the history entry of delegator, `UserHistoryPrefix + len(delegator)+delegator + sequence`
+func GetUserHistoryKey(delegator string, sequence uint64) []byte {
+	return append(GetUserHistoryPrefix(delegator), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func lengthPrefix(bz []byte) []byte {
 	bzLen := len(bz)
 	if bzLen == 0 {
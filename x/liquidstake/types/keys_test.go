@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func TestUserUnbondingKeyWithDottedChainID(t *testing.T) {
+	// the UserUnbondings of "a.b" must not be iterated as the ones of "a".
+	key := types.GetUserUnbondingKey("a.b", 1, "delegator")
+	require.False(t, bytes.HasPrefix(key, types.GetUserUnbondingPrefix("a")))
+	require.True(t, bytes.HasPrefix(key, types.GetUserUnbondingPrefix("a.b")))
+
+	chainID, epoch, delegator, err := types.ParseUserUnbondingID(types.AssembleUserUnbondingID("a.b", 1, "delegator"))
+	require.NoError(t, err)
+	require.Equal(t, "a.b", chainID)
+	require.Equal(t, uint64(1), epoch)
+	require.Equal(t, "delegator", delegator)
+
+	idKey, err := types.GetUndelegationRecordKeyFromID(types.AssembleUserUnbondingID("a.b", 1, "delegator"))
+	require.NoError(t, err)
+	require.Equal(t, key, idKey)
+
+	_, _, _, err = types.ParseUserUnbondingID("a.b")
+	require.Error(t, err)
+}
+
+func TestParseIBCCallbackKey(t *testing.T) {
+	channel, port := types.ParseIBCCallbackKey(types.GetIBCCallbackKey([]byte("channel-0"), []byte("icacontroller-addr"), 7))
+	require.Equal(t, "channel-0", channel)
+	require.Equal(t, "icacontroller-addr", port)
+}
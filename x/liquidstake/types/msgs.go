@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,6 +14,14 @@ var (
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgReinvest{}
 	_ sdk.Msg = &MsgClaim{}
+	_ sdk.Msg = &MsgUpdateValidatorSelectionPolicy{}
+	_ sdk.Msg = &MsgSubmitHostValidatorSnapshot{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgResumeSourceChain{}
+	_ sdk.Msg = &MsgSetSourceChainStatus{}
+	_ sdk.Msg = &MsgDeregisterSourceChain{}
+	_ sdk.Msg = &MsgUpdateDerivativeDenomMetadata{}
+	_ sdk.Msg = &MsgClearValidatorSlashed{}
 )
 
 // GetSigners implements types.Msg
@@ -105,3 +115,153 @@ func (msg *MsgClaim) GetSigners() []sdk.AccAddress {
 func (*MsgClaim) ValidateBasic() error {
 	return nil
 }
+
+func (msg *MsgUpdateValidatorSelectionPolicy) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgUpdateValidatorSelectionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Policy.Validate()
+}
+
+func (msg *MsgSubmitHostValidatorSnapshot) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Submitter)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgSubmitHostValidatorSnapshot) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Submitter)
+	return err
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg *MsgResumeSourceChain) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgResumeSourceChain) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func (msg *MsgSetSourceChainStatus) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgSetSourceChainStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	if !msg.Status.IsValid() {
+		return fmt.Errorf("invalid source chain status %d", msg.Status)
+	}
+
+	return nil
+}
+
+func (msg *MsgDeregisterSourceChain) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgDeregisterSourceChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	if len(msg.ChainID) == 0 {
+		return fmt.Errorf("empty chainID")
+	}
+
+	return nil
+}
+
+func (msg *MsgUpdateDerivativeDenomMetadata) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgUpdateDerivativeDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	if len(msg.ChainID) == 0 {
+		return fmt.Errorf("empty chainID")
+	}
+
+	return msg.Metadata.Validate()
+}
+
+func (msg *MsgClearValidatorSlashed) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgClearValidatorSlashed) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	if len(msg.ChainID) == 0 {
+		return fmt.Errorf("empty chainID")
+	}
+
+	if len(msg.Validators) == 0 {
+		return fmt.Errorf("empty validators")
+	}
+
+	return nil
+}
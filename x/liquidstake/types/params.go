@@ -1,6 +1,70 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 var (
 	DefaultIBCTransferTimeoutNanos int64 = 1800000000000
 	DefaultICATimeoutNanos         int64 = 1800000000000
+
+	// DefaultMaxRedeemRateIncrease is the default max fraction which the redeem rate can increase in an epoch.
+	DefaultMaxRedeemRateIncrease = sdk.NewDecWithPrec(10, 2)
+
+	// DefaultMaxRedeemRateDecrease is the default max fraction which the redeem rate can decrease in an epoch.
+	DefaultMaxRedeemRateDecrease = sdk.NewDecWithPrec(10, 2)
+
+	// DefaultEpochProcessingBudget is the default max number of records which the epoch processing handles in a block.
+	DefaultEpochProcessingBudget uint64 = 100
+
+	// DefaultRecordRetention is the default number of epochs which the finished records are kept.
+	DefaultRecordRetention uint64 = 30
+
+	// DefaultMaxUserHistoryEntries is the default max number of history entries which are kept for a delegator.
+	DefaultMaxUserHistoryEntries uint64 = 100
 )
+
+// DefaultParams returns default liquidstake parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxRedeemRateIncrease:      DefaultMaxRedeemRateIncrease,
+		MaxRedeemRateDecrease:      DefaultMaxRedeemRateDecrease,
+		RedeemRateHistoryRetention: 0,
+		EpochProcessingBudget:      DefaultEpochProcessingBudget,
+		RecordRetention:            DefaultRecordRetention,
+		MaxUserHistoryEntries:      DefaultMaxUserHistoryEntries,
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.MaxRedeemRateIncrease.IsNil() || p.MaxRedeemRateIncrease.IsNegative() {
+		return fmt.Errorf("max redeem rate increase must be non-negative")
+	}
+
+	if p.MaxRedeemRateDecrease.IsNil() || p.MaxRedeemRateDecrease.IsNegative() || p.MaxRedeemRateDecrease.GT(sdk.OneDec()) {
+		return fmt.Errorf("max redeem rate decrease must be in [0, 1]")
+	}
+
+	return nil
+}
+
+// RedeemRateInBounds return true if the change from oldRate to newRate is allowed.
+func (p Params) RedeemRateInBounds(oldRate, newRate sdk.Dec) bool {
+	if oldRate.IsNil() || !oldRate.IsPositive() {
+		return true
+	}
+
+	change := newRate.Sub(oldRate).Quo(oldRate)
+	if p.MaxRedeemRateIncrease.IsPositive() && change.GT(p.MaxRedeemRateIncrease) {
+		return false
+	}
+
+	if p.MaxRedeemRateDecrease.IsPositive() && change.Neg().GT(p.MaxRedeemRateDecrease) {
+		return false
+	}
+
+	return true
+}
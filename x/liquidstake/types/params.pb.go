@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celinium/liquidstake/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the liquidstake module.
+type Params struct {
+	// The max fraction which the redeem rate can increase in an epoch.
+	// The source chain will be halted if it's exceeded. Zero means no limit.
+	MaxRedeemRateIncrease Dec `protobuf:"bytes,1,opt,name=maxRedeemRateIncrease,proto3,customtype=Dec" json:"maxRedeemRateIncrease"`
+	// The max fraction which the redeem rate can decrease in an epoch.
+	// The source chain will be halted if it's exceeded. Zero means no limit.
+	MaxRedeemRateDecrease Dec `protobuf:"bytes,2,opt,name=maxRedeemRateDecrease,proto3,customtype=Dec" json:"maxRedeemRateDecrease"`
+	// The number of epochs which the redeem rate history is kept. Zero means keep forever.
+	RedeemRateHistoryRetention uint64 `protobuf:"varint,3,opt,name=redeemRateHistoryRetention,proto3" json:"redeemRateHistoryRetention,omitempty"`
+	// The max number of records which the epoch processing handles in a block, the left
+	// records are processed in the subsequent blocks. Zero means no limit.
+	EpochProcessingBudget uint64 `protobuf:"varint,4,opt,name=epochProcessingBudget,proto3" json:"epochProcessingBudget,omitempty"`
+	// The number of epochs which the done ProxyDelegations, the done EpochProxyUnbondings and the
+	// complete UserUnbondings are kept after their epoch, then they are pruned. The delegations
+	// are counted in delegation epochs, the others in undelegation epochs. Zero means keep forever.
+	RecordRetention uint64 `protobuf:"varint,5,opt,name=recordRetention,proto3" json:"recordRetention,omitempty"`
+	// The max number of history entries which are kept for a delegator, the oldest entries are
+	// deleted when it's exceeded. Zero means no limit.
+	MaxUserHistoryEntries uint64 `protobuf:"varint,6,opt,name=maxUserHistoryEntries,proto3" json:"maxUserHistoryEntries,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fb706dc43cb8c3f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRedeemRateHistoryRetention() uint64 {
+	if m != nil {
+		return m.RedeemRateHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetEpochProcessingBudget() uint64 {
+	if m != nil {
+		return m.EpochProcessingBudget
+	}
+	return 0
+}
+
+func (m *Params) GetRecordRetention() uint64 {
+	if m != nil {
+		return m.RecordRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUserHistoryEntries() uint64 {
+	if m != nil {
+		return m.MaxUserHistoryEntries
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celinium.liquidstake.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("celinium/liquidstake/v1/params.proto", fileDescriptor_4fb706dc43cb8c3f)
+}
+
+var fileDescriptor_4fb706dc43cb8c3f = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x73, 0xb6, 0x16, 0xbc, 0x45, 0x08, 0x16, 0xd3, 0x22, 0xd7, 0x22, 0x0e, 0x5d, 0x6c,
+	0x28, 0x8a, 0xa3, 0x43, 0xa9, 0xa0, 0x93, 0x25, 0xe0, 0xe2, 0x22, 0xe7, 0xe5, 0x25, 0x1e, 0xf6,
+	0xee, 0xe2, 0xdd, 0xb5, 0xb4, 0x9b, 0x1f, 0xc1, 0x0f, 0xe3, 0x87, 0xe8, 0x58, 0x9c, 0xc4, 0xa1,
+	0x48, 0xf3, 0x45, 0xa4, 0x49, 0x5a, 0xff, 0x05, 0xc1, 0x2d, 0x79, 0x9f, 0xdf, 0xf3, 0x7b, 0x87,
+	0x7b, 0xf1, 0x01, 0x83, 0x01, 0x97, 0x7c, 0x28, 0xfc, 0x01, 0x7f, 0x18, 0xf2, 0xd0, 0x58, 0x7a,
+	0x0f, 0xfe, 0xa8, 0xe3, 0xc7, 0x54, 0x53, 0x61, 0xda, 0xb1, 0x56, 0x56, 0xb9, 0xbb, 0x2b, 0xaa,
+	0xfd, 0x85, 0x6a, 0x8f, 0x3a, 0xf5, 0x9d, 0x48, 0x45, 0x2a, 0x65, 0xfc, 0xe5, 0x57, 0x86, 0xd7,
+	0x6b, 0x4c, 0x19, 0xa1, 0xcc, 0x4d, 0x16, 0x64, 0x3f, 0x59, 0xb4, 0xff, 0x58, 0xc2, 0x95, 0x7e,
+	0xaa, 0x76, 0x2f, 0x71, 0x55, 0xd0, 0x71, 0x00, 0x21, 0x80, 0x08, 0xa8, 0x85, 0x0b, 0xc9, 0x34,
+	0x50, 0x03, 0x1e, 0x6a, 0xa2, 0xd6, 0x56, 0xb7, 0x36, 0x9d, 0x37, 0x9c, 0xb7, 0x79, 0xa3, 0xd4,
+	0x03, 0xf6, 0xf2, 0x7c, 0x88, 0x73, 0x4d, 0x0f, 0x58, 0x50, 0xdc, 0xfb, 0x25, 0xec, 0x41, 0x2e,
+	0xdc, 0xf8, 0x9f, 0x70, 0xd5, 0x73, 0x4f, 0x71, 0x5d, 0xaf, 0xa7, 0xe7, 0xdc, 0x58, 0xa5, 0x27,
+	0x01, 0x58, 0x90, 0x96, 0x2b, 0xe9, 0x95, 0x9a, 0xa8, 0x55, 0x0e, 0xfe, 0x20, 0xdc, 0x63, 0x5c,
+	0x85, 0x58, 0xb1, 0xbb, 0xbe, 0x56, 0x0c, 0x8c, 0xe1, 0x32, 0xea, 0x0e, 0xc3, 0x08, 0xac, 0x57,
+	0x4e, 0xab, 0xc5, 0xa1, 0xdb, 0xc2, 0xdb, 0x1a, 0x98, 0xd2, 0xe1, 0xe7, 0xaa, 0xcd, 0x94, 0xff,
+	0x39, 0x5e, 0xfa, 0x05, 0x1d, 0x5f, 0x19, 0xd0, 0xf9, 0xea, 0x33, 0x69, 0x35, 0x07, 0xe3, 0x55,
+	0x32, 0x7f, 0x61, 0xd8, 0x3d, 0x99, 0x2e, 0x08, 0x9a, 0x2d, 0x08, 0x7a, 0x5f, 0x10, 0xf4, 0x94,
+	0x10, 0x67, 0x96, 0x10, 0xe7, 0x35, 0x21, 0xce, 0xf5, 0xde, 0xfa, 0x18, 0xc6, 0xdf, 0xce, 0xc1,
+	0x4e, 0x62, 0x30, 0xb7, 0x95, 0xf4, 0x05, 0x8f, 0x3e, 0x06, 0x00, 0xcb, 0x7a, 0x45, 0x74, 0x33,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUserHistoryEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUserHistoryEntries))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RecordRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecordRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EpochProcessingBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochProcessingBudget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RedeemRateHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedeemRateHistoryRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxRedeemRateDecrease.Size()
+		i -= size
+		if _, err := m.MaxRedeemRateDecrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxRedeemRateIncrease.Size()
+		i -= size
+		if _, err := m.MaxRedeemRateIncrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxRedeemRateIncrease.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxRedeemRateDecrease.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RedeemRateHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.RedeemRateHistoryRetention))
+	}
+	if m.EpochProcessingBudget != 0 {
+		n += 1 + sovParams(uint64(m.EpochProcessingBudget))
+	}
+	if m.RecordRetention != 0 {
+		n += 1 + sovParams(uint64(m.RecordRetention))
+	}
+	if m.MaxUserHistoryEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxUserHistoryEntries))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedeemRateIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedeemRateIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedeemRateDecrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedeemRateDecrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemRateHistoryRetention", wireType)
+			}
+			m.RedeemRateHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedeemRateHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProcessingBudget", wireType)
+			}
+			m.EpochProcessingBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochProcessingBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRetention", wireType)
+			}
+			m.RecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUserHistoryEntries", wireType)
+			}
+			m.MaxUserHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUserHistoryEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
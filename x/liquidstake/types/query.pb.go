@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -406,651 +407,2673 @@ func (m *QueryUserUnbondingResponse) GetUserUnbondings() []UserUnbonding {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySourceChainRequest)(nil), "celinium.liquidstake.v1.QuerySourceChainRequest")
-	proto.RegisterType((*QuerySourceChainResponse)(nil), "celinium.liquidstake.v1.QuerySourceChainResponse")
-	proto.RegisterType((*QueryProxyDelegationRequest)(nil), "celinium.liquidstake.v1.QueryProxyDelegationRequest")
-	proto.RegisterType((*QueryProxyDelegationResponse)(nil), "celinium.liquidstake.v1.QueryProxyDelegationResponse")
-	proto.RegisterType((*QueryEpochProxyUnbondingRequest)(nil), "celinium.liquidstake.v1.QueryEpochProxyUnbondingRequest")
-	proto.RegisterType((*QueryEpochProxyUnbondingResponse)(nil), "celinium.liquidstake.v1.QueryEpochProxyUnbondingResponse")
-	proto.RegisterType((*QueryUserUnbondingRequest)(nil), "celinium.liquidstake.v1.QueryUserUnbondingRequest")
-	proto.RegisterType((*QueryUserUnbondingResponse)(nil), "celinium.liquidstake.v1.QueryUserUnbondingResponse")
+type QueryHostValidatorSnapshotRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("celinium/liquidstake/v1/query.proto", fileDescriptor_4a1fda13ab20bfc7)
+func (m *QueryHostValidatorSnapshotRequest) Reset()         { *m = QueryHostValidatorSnapshotRequest{} }
+func (m *QueryHostValidatorSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostValidatorSnapshotRequest) ProtoMessage()    {}
+func (*QueryHostValidatorSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{8}
 }
-
-var fileDescriptor_4a1fda13ab20bfc7 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc7, 0xb3, 0xfd, 0x25, 0xfd, 0x89, 0x89, 0x28, 0xd2, 0x52, 0xa9, 0xa9, 0x89, 0xdc, 0xc8,
-	0xfc, 0x49, 0x0a, 0xc2, 0x6e, 0x12, 0xa8, 0xe0, 0x5a, 0x0a, 0x52, 0x25, 0x90, 0x68, 0x20, 0x17,
-	0x2e, 0x51, 0xea, 0x2c, 0x8e, 0x45, 0xea, 0x75, 0xbc, 0x76, 0xd5, 0x5c, 0x79, 0x02, 0x24, 0x9e,
-	0x80, 0x23, 0x47, 0x5e, 0x81, 0x53, 0x8f, 0x91, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0x41, 0x90, 0xd7,
-	0xeb, 0xc4, 0x4e, 0xbd, 0x11, 0xe9, 0xcd, 0x93, 0x99, 0xef, 0x77, 0x3e, 0xb3, 0x9a, 0x09, 0xdc,
-	0x36, 0xc9, 0xc0, 0x76, 0xec, 0xe0, 0xd4, 0x18, 0xd8, 0xc3, 0xc0, 0xee, 0x31, 0xbf, 0xfb, 0x81,
-	0x18, 0x67, 0x75, 0x63, 0x18, 0x10, 0x6f, 0xa4, 0xbb, 0x1e, 0xf5, 0x29, 0xde, 0x8a, 0x8b, 0xf4,
-	0x44, 0x91, 0x7e, 0x56, 0x57, 0x36, 0x2d, 0x6a, 0x51, 0x5e, 0x63, 0x84, 0x5f, 0x51, 0xb9, 0xb2,
-	0x6d, 0x52, 0x76, 0x4a, 0x59, 0x27, 0x4a, 0x44, 0x81, 0x48, 0x95, 0x2d, 0x4a, 0xad, 0x01, 0x31,
-	0xba, 0xae, 0x6d, 0x74, 0x1d, 0x87, 0xfa, 0x5d, 0xdf, 0xa6, 0x4e, 0x9c, 0xbd, 0x2f, 0x83, 0x61,
-	0x34, 0xf0, 0x4c, 0xd2, 0x31, 0xfb, 0x5d, 0xdb, 0x11, 0xb5, 0x52, 0xf0, 0x08, 0x8e, 0x17, 0x69,
-	0x4d, 0xd8, 0x3a, 0x0e, 0xe7, 0x78, 0xc3, 0xf5, 0xcf, 0x42, 0x79, 0x8b, 0x0c, 0x03, 0xc2, 0x7c,
-	0x5c, 0x82, 0xff, 0x79, 0x7c, 0x74, 0x58, 0x42, 0x15, 0x54, 0xbb, 0xd6, 0x8a, 0x43, 0xad, 0x0f,
-	0xa5, 0xcb, 0x22, 0xe6, 0x52, 0x87, 0x11, 0xfc, 0x12, 0x8a, 0x6c, 0xfe, 0x33, 0x57, 0x16, 0x1b,
-	0x77, 0x74, 0xc9, 0xfb, 0xe8, 0x09, 0x8b, 0x83, 0xfc, 0xc5, 0xaf, 0x9d, 0x5c, 0x2b, 0x29, 0xd7,
-	0x5e, 0xc1, 0x2d, 0xde, 0xe9, 0xb5, 0x47, 0xcf, 0x47, 0x87, 0x64, 0x40, 0x2c, 0xfe, 0x1c, 0x31,
-	0xe2, 0x26, 0x14, 0x88, 0x4b, 0xcd, 0x3e, 0x6f, 0x93, 0x6f, 0x45, 0x41, 0x08, 0x6e, 0x0a, 0xf0,
-	0xb5, 0x08, 0x5c, 0x84, 0xda, 0x7b, 0x28, 0x67, 0xdb, 0x09, 0xf8, 0x17, 0xb0, 0xee, 0x11, 0x93,
-	0x7a, 0x3d, 0xc1, 0x5d, 0x93, 0x72, 0x2f, 0x38, 0x08, 0x76, 0xa1, 0xd6, 0x8e, 0x61, 0x87, 0xf7,
-	0x79, 0x1e, 0xf2, 0xf0, 0xd2, 0xb6, 0x73, 0x42, 0x9d, 0x9e, 0xed, 0x58, 0x57, 0x45, 0x1f, 0x41,
-	0x45, 0x6e, 0x29, 0xf0, 0xdb, 0xb0, 0xc1, 0xcb, 0x67, 0x19, 0x31, 0x46, 0x75, 0xf9, 0x18, 0xb3,
-	0x72, 0x31, 0xc5, 0x82, 0x89, 0x76, 0x04, 0xdb, 0xbc, 0x75, 0x9b, 0x11, 0xef, 0xd2, 0x1c, 0x09,
-	0x62, 0x94, 0x22, 0xc6, 0x18, 0xf2, 0x01, 0x23, 0x9e, 0x18, 0x84, 0x7f, 0x6b, 0x1e, 0x28, 0x59,
-	0x56, 0x82, 0xff, 0x2d, 0x6c, 0x04, 0xc9, 0x04, 0x2b, 0xa1, 0xca, 0x7f, 0xb5, 0x62, 0xe3, 0x9e,
-	0x94, 0x3f, 0xe5, 0x13, 0xe3, 0xa7, 0x3d, 0x1a, 0xe3, 0x02, 0x14, 0x78, 0x53, 0xfc, 0x05, 0x41,
-	0x31, 0xb1, 0x70, 0x78, 0x4f, 0xea, 0x2b, 0xb9, 0x09, 0xa5, 0xbe, 0x82, 0x22, 0x1a, 0x4a, 0x7b,
-	0xf8, 0xf1, 0xc7, 0x9f, 0xcf, 0x6b, 0x55, 0x7c, 0xd7, 0xf8, 0x97, 0xdb, 0xc5, 0xdf, 0x10, 0xdc,
-	0x58, 0x58, 0x2e, 0xfc, 0x68, 0x79, 0xd7, 0xec, 0xe3, 0x50, 0x1e, 0xaf, 0xa8, 0x12, 0xbc, 0x75,
-	0xce, 0xfb, 0x00, 0xef, 0x4a, 0x79, 0xdd, 0x50, 0xd9, 0xe9, 0xcd, 0xf9, 0xbe, 0x23, 0xb8, 0x99,
-	0xb1, 0x97, 0xf8, 0xc9, 0x72, 0x02, 0xf9, 0x75, 0x28, 0x4f, 0xaf, 0xa0, 0x14, 0xfc, 0xfb, 0x9c,
-	0x7f, 0x0f, 0xeb, 0x52, 0x7e, 0x7e, 0x6a, 0x9d, 0x68, 0x8a, 0x60, 0x06, 0xfb, 0x15, 0xc1, 0xf5,
-	0xd4, 0x3a, 0xe1, 0xc6, 0x72, 0x88, 0xac, 0x73, 0x50, 0x9a, 0x2b, 0x69, 0x04, 0xb2, 0xc1, 0x91,
-	0x77, 0x71, 0x55, 0x8a, 0x1c, 0xae, 0xf4, 0x9c, 0xf5, 0x60, 0xff, 0x62, 0xa2, 0xa2, 0xf1, 0x44,
-	0x45, 0xbf, 0x27, 0x2a, 0xfa, 0x34, 0x55, 0x73, 0xe3, 0xa9, 0x9a, 0xfb, 0x39, 0x55, 0x73, 0xef,
-	0xca, 0x33, 0x87, 0xf3, 0x94, 0x87, 0x3f, 0x72, 0x09, 0x3b, 0x59, 0xe7, 0x7f, 0xfa, 0xcd, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x05, 0x56, 0xbb, 0xd4, 0x06, 0x00, 0x00,
+func (m *QueryHostValidatorSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostValidatorSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostValidatorSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostValidatorSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostValidatorSnapshotRequest.Merge(m, src)
+}
+func (m *QueryHostValidatorSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostValidatorSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostValidatorSnapshotRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryHostValidatorSnapshotRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	SourceChain(ctx context.Context, in *QuerySourceChainRequest, opts ...grpc.CallOption) (*QuerySourceChainResponse, error)
-	ProxyDelegation(ctx context.Context, in *QueryProxyDelegationRequest, opts ...grpc.CallOption) (*QueryProxyDelegationResponse, error)
-	EpochProxyUnbonding(ctx context.Context, in *QueryEpochProxyUnbondingRequest, opts ...grpc.CallOption) (*QueryEpochProxyUnbondingResponse, error)
-	UserUnbonding(ctx context.Context, in *QueryUserUnbondingRequest, opts ...grpc.CallOption) (*QueryUserUnbondingResponse, error)
+func (m *QueryHostValidatorSnapshotRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryHostValidatorSnapshotResponse struct {
+	Snapshot HostValidatorSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryHostValidatorSnapshotResponse) Reset()         { *m = QueryHostValidatorSnapshotResponse{} }
+func (m *QueryHostValidatorSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostValidatorSnapshotResponse) ProtoMessage()    {}
+func (*QueryHostValidatorSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{9}
 }
-
-func (c *queryClient) SourceChain(ctx context.Context, in *QuerySourceChainRequest, opts ...grpc.CallOption) (*QuerySourceChainResponse, error) {
-	out := new(QuerySourceChainResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/SourceChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHostValidatorSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostValidatorSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostValidatorSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHostValidatorSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostValidatorSnapshotResponse.Merge(m, src)
+}
+func (m *QueryHostValidatorSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostValidatorSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostValidatorSnapshotResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProxyDelegation(ctx context.Context, in *QueryProxyDelegationRequest, opts ...grpc.CallOption) (*QueryProxyDelegationResponse, error) {
-	out := new(QueryProxyDelegationResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/ProxyDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostValidatorSnapshotResponse proto.InternalMessageInfo
+
+func (m *QueryHostValidatorSnapshotResponse) GetSnapshot() HostValidatorSnapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return out, nil
+	return HostValidatorSnapshot{}
 }
 
-func (c *queryClient) EpochProxyUnbonding(ctx context.Context, in *QueryEpochProxyUnbondingRequest, opts ...grpc.CallOption) (*QueryEpochProxyUnbondingResponse, error) {
-	out := new(QueryEpochProxyUnbondingResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/EpochProxyUnbonding", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryParamsRequest struct {
 }
 
-func (c *queryClient) UserUnbonding(ctx context.Context, in *QueryUserUnbondingRequest, opts ...grpc.CallOption) (*QueryUserUnbondingResponse, error) {
-	out := new(QueryUserUnbondingResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/UserUnbonding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{10}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	SourceChain(context.Context, *QuerySourceChainRequest) (*QuerySourceChainResponse, error)
-	ProxyDelegation(context.Context, *QueryProxyDelegationRequest) (*QueryProxyDelegationResponse, error)
-	EpochProxyUnbonding(context.Context, *QueryEpochProxyUnbondingRequest) (*QueryEpochProxyUnbondingResponse, error)
-	UserUnbonding(context.Context, *QueryUserUnbondingRequest) (*QueryUserUnbondingResponse, error)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (*UnimplementedQueryServer) SourceChain(ctx context.Context, req *QuerySourceChainRequest) (*QuerySourceChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SourceChain not implemented")
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{11}
 }
-func (*UnimplementedQueryServer) ProxyDelegation(ctx context.Context, req *QueryProxyDelegationRequest) (*QueryProxyDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProxyDelegation not implemented")
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EpochProxyUnbonding(ctx context.Context, req *QueryEpochProxyUnbondingRequest) (*QueryEpochProxyUnbondingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochProxyUnbonding not implemented")
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserUnbonding(ctx context.Context, req *QueryUserUnbondingRequest) (*QueryUserUnbondingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUnbonding not implemented")
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func _Query_SourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySourceChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SourceChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Query/SourceChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SourceChain(ctx, req.(*QuerySourceChainRequest))
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Query_ProxyDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProxyDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProxyDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Query/ProxyDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProxyDelegation(ctx, req.(*QueryProxyDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedeemRateHistoryRequest struct {
+	ChainID    string             `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_EpochProxyUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochProxyUnbondingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochProxyUnbonding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Query/EpochProxyUnbonding",
+func (m *QueryRedeemRateHistoryRequest) Reset()         { *m = QueryRedeemRateHistoryRequest{} }
+func (m *QueryRedeemRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedeemRateHistoryRequest) ProtoMessage()    {}
+func (*QueryRedeemRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{12}
+}
+func (m *QueryRedeemRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedeemRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedeemRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochProxyUnbonding(ctx, req.(*QueryEpochProxyUnbondingRequest))
+}
+func (m *QueryRedeemRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedeemRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryRedeemRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedeemRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedeemRateHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedeemRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRedeemRateHistoryRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_UserUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserUnbondingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryRedeemRateHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserUnbonding(ctx, in)
+	return nil
+}
+
+type QueryRedeemRateHistoryResponse struct {
+	Records    []RedeemRateRecord  `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRedeemRateHistoryResponse) Reset()         { *m = QueryRedeemRateHistoryResponse{} }
+func (m *QueryRedeemRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedeemRateHistoryResponse) ProtoMessage()    {}
+func (*QueryRedeemRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{13}
+}
+func (m *QueryRedeemRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedeemRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedeemRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Query/UserUnbonding",
+}
+func (m *QueryRedeemRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedeemRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryRedeemRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedeemRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedeemRateHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedeemRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRedeemRateHistoryResponse) GetRecords() []RedeemRateRecord {
+	if m != nil {
+		return m.Records
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserUnbonding(ctx, req.(*QueryUserUnbondingRequest))
+	return nil
+}
+
+func (m *QueryRedeemRateHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celinium.liquidstake.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SourceChain",
-			Handler:    _Query_SourceChain_Handler,
-		},
-		{
-			MethodName: "ProxyDelegation",
-			Handler:    _Query_ProxyDelegation_Handler,
-		},
-		{
-			MethodName: "EpochProxyUnbonding",
-			Handler:    _Query_EpochProxyUnbonding_Handler,
-		},
-		{
-			MethodName: "UserUnbonding",
-			Handler:    _Query_UserUnbonding_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celinium/liquidstake/v1/query.proto",
+type QuerySourceChainStatusRequest struct {
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
-func (m *QuerySourceChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySourceChainStatusRequest) Reset()         { *m = QuerySourceChainStatusRequest{} }
+func (m *QuerySourceChainStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainStatusRequest) ProtoMessage()    {}
+func (*QuerySourceChainStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{14}
 }
-
-func (m *QuerySourceChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySourceChainStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QuerySourceChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySourceChainStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySourceChainStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainStatusRequest.Merge(m, src)
+}
+func (m *QuerySourceChainStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySourceChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySourceChainStatusRequest proto.InternalMessageInfo
+
+func (m *QuerySourceChainStatusRequest) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySourceChainStatusResponse struct {
+	// The status set by governance.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Whether the source chain is halted by the redeem rate circuit breaker.
+	Halted bool `protobuf:"varint,2,opt,name=halted,proto3" json:"halted,omitempty"`
 }
 
-func (m *QuerySourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SourceChain.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySourceChainStatusResponse) Reset()         { *m = QuerySourceChainStatusResponse{} }
+func (m *QuerySourceChainStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySourceChainStatusResponse) ProtoMessage()    {}
+func (*QuerySourceChainStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{15}
+}
+func (m *QuerySourceChainStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySourceChainStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySourceChainStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProxyDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySourceChainStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySourceChainStatusResponse.Merge(m, src)
 }
-
-func (m *QueryProxyDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySourceChainStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySourceChainStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySourceChainStatusResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProxyDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QuerySourceChainStatusResponse proto.InternalMessageInfo
+
+func (m *QuerySourceChainStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryProxyDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySourceChainStatusResponse) GetHalted() bool {
+	if m != nil {
+		return m.Halted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryProxyDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUserHistoryRequest struct {
+	Delegator  string             `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProxyDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUserHistoryRequest) Reset()         { *m = QueryUserHistoryRequest{} }
+func (m *QueryUserHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserHistoryRequest) ProtoMessage()    {}
+func (*QueryUserHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{16}
+}
+func (m *QueryUserHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEpochProxyUnbondingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUserHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserHistoryRequest.Merge(m, src)
 }
-
-func (m *QueryEpochProxyUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUserHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEpochProxyUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryUserHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryUserHistoryRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryEpochProxyUnbondingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUserHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEpochProxyUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUserHistoryResponse struct {
+	// The delegations, undelegations and claims of the delegator across source chains, oldest first.
+	Entries    []UserHistoryEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEpochProxyUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ChainUnbonding.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUserHistoryResponse) Reset()         { *m = QueryUserHistoryResponse{} }
+func (m *QueryUserHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserHistoryResponse) ProtoMessage()    {}
+func (*QueryUserHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a1fda13ab20bfc7, []int{17}
+}
+func (m *QueryUserHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryUserHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserHistoryResponse.Merge(m, src)
+}
+func (m *QueryUserHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserUnbondingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUserHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryUserHistoryResponse) GetEntries() []UserHistoryEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUserHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryUserUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QuerySourceChainRequest)(nil), "celinium.liquidstake.v1.QuerySourceChainRequest")
+	proto.RegisterType((*QuerySourceChainResponse)(nil), "celinium.liquidstake.v1.QuerySourceChainResponse")
+	proto.RegisterType((*QueryProxyDelegationRequest)(nil), "celinium.liquidstake.v1.QueryProxyDelegationRequest")
+	proto.RegisterType((*QueryProxyDelegationResponse)(nil), "celinium.liquidstake.v1.QueryProxyDelegationResponse")
+	proto.RegisterType((*QueryEpochProxyUnbondingRequest)(nil), "celinium.liquidstake.v1.QueryEpochProxyUnbondingRequest")
+	proto.RegisterType((*QueryEpochProxyUnbondingResponse)(nil), "celinium.liquidstake.v1.QueryEpochProxyUnbondingResponse")
+	proto.RegisterType((*QueryUserUnbondingRequest)(nil), "celinium.liquidstake.v1.QueryUserUnbondingRequest")
+	proto.RegisterType((*QueryUserUnbondingResponse)(nil), "celinium.liquidstake.v1.QueryUserUnbondingResponse")
+	proto.RegisterType((*QueryHostValidatorSnapshotRequest)(nil), "celinium.liquidstake.v1.QueryHostValidatorSnapshotRequest")
+	proto.RegisterType((*QueryHostValidatorSnapshotResponse)(nil), "celinium.liquidstake.v1.QueryHostValidatorSnapshotResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "celinium.liquidstake.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celinium.liquidstake.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRedeemRateHistoryRequest)(nil), "celinium.liquidstake.v1.QueryRedeemRateHistoryRequest")
+	proto.RegisterType((*QueryRedeemRateHistoryResponse)(nil), "celinium.liquidstake.v1.QueryRedeemRateHistoryResponse")
+	proto.RegisterType((*QuerySourceChainStatusRequest)(nil), "celinium.liquidstake.v1.QuerySourceChainStatusRequest")
+	proto.RegisterType((*QuerySourceChainStatusResponse)(nil), "celinium.liquidstake.v1.QuerySourceChainStatusResponse")
+	proto.RegisterType((*QueryUserHistoryRequest)(nil), "celinium.liquidstake.v1.QueryUserHistoryRequest")
+	proto.RegisterType((*QueryUserHistoryResponse)(nil), "celinium.liquidstake.v1.QueryUserHistoryResponse")
 }
 
-func (m *QueryUserUnbondingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("celinium/liquidstake/v1/query.proto", fileDescriptor_4a1fda13ab20bfc7)
+}
+
+var fileDescriptor_4a1fda13ab20bfc7 = []byte{
+	// 1029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x25, 0x75, 0xdb, 0x17, 0x28, 0x62, 0x1a, 0xa8, 0xbb, 0x04, 0x27, 0x5d, 0x0a,
+	0x4e, 0x68, 0xd9, 0x8d, 0x9d, 0x92, 0xb6, 0xa0, 0x5e, 0x4a, 0x5b, 0x1a, 0x09, 0xa4, 0x74, 0xd3,
+	0x70, 0xe0, 0xb2, 0xda, 0xd8, 0x83, 0xbd, 0xc2, 0xd9, 0x71, 0x66, 0x66, 0xad, 0xfa, 0x84, 0xe0,
+	0xcc, 0x01, 0x89, 0x4f, 0xc0, 0x91, 0x0b, 0x12, 0x12, 0x27, 0x8e, 0x9c, 0xaa, 0x9e, 0x2a, 0x71,
+	0xe1, 0x84, 0x50, 0xc2, 0x07, 0x41, 0x3b, 0xf3, 0xd6, 0x5e, 0xc7, 0x9e, 0x75, 0x1c, 0xf5, 0x96,
+	0xd9, 0x7d, 0xff, 0xf7, 0x7e, 0x6f, 0xdf, 0xee, 0xfb, 0x3b, 0xf0, 0x6e, 0x83, 0x76, 0xa2, 0x38,
+	0x4a, 0xf6, 0xbd, 0x4e, 0x74, 0x90, 0x44, 0x4d, 0x21, 0xc3, 0x6f, 0xa8, 0xd7, 0xab, 0x79, 0x07,
+	0x09, 0xe5, 0x7d, 0xb7, 0xcb, 0x99, 0x64, 0xe4, 0x72, 0x16, 0xe4, 0xe6, 0x82, 0xdc, 0x5e, 0xcd,
+	0x5e, 0x6c, 0xb1, 0x16, 0x53, 0x31, 0x5e, 0xfa, 0x97, 0x0e, 0xb7, 0xaf, 0x34, 0x98, 0xd8, 0x67,
+	0x22, 0xd0, 0x37, 0xf4, 0x01, 0x6f, 0x2d, 0xb5, 0x18, 0x6b, 0x75, 0xa8, 0x17, 0x76, 0x23, 0x2f,
+	0x8c, 0x63, 0x26, 0x43, 0x19, 0xb1, 0x38, 0xbb, 0xfb, 0x81, 0x09, 0x46, 0xb0, 0x84, 0x37, 0x68,
+	0xd0, 0x68, 0x87, 0x51, 0x8c, 0xb1, 0x46, 0x70, 0x0d, 0xa7, 0x83, 0xae, 0x99, 0x82, 0xba, 0x21,
+	0x0f, 0xf7, 0x87, 0x65, 0x15, 0xa2, 0xb7, 0x17, 0x0a, 0xaa, 0xfb, 0xf6, 0x7a, 0xb5, 0x3d, 0x2a,
+	0xc3, 0x34, 0xae, 0x15, 0xc5, 0x8a, 0x51, 0xc7, 0x3a, 0x1b, 0x70, 0xf9, 0x71, 0x1a, 0xb1, 0xa3,
+	0x88, 0x3e, 0x4d, 0x81, 0x7c, 0x7a, 0x90, 0x50, 0x21, 0x49, 0x19, 0xce, 0xa9, 0xf3, 0xd6, 0xfd,
+	0xb2, 0xb5, 0x62, 0xad, 0x5e, 0xf0, 0xb3, 0xa3, 0xd3, 0x86, 0xf2, 0xb8, 0x48, 0x74, 0x59, 0x2c,
+	0x28, 0xf9, 0x1c, 0x16, 0xc4, 0xf0, 0xb2, 0x52, 0x2e, 0xd4, 0xaf, 0xb9, 0x86, 0x27, 0xee, 0xe6,
+	0x52, 0xdc, 0x9b, 0x7f, 0xf6, 0xcf, 0xf2, 0x9c, 0x9f, 0x97, 0x3b, 0x5f, 0xc0, 0xdb, 0xaa, 0xd2,
+	0x36, 0x67, 0x4f, 0xfb, 0xf7, 0x69, 0x87, 0xb6, 0x14, 0x7c, 0x86, 0xb8, 0x08, 0x67, 0x69, 0x97,
+	0x35, 0xda, 0xaa, 0xcc, 0xbc, 0xaf, 0x0f, 0x29, 0x78, 0x03, 0xc1, 0xcf, 0x68, 0x70, 0x3c, 0x3a,
+	0x5f, 0xc3, 0xd2, 0xe4, 0x74, 0x08, 0xff, 0x10, 0x4a, 0x9c, 0x36, 0x18, 0x6f, 0x22, 0xf7, 0xaa,
+	0x91, 0xfb, 0x58, 0x06, 0x64, 0x47, 0xb5, 0xf3, 0x18, 0x96, 0x55, 0x9d, 0x07, 0x29, 0x8f, 0x0a,
+	0xdd, 0x8d, 0xf7, 0x58, 0xdc, 0x8c, 0xe2, 0xd6, 0x69, 0xd1, 0xfb, 0xb0, 0x62, 0x4e, 0x89, 0xf8,
+	0xbb, 0x70, 0x51, 0x85, 0x0f, 0xee, 0x60, 0x1b, 0xd5, 0xe2, 0x36, 0x06, 0xe1, 0xd8, 0xc5, 0xb1,
+	0x24, 0xce, 0x16, 0x5c, 0x51, 0xa5, 0x77, 0x05, 0xe5, 0x63, 0x7d, 0xe4, 0x88, 0xad, 0x11, 0x62,
+	0x42, 0x60, 0x3e, 0x11, 0x94, 0x63, 0x23, 0xea, 0x6f, 0x87, 0x83, 0x3d, 0x29, 0x15, 0xf2, 0x3f,
+	0x81, 0x8b, 0x49, 0xfe, 0x86, 0x28, 0x5b, 0x2b, 0xaf, 0xac, 0x2e, 0xd4, 0xdf, 0x37, 0xf2, 0x8f,
+	0xe4, 0xc9, 0xf0, 0x47, 0x73, 0x38, 0x77, 0xe1, 0xaa, 0xaa, 0xf9, 0x88, 0x09, 0xf9, 0x65, 0xd8,
+	0x89, 0x9a, 0xa1, 0x64, 0x7c, 0x27, 0x0e, 0xbb, 0xa2, 0xcd, 0xe4, 0xd4, 0x36, 0x9c, 0x1e, 0x38,
+	0x45, 0x72, 0x44, 0xdf, 0x86, 0xf3, 0x02, 0xaf, 0xe1, 0x43, 0x77, 0x8d, 0xd0, 0x13, 0x33, 0x21,
+	0xfc, 0x20, 0x8b, 0xb3, 0x08, 0x44, 0xbf, 0xab, 0xea, 0xd3, 0x46, 0x4e, 0xe7, 0x09, 0x5c, 0x1a,
+	0xb9, 0x8a, 0xe5, 0xef, 0x42, 0x49, 0xaf, 0x00, 0x2c, 0xbe, 0x6c, 0x9e, 0xb8, 0x0a, 0xcb, 0xde,
+	0x57, 0x2d, 0x72, 0xbe, 0xb3, 0xe0, 0x1d, 0x95, 0xd6, 0xa7, 0x4d, 0x4a, 0xf7, 0xfd, 0x50, 0xd2,
+	0x47, 0x91, 0x90, 0x8c, 0xf7, 0xb1, 0x6e, 0xc1, 0x98, 0x1f, 0x02, 0x0c, 0xb7, 0x8a, 0x1a, 0xb6,
+	0x1a, 0x98, 0xde, 0x92, 0xe9, 0x0a, 0x72, 0xf5, 0xea, 0xc5, 0x15, 0xe4, 0x6e, 0x87, 0x2d, 0x8a,
+	0x59, 0xfd, 0x9c, 0xd2, 0xf9, 0xdd, 0x82, 0x8a, 0x89, 0x01, 0xbb, 0xdc, 0x82, 0x73, 0xfa, 0x03,
+	0xcb, 0x5e, 0x8c, 0x35, 0x63, 0x9b, 0xc3, 0x24, 0xbe, 0x52, 0x60, 0xc3, 0x99, 0x9e, 0x7c, 0x36,
+	0x81, 0xba, 0x3a, 0x95, 0x5a, 0x73, 0x8c, 0x60, 0xdf, 0xc1, 0x27, 0x97, 0x5b, 0x64, 0x3b, 0x32,
+	0x94, 0x89, 0x98, 0xfe, 0x66, 0x6d, 0x43, 0xc5, 0x24, 0xc5, 0x86, 0xdf, 0x82, 0x92, 0x50, 0x57,
+	0x50, 0x8a, 0xa7, 0xf4, 0x7a, 0x3b, 0xec, 0x48, 0xda, 0x54, 0xe4, 0xe7, 0x7d, 0x3c, 0x39, 0xdf,
+	0xe2, 0x36, 0x4f, 0x3f, 0x8b, 0x63, 0x03, 0x5c, 0x82, 0x0b, 0x4d, 0xbd, 0xae, 0x18, 0xc7, 0x6c,
+	0xc3, 0x0b, 0x2f, 0x6d, 0x88, 0xbf, 0x5a, 0x50, 0x1e, 0x27, 0x18, 0x8e, 0x8f, 0xc6, 0x92, 0x47,
+	0x74, 0xfa, 0xf8, 0x72, 0xf2, 0x07, 0xb1, 0xe4, 0xfd, 0x6c, 0x7c, 0xa8, 0x7f, 0x69, 0xe3, 0xab,
+	0x3f, 0x7f, 0x15, 0xce, 0x2a, 0x60, 0xf2, 0xb3, 0x05, 0x0b, 0xb9, 0x49, 0x90, 0x75, 0x23, 0x9c,
+	0xc1, 0x30, 0xed, 0xda, 0x0c, 0x0a, 0x8d, 0xe2, 0x7c, 0xf8, 0xfd, 0x5f, 0xff, 0xfd, 0x74, 0xa6,
+	0x4a, 0xde, 0xf3, 0x4e, 0xf2, 0x53, 0x81, 0xfc, 0x66, 0xc1, 0xeb, 0xc7, 0x9c, 0x87, 0xdc, 0x2c,
+	0xae, 0x3a, 0xd9, 0x39, 0xed, 0x8f, 0x66, 0x54, 0x21, 0x6f, 0x4d, 0xf1, 0x5e, 0x27, 0x6b, 0x46,
+	0xde, 0x6e, 0xaa, 0x0c, 0x9a, 0x43, 0xbe, 0x3f, 0x2d, 0xb8, 0x34, 0xc1, 0xb4, 0xc8, 0xed, 0x62,
+	0x02, 0xb3, 0x75, 0xda, 0x77, 0x4e, 0xa1, 0x44, 0xfe, 0x4d, 0xc5, 0xbf, 0x4e, 0x5c, 0x23, 0xbf,
+	0xf2, 0xe1, 0x40, 0x77, 0x91, 0x0c, 0x60, 0x7f, 0xb1, 0xe0, 0xb5, 0x11, 0xaf, 0x21, 0xf5, 0x62,
+	0x88, 0x49, 0x5e, 0x69, 0x6f, 0xcc, 0xa4, 0x41, 0x64, 0x4f, 0x21, 0xaf, 0x91, 0xaa, 0x11, 0x39,
+	0xf5, 0xbb, 0x1c, 0xeb, 0x73, 0x0b, 0xde, 0x9c, 0x68, 0x31, 0xe4, 0xe3, 0xe2, 0xfa, 0x45, 0x06,
+	0x69, 0x7f, 0x72, 0x2a, 0x2d, 0xf6, 0x70, 0x5b, 0xf5, 0x50, 0x27, 0xeb, 0xc6, 0x1e, 0xda, 0x4c,
+	0xc8, 0xa0, 0x97, 0x25, 0x08, 0x32, 0x17, 0x24, 0x3f, 0x58, 0x50, 0xd2, 0x96, 0x45, 0xae, 0x4f,
+	0x79, 0x65, 0xf3, 0x3e, 0x69, 0xdf, 0x38, 0x59, 0x30, 0xf2, 0x55, 0x15, 0xdf, 0x55, 0xb2, 0xec,
+	0x15, 0xff, 0xc0, 0x26, 0x7f, 0x58, 0xf0, 0xc6, 0x98, 0x3f, 0x91, 0xcd, 0xe2, 0x62, 0x26, 0x53,
+	0xb5, 0x6f, 0xcd, 0xac, 0x43, 0xde, 0x9b, 0x8a, 0xd7, 0x25, 0x37, 0x8c, 0xbc, 0x5c, 0x69, 0x03,
+	0x1e, 0x4a, 0x1a, 0xb4, 0x11, 0x33, 0x85, 0x1f, 0xf3, 0x9a, 0x69, 0xf0, 0x26, 0x5f, 0xb3, 0x6f,
+	0xcd, 0xac, 0x3b, 0x31, 0x7c, 0x7e, 0xe7, 0x05, 0x68, 0x79, 0xe9, 0x7a, 0xce, 0xb9, 0xc2, 0xb4,
+	0xf5, 0x3c, 0xee, 0x80, 0x76, 0x6d, 0x06, 0xc5, 0x89, 0xd7, 0xb3, 0xfa, 0xf6, 0xf0, 0x01, 0xdf,
+	0xdb, 0x7c, 0x76, 0x58, 0xb1, 0x5e, 0x1c, 0x56, 0xac, 0x7f, 0x0f, 0x2b, 0xd6, 0x8f, 0x47, 0x95,
+	0xb9, 0x17, 0x47, 0x95, 0xb9, 0xbf, 0x8f, 0x2a, 0x73, 0x5f, 0x2d, 0x0d, 0xf4, 0x4f, 0x47, 0x32,
+	0xc8, 0x7e, 0x97, 0x8a, 0xbd, 0x92, 0xfa, 0x5f, 0x6c, 0xe3, 0xff, 0x01, 0x00, 0x30, 0xb2, 0xd1,
+	0x57, 0xbd, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	SourceChain(ctx context.Context, in *QuerySourceChainRequest, opts ...grpc.CallOption) (*QuerySourceChainResponse, error)
+	ProxyDelegation(ctx context.Context, in *QueryProxyDelegationRequest, opts ...grpc.CallOption) (*QueryProxyDelegationResponse, error)
+	EpochProxyUnbonding(ctx context.Context, in *QueryEpochProxyUnbondingRequest, opts ...grpc.CallOption) (*QueryEpochProxyUnbondingResponse, error)
+	UserUnbonding(ctx context.Context, in *QueryUserUnbondingRequest, opts ...grpc.CallOption) (*QueryUserUnbondingResponse, error)
+	HostValidatorSnapshot(ctx context.Context, in *QueryHostValidatorSnapshotRequest, opts ...grpc.CallOption) (*QueryHostValidatorSnapshotResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	RedeemRateHistory(ctx context.Context, in *QueryRedeemRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedeemRateHistoryResponse, error)
+	SourceChainStatus(ctx context.Context, in *QuerySourceChainStatusRequest, opts ...grpc.CallOption) (*QuerySourceChainStatusResponse, error)
+	UserHistory(ctx context.Context, in *QueryUserHistoryRequest, opts ...grpc.CallOption) (*QueryUserHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SourceChain(ctx context.Context, in *QuerySourceChainRequest, opts ...grpc.CallOption) (*QuerySourceChainResponse, error) {
+	out := new(QuerySourceChainResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/SourceChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryUserUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProxyDelegation(ctx context.Context, in *QueryProxyDelegationRequest, opts ...grpc.CallOption) (*QueryProxyDelegationResponse, error) {
+	out := new(QueryProxyDelegationResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/ProxyDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryUserUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserUnbondings) > 0 {
-		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) EpochProxyUnbonding(ctx context.Context, in *QueryEpochProxyUnbondingRequest, opts ...grpc.CallOption) (*QueryEpochProxyUnbondingResponse, error) {
+	out := new(QueryEpochProxyUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/EpochProxyUnbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) UserUnbonding(ctx context.Context, in *QueryUserUnbondingRequest, opts ...grpc.CallOption) (*QueryUserUnbondingResponse, error) {
+	out := new(QueryUserUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/UserUnbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QuerySourceChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) HostValidatorSnapshot(ctx context.Context, in *QueryHostValidatorSnapshotRequest, opts ...grpc.CallOption) (*QueryHostValidatorSnapshotResponse, error) {
+	out := new(QueryHostValidatorSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/HostValidatorSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QuerySourceChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.SourceChain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryProxyDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RedeemRateHistory(ctx context.Context, in *QueryRedeemRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedeemRateHistoryResponse, error) {
+	out := new(QueryRedeemRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/RedeemRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProxyDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) SourceChainStatus(ctx context.Context, in *QuerySourceChainStatusRequest, opts ...grpc.CallOption) (*QuerySourceChainStatusResponse, error) {
+	out := new(QuerySourceChainStatusResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/SourceChainStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryEpochProxyUnbondingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) UserHistory(ctx context.Context, in *QueryUserHistoryRequest, opts ...grpc.CallOption) (*QueryUserHistoryResponse, error) {
+	out := new(QueryUserHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Query/UserHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEpochProxyUnbondingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ChainUnbonding.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	SourceChain(context.Context, *QuerySourceChainRequest) (*QuerySourceChainResponse, error)
+	ProxyDelegation(context.Context, *QueryProxyDelegationRequest) (*QueryProxyDelegationResponse, error)
+	EpochProxyUnbonding(context.Context, *QueryEpochProxyUnbondingRequest) (*QueryEpochProxyUnbondingResponse, error)
+	UserUnbonding(context.Context, *QueryUserUnbondingRequest) (*QueryUserUnbondingResponse, error)
+	HostValidatorSnapshot(context.Context, *QueryHostValidatorSnapshotRequest) (*QueryHostValidatorSnapshotResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	RedeemRateHistory(context.Context, *QueryRedeemRateHistoryRequest) (*QueryRedeemRateHistoryResponse, error)
+	SourceChainStatus(context.Context, *QuerySourceChainStatusRequest) (*QuerySourceChainStatusResponse, error)
+	UserHistory(context.Context, *QueryUserHistoryRequest) (*QueryUserHistoryResponse, error)
 }
 
-func (m *QueryUserUnbondingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SourceChain(ctx context.Context, req *QuerySourceChainRequest) (*QuerySourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChain not implemented")
+}
+func (*UnimplementedQueryServer) ProxyDelegation(ctx context.Context, req *QueryProxyDelegationRequest) (*QueryProxyDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyDelegation not implemented")
+}
+func (*UnimplementedQueryServer) EpochProxyUnbonding(ctx context.Context, req *QueryEpochProxyUnbondingRequest) (*QueryEpochProxyUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochProxyUnbonding not implemented")
+}
+func (*UnimplementedQueryServer) UserUnbonding(ctx context.Context, req *QueryUserUnbondingRequest) (*QueryUserUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUnbonding not implemented")
+}
+func (*UnimplementedQueryServer) HostValidatorSnapshot(ctx context.Context, req *QueryHostValidatorSnapshotRequest) (*QueryHostValidatorSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostValidatorSnapshot not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RedeemRateHistory(ctx context.Context, req *QueryRedeemRateHistoryRequest) (*QueryRedeemRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) SourceChainStatus(ctx context.Context, req *QuerySourceChainStatusRequest) (*QuerySourceChainStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SourceChainStatus not implemented")
+}
+func (*UnimplementedQueryServer) UserHistory(ctx context.Context, req *QueryUserHistoryRequest) (*QueryUserHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySourceChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChain(ctx, in)
 	}
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/SourceChain",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChain(ctx, req.(*QuerySourceChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryUserUnbondingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProxyDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/ProxyDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyDelegation(ctx, req.(*QueryProxyDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochProxyUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochProxyUnbondingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochProxyUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/EpochProxyUnbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochProxyUnbonding(ctx, req.(*QueryEpochProxyUnbondingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserUnbondingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/UserUnbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserUnbonding(ctx, req.(*QueryUserUnbondingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostValidatorSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostValidatorSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostValidatorSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/HostValidatorSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostValidatorSnapshot(ctx, req.(*QueryHostValidatorSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedeemRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedeemRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedeemRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/RedeemRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedeemRateHistory(ctx, req.(*QueryRedeemRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SourceChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySourceChainStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SourceChainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/SourceChainStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SourceChainStatus(ctx, req.(*QuerySourceChainStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Query/UserHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserHistory(ctx, req.(*QueryUserHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celinium.liquidstake.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SourceChain",
+			Handler:    _Query_SourceChain_Handler,
+		},
+		{
+			MethodName: "ProxyDelegation",
+			Handler:    _Query_ProxyDelegation_Handler,
+		},
+		{
+			MethodName: "EpochProxyUnbonding",
+			Handler:    _Query_EpochProxyUnbonding_Handler,
+		},
+		{
+			MethodName: "UserUnbonding",
+			Handler:    _Query_UserUnbonding_Handler,
+		},
+		{
+			MethodName: "HostValidatorSnapshot",
+			Handler:    _Query_HostValidatorSnapshot_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RedeemRateHistory",
+			Handler:    _Query_RedeemRateHistory_Handler,
+		},
+		{
+			MethodName: "SourceChainStatus",
+			Handler:    _Query_SourceChainStatus_Handler,
+		},
+		{
+			MethodName: "UserHistory",
+			Handler:    _Query_UserHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celinium/liquidstake/v1/query.proto",
+}
+
+func (m *QuerySourceChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UserUnbondings) > 0 {
-		for _, e := range m.UserUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SourceChain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryProxyDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryProxyDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochProxyUnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochProxyUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochProxyUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochProxyUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochProxyUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochProxyUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChainUnbonding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserUnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserUnbondings) > 0 {
+		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostValidatorSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostValidatorSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostValidatorSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostValidatorSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostValidatorSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostValidatorSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedeemRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedeemRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedeemRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedeemRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedeemRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedeemRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceChainStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySourceChainStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySourceChainStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySourceChainStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySourceChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceChain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProxyDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochProxyUnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochProxyUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainUnbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUserUnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserUnbondings) > 0 {
+		for _, e := range m.UserUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHostValidatorSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostValidatorSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedeemRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedeemRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySourceChainStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySourceChainStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryUserHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySourceChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySourceChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySourceChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochProxyUnbondingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochProxyUnbondingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochProxyUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochProxyUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochProxyUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochProxyUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainUnbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainUnbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserUnbondingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserUnbondingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserUnbondings = append(m.UserUnbondings, UserUnbonding{})
+			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostValidatorSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostValidatorSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostValidatorSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySourceChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostValidatorSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,17 +3096,17 @@ func (m *QuerySourceChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySourceChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostValidatorSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySourceChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostValidatorSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1093,23 +3116,24 @@ func (m *QuerySourceChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1132,7 +3156,7 @@ func (m *QuerySourceChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySourceChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1155,15 +3179,65 @@ func (m *QuerySourceChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySourceChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1190,7 +3264,7 @@ func (m *QuerySourceChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1215,7 +3289,7 @@ func (m *QuerySourceChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProxyDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedeemRateHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1238,17 +3312,17 @@ func (m *QueryProxyDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProxyDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedeemRateHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProxyDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedeemRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			m.Epoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1258,16 +3332,29 @@ func (m *QueryProxyDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1277,23 +3364,27 @@ func (m *QueryProxyDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1316,7 +3407,7 @@ func (m *QueryProxyDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProxyDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedeemRateHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,15 +3430,15 @@ func (m *QueryProxyDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProxyDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedeemRateHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProxyDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedeemRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1374,7 +3465,44 @@ func (m *QueryProxyDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, RedeemRateRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1399,7 +3527,7 @@ func (m *QueryProxyDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochProxyUnbondingRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySourceChainStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1422,32 +3550,13 @@ func (m *QueryEpochProxyUnbondingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochProxyUnbondingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySourceChainStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochProxyUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySourceChainStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
@@ -1500,7 +3609,7 @@ func (m *QueryEpochProxyUnbondingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochProxyUnbondingResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySourceChainStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,17 +3632,17 @@ func (m *QueryEpochProxyUnbondingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochProxyUnbondingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySourceChainStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochProxyUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySourceChainStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainUnbonding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1543,25 +3652,44 @@ func (m *QueryEpochProxyUnbondingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainUnbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1583,7 +3711,7 @@ func (m *QueryEpochProxyUnbondingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserUnbondingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUserHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1606,15 +3734,15 @@ func (m *QueryUserUnbondingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserUnbondingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1642,13 +3770,13 @@ func (m *QueryUserUnbondingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1658,23 +3786,27 @@ func (m *QueryUserUnbondingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1697,7 +3829,7 @@ func (m *QueryUserUnbondingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserUnbondingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUserHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1720,15 +3852,15 @@ func (m *QueryUserUnbondingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserUnbondingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1755,8 +3887,44 @@ func (m *QueryUserUnbondingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserUnbondings = append(m.UserUnbondings, UserUnbonding{})
-			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UserHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
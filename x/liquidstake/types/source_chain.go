@@ -98,33 +98,145 @@ type ValidatorFund struct {
 
 func (s SourceChain) AllocateTokenForValidator(amount math.Int) Validators {
 	var allocatedTokenValidators Validators
+
+	// validators without weight are not allocated any token
+	validators := s.weightedValidators()
+
 	totalWeight := math.ZeroInt()
-	for _, v := range s.Validators {
+	for _, v := range validators {
 		totalWeight = totalWeight.Add(math.NewIntFromUint64(v.Weight))
 	}
 
-	valiLen := len(s.Validators)
+	// all the validators have zero weight, the amount is split evenly.
+	evenly := totalWeight.IsZero()
+	if evenly {
+		totalWeight = math.NewInt(int64(len(validators)))
+	}
+	weightOf := func(v Validator) math.Int {
+		if evenly {
+			return math.OneInt()
+		}
+		return math.NewIntFromUint64(v.Weight)
+	}
+
+	valiLen := len(validators)
 	reminding := amount
 	for i := 0; i < valiLen-1; i++ {
-		allocateAmt := amount.Mul(math.NewIntFromUint64(s.Validators[i].Weight)).Quo(totalWeight)
+		allocateAmt := amount.Mul(weightOf(validators[i])).Quo(totalWeight)
 		allocatedTokenValidators.Validators = append(allocatedTokenValidators.Validators, Validator{
-			Address:     s.Validators[i].Address,
+			Address:     validators[i].Address,
 			TokenAmount: allocateAmt,
-			Weight:      s.Validators[i].Weight,
+			Weight:      validators[i].Weight,
 		})
 		reminding = reminding.Sub(allocateAmt)
 	}
 
 	// the last validator get all reminding amount
 	allocatedTokenValidators.Validators = append(allocatedTokenValidators.Validators, Validator{
-		Address:     s.Validators[valiLen-1].Address,
+		Address:     validators[valiLen-1].Address,
 		TokenAmount: reminding,
-		Weight:      s.Validators[valiLen-1].Weight,
+		Weight:      validators[valiLen-1].Weight,
 	})
 
 	return allocatedTokenValidators
 }
 
+// AllocateUnbondingForValidator allocate the undelegation amount for validators. If all the staked
+// token is undelegated, each validator undelegate all its delegated token instead of by weight.
+// Otherwise the validators without weight are drained first, then the left amount is allocated by
+// weight, and no validator undelegates more than its delegated token.
+func (s SourceChain) AllocateUnbondingForValidator(amount math.Int) Validators {
+	var allocatedTokenValidators Validators
+	if amount.GTE(s.StakedAmount) {
+		for _, v := range s.Validators {
+			if v.TokenAmount.IsNil() || !v.TokenAmount.IsPositive() {
+				continue
+			}
+			allocatedTokenValidators.Validators = append(allocatedTokenValidators.Validators, v)
+		}
+
+		return allocatedTokenValidators
+	}
+
+	allocated := make([]math.Int, len(s.Validators))
+	available := func(i int) math.Int {
+		tokenAmount := s.Validators[i].TokenAmount
+		if tokenAmount.IsNil() || !tokenAmount.IsPositive() {
+			return math.ZeroInt()
+		}
+		return tokenAmount.Sub(allocated[i])
+	}
+	for i := range allocated {
+		allocated[i] = math.ZeroInt()
+	}
+
+	reminding := amount
+	for i, v := range s.Validators {
+		if v.Weight != 0 || !reminding.IsPositive() {
+			continue
+		}
+		allocated[i] = math.MinInt(available(i), reminding)
+		reminding = reminding.Sub(allocated[i])
+	}
+
+	// the share which exceeds the delegated token of validator is allocated to the others in the next round.
+	for reminding.IsPositive() {
+		totalWeight := math.ZeroInt()
+		for i, v := range s.Validators {
+			if v.Weight != 0 && available(i).IsPositive() {
+				totalWeight = totalWeight.Add(math.NewIntFromUint64(v.Weight))
+			}
+		}
+		if totalWeight.IsZero() {
+			break
+		}
+
+		roundAmount := reminding
+		for i, v := range s.Validators {
+			if v.Weight == 0 || !available(i).IsPositive() || !reminding.IsPositive() {
+				continue
+			}
+
+			share := roundAmount.Mul(math.NewIntFromUint64(v.Weight)).Quo(totalWeight)
+			if share.IsZero() {
+				// the rounded share is allocated to the validators in order.
+				share = math.OneInt()
+			}
+			share = math.MinInt(math.MinInt(share, available(i)), reminding)
+			allocated[i] = allocated[i].Add(share)
+			reminding = reminding.Sub(share)
+		}
+	}
+
+	for i, v := range s.Validators {
+		if !allocated[i].IsPositive() {
+			continue
+		}
+		allocatedTokenValidators.Validators = append(allocatedTokenValidators.Validators, Validator{
+			Address:     v.Address,
+			TokenAmount: allocated[i],
+			Weight:      v.Weight,
+		})
+	}
+
+	return allocatedTokenValidators
+}
+
+func (s SourceChain) weightedValidators() []Validator {
+	var vals []Validator
+	for _, v := range s.Validators {
+		if v.Weight != 0 {
+			vals = append(vals, v)
+		}
+	}
+
+	if len(vals) == 0 {
+		return s.Validators
+	}
+
+	return vals
+}
+
 func (s *SourceChain) UpdateWithDelegatedValidators(vals []Validator) {
 	allocValmap := make(map[string]math.Int)
 	totalAmt := math.ZeroInt()
@@ -31,6 +31,9 @@ type Validator struct {
 	TokenAmount Int `protobuf:"bytes,2,opt,name=tokenAmount,proto3,customtype=Int" json:"tokenAmount"`
 	// The weight used for distribute delegation funds.
 	Weight uint64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// The validator has been slashed on source chain, the slashed weight factor of the
+	// selection policy is applied to its weight.
+	Slashed bool `protobuf:"varint,4,opt,name=slashed,proto3" json:"slashed,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -80,6 +83,13 @@ func (m *Validator) GetWeight() uint64 {
 	return 0
 }
 
+func (m *Validator) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
+	}
+	return false
+}
+
 type SourceChain struct {
 	// The chain id of source chain.
 	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
@@ -106,6 +116,23 @@ type SourceChain struct {
 	DerivativeDenom string `protobuf:"bytes,12,opt,name=derivativeDenom,proto3" json:"derivativeDenom,omitempty"`
 	// The amount of staked token.
 	StakedAmount Int `protobuf:"bytes,13,opt,name=stakedAmount,proto3,customtype=Int" json:"stakedAmount"`
+	// The policy used to compute the weight of validators at each delegation epoch.
+	SelectionPolicy ValidatorSelectionPolicy `protobuf:"bytes,14,opt,name=selectionPolicy,proto3" json:"selectionPolicy"`
+	// Delegate and undelegate are halted because the redeem rate changed out of bounds.
+	// It can only be resumed by governance.
+	Halted bool `protobuf:"varint,15,opt,name=halted,proto3" json:"halted,omitempty"`
+	// The status of source chain which is set by governance.
+	// 1) Active: all operations are allowed.
+	// 2) PausedDeposits: new delegation is not allowed.
+	// 3) PausedAll: all user operations and epoch processing are paused.
+	// 4) Deregistering: the source chain is winding down, only undelegate and claim are allowed.
+	Status SourceChainStatus `protobuf:"varint,16,opt,name=status,proto3,customtype=SourceChainStatus" json:"status"`
+	// The wind down progress of a deregistering source chain. It's nil until all the
+	// remaining stake is undelegated from the source chain.
+	WindDown *WindDown `protobuf:"bytes,17,opt,name=windDown,proto3" json:"windDown,omitempty"`
+	// The source chain height at which the `tokenAmount` of validators was changed last time.
+	// The host validator snapshot at or below it is rejected, since it doesn't reflect the change.
+	TokenAmountHostHeight uint64 `protobuf:"varint,18,opt,name=tokenAmountHostHeight,proto3" json:"tokenAmountHostHeight,omitempty"`
 }
 
 func (m *SourceChain) Reset()         { *m = SourceChain{} }
@@ -218,6 +245,105 @@ func (m *SourceChain) GetDerivativeDenom() string {
 	return ""
 }
 
+func (m *SourceChain) GetSelectionPolicy() ValidatorSelectionPolicy {
+	if m != nil {
+		return m.SelectionPolicy
+	}
+	return ValidatorSelectionPolicy{}
+}
+
+func (m *SourceChain) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *SourceChain) GetWindDown() *WindDown {
+	if m != nil {
+		return m.WindDown
+	}
+	return nil
+}
+
+func (m *SourceChain) GetTokenAmountHostHeight() uint64 {
+	if m != nil {
+		return m.TokenAmountHostHeight
+	}
+	return 0
+}
+
+// WindDown records the undelegation of all the remaining stake of a deregistering source chain.
+type WindDown struct {
+	// The undelegation epoch in which the remaining stake is undelegated.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The amount of native token undelegated for the derivative token holders.
+	Amount Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=Int" json:"amount"`
+	// The undelegated token has been withdrawn from the source chain, the derivative token
+	// can be redeemed at the final redeem rate.
+	Complete bool `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	// The staking rewards withdrawn with the undelegation, they are withdrawn together with the
+	// undelegated token.
+	RewardAmount Int `protobuf:"bytes,4,opt,name=rewardAmount,proto3,customtype=Int" json:"rewardAmount"`
+	// The withdrawal of the undelegated token is acknowledged, the wind down is completed
+	// after the token is received.
+	Withdrawn bool `protobuf:"varint,5,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+}
+
+func (m *WindDown) Reset()         { *m = WindDown{} }
+func (m *WindDown) String() string { return proto.CompactTextString(m) }
+func (*WindDown) ProtoMessage()    {}
+func (*WindDown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9717b2e9147633e9, []int{2}
+}
+func (m *WindDown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindDown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindDown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindDown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindDown.Merge(m, src)
+}
+func (m *WindDown) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindDown) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindDown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindDown proto.InternalMessageInfo
+
+func (m *WindDown) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *WindDown) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *WindDown) GetWithdrawn() bool {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return false
+}
+
 type Validators struct {
 	Validators []Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
 }
@@ -226,7 +352,7 @@ func (m *Validators) Reset()         { *m = Validators{} }
 func (m *Validators) String() string { return proto.CompactTextString(m) }
 func (*Validators) ProtoMessage()    {}
 func (*Validators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9717b2e9147633e9, []int{2}
+	return fileDescriptor_9717b2e9147633e9, []int{3}
 }
 func (m *Validators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,10 +388,353 @@ func (m *Validators) GetValidators() []Validator {
 	return nil
 }
 
+// ValidatorSelectionPolicy define how the weight of source chain validators are computed
+// from the host chain validator snapshot.
+type ValidatorSelectionPolicy struct {
+	// The strategy used to compute weights.
+	// 1) Manual: weights are set manually and never changed by the policy.
+	// 2) Equal: all eligible validators get the same weight.
+	// 3) InverseVotingPower: weight is inversely proportional to the voting power.
+	// 4) CommissionCapped: weight is proportional to `1 - commission`.
+	Strategy ValidatorSelectionStrategy `protobuf:"varint,1,opt,name=strategy,proto3,customtype=ValidatorSelectionStrategy" json:"strategy"`
+	// Validators with a higher commission rate are not eligible. Ignored if zero.
+	MaxCommission Dec `protobuf:"bytes,2,opt,name=maxCommission,proto3,customtype=Dec" json:"maxCommission"`
+	// Validators with a lower uptime are not eligible. Ignored if zero.
+	MinUptime Dec `protobuf:"bytes,3,opt,name=minUptime,proto3,customtype=Dec" json:"minUptime"`
+	// Validators with a higher fraction of the total voting power are not eligible. Ignored if zero.
+	MaxVotingPowerRatio Dec `protobuf:"bytes,4,opt,name=maxVotingPowerRatio,proto3,customtype=Dec" json:"maxVotingPowerRatio"`
+	// The accounts which are allowed to submit the host validator snapshot.
+	SnapshotSubmitters []string `protobuf:"bytes,5,rep,name=snapshotSubmitters,proto3" json:"snapshotSubmitters,omitempty"`
+	// The factor multiplied to the weight of a validator when it's slashed on the source chain.
+	// Zero means the validator will not be allocated any delegation. Ignored if empty.
+	SlashedWeightFactor Dec `protobuf:"bytes,6,opt,name=slashedWeightFactor,proto3,customtype=Dec" json:"slashedWeightFactor"`
+}
+
+func (m *ValidatorSelectionPolicy) Reset()         { *m = ValidatorSelectionPolicy{} }
+func (m *ValidatorSelectionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSelectionPolicy) ProtoMessage()    {}
+func (*ValidatorSelectionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9717b2e9147633e9, []int{4}
+}
+func (m *ValidatorSelectionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSelectionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSelectionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSelectionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSelectionPolicy.Merge(m, src)
+}
+func (m *ValidatorSelectionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSelectionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSelectionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSelectionPolicy proto.InternalMessageInfo
+
+func (m *ValidatorSelectionPolicy) GetSnapshotSubmitters() []string {
+	if m != nil {
+		return m.SnapshotSubmitters
+	}
+	return nil
+}
+
+// HostValidator is the state of a validator on the source chain.
+type HostValidator struct {
+	// The address of source chain validator account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The commission rate of the validator.
+	Commission Dec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=Dec" json:"commission"`
+	// The fraction of blocks signed by the validator in the signing window.
+	Uptime Dec `protobuf:"bytes,3,opt,name=uptime,proto3,customtype=Dec" json:"uptime"`
+	// The voting power(bonded tokens) of the validator.
+	VotingPower Int  `protobuf:"bytes,4,opt,name=votingPower,proto3,customtype=Int" json:"votingPower"`
+	Jailed      bool `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// The amount of token delegated to the validator by the delegate interchain account.
+	// It is used to detect the slashing of validator on source chain.
+	DelegationAmount Int `protobuf:"bytes,6,opt,name=delegationAmount,proto3,customtype=Int" json:"delegationAmount"`
+}
+
+func (m *HostValidator) Reset()         { *m = HostValidator{} }
+func (m *HostValidator) String() string { return proto.CompactTextString(m) }
+func (*HostValidator) ProtoMessage()    {}
+func (*HostValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9717b2e9147633e9, []int{5}
+}
+func (m *HostValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostValidator.Merge(m, src)
+}
+func (m *HostValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostValidator proto.InternalMessageInfo
+
+func (m *HostValidator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HostValidator) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+// HostDelegationProof proves the delegation of the delegate interchain account to a validator
+// by the merkle proofs of the source chain staking store at the height of the snapshot.
+type HostDelegationProof struct {
+	// The address of source chain validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// The staking store value of the delegation.
+	Delegation []byte `protobuf:"bytes,2,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	// The proto encoded merkle proof of the delegation.
+	DelegationProof []byte `protobuf:"bytes,3,opt,name=delegationProof,proto3" json:"delegationProof,omitempty"`
+	// The staking store value of the validator, which converts the delegation shares into tokens.
+	ValidatorInfo []byte `protobuf:"bytes,4,opt,name=validatorInfo,proto3" json:"validatorInfo,omitempty"`
+	// The proto encoded merkle proof of the validator.
+	ValidatorProof []byte `protobuf:"bytes,5,opt,name=validatorProof,proto3" json:"validatorProof,omitempty"`
+}
+
+func (m *HostDelegationProof) Reset()         { *m = HostDelegationProof{} }
+func (m *HostDelegationProof) String() string { return proto.CompactTextString(m) }
+func (*HostDelegationProof) ProtoMessage()    {}
+func (*HostDelegationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9717b2e9147633e9, []int{6}
+}
+func (m *HostDelegationProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostDelegationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostDelegationProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostDelegationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostDelegationProof.Merge(m, src)
+}
+func (m *HostDelegationProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostDelegationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostDelegationProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostDelegationProof proto.InternalMessageInfo
+
+func (m *HostDelegationProof) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *HostDelegationProof) GetDelegation() []byte {
+	if m != nil {
+		return m.Delegation
+	}
+	return nil
+}
+
+func (m *HostDelegationProof) GetDelegationProof() []byte {
+	if m != nil {
+		return m.DelegationProof
+	}
+	return nil
+}
+
+func (m *HostDelegationProof) GetValidatorInfo() []byte {
+	if m != nil {
+		return m.ValidatorInfo
+	}
+	return nil
+}
+
+func (m *HostDelegationProof) GetValidatorProof() []byte {
+	if m != nil {
+		return m.ValidatorProof
+	}
+	return nil
+}
+
+// HostValidatorSnapshot is the snapshot of the source chain validators at a specific height.
+type HostValidatorSnapshot struct {
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// The height of source chain when the snapshot is taken.
+	Height     uint64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Validators []HostValidator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *HostValidatorSnapshot) Reset()         { *m = HostValidatorSnapshot{} }
+func (m *HostValidatorSnapshot) String() string { return proto.CompactTextString(m) }
+func (*HostValidatorSnapshot) ProtoMessage()    {}
+func (*HostValidatorSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9717b2e9147633e9, []int{7}
+}
+func (m *HostValidatorSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostValidatorSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostValidatorSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostValidatorSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostValidatorSnapshot.Merge(m, src)
+}
+func (m *HostValidatorSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostValidatorSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostValidatorSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostValidatorSnapshot proto.InternalMessageInfo
+
+func (m *HostValidatorSnapshot) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *HostValidatorSnapshot) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HostValidatorSnapshot) GetValidators() []HostValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// RedeemRateRecord is the redeem rate of source chain in a delegation epoch.
+type RedeemRateRecord struct {
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// The delegation epoch number.
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The redeem rate at the epoch.
+	Redemptionratio Dec `protobuf:"bytes,3,opt,name=redemptionratio,proto3,customtype=Dec" json:"redemptionratio"`
+	// The block height when the redeem rate was updated.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RedeemRateRecord) Reset()         { *m = RedeemRateRecord{} }
+func (m *RedeemRateRecord) String() string { return proto.CompactTextString(m) }
+func (*RedeemRateRecord) ProtoMessage()    {}
+func (*RedeemRateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9717b2e9147633e9, []int{8}
+}
+func (m *RedeemRateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedeemRateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedeemRateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedeemRateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemRateRecord.Merge(m, src)
+}
+func (m *RedeemRateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedeemRateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemRateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemRateRecord proto.InternalMessageInfo
+
+func (m *RedeemRateRecord) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *RedeemRateRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RedeemRateRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Validator)(nil), "celinium.liquidstake.v1.Validator")
 	proto.RegisterType((*SourceChain)(nil), "celinium.liquidstake.v1.SourceChain")
+	proto.RegisterType((*WindDown)(nil), "celinium.liquidstake.v1.WindDown")
 	proto.RegisterType((*Validators)(nil), "celinium.liquidstake.v1.Validators")
+	proto.RegisterType((*ValidatorSelectionPolicy)(nil), "celinium.liquidstake.v1.ValidatorSelectionPolicy")
+	proto.RegisterType((*HostValidator)(nil), "celinium.liquidstake.v1.HostValidator")
+	proto.RegisterType((*HostDelegationProof)(nil), "celinium.liquidstake.v1.HostDelegationProof")
+	proto.RegisterType((*HostValidatorSnapshot)(nil), "celinium.liquidstake.v1.HostValidatorSnapshot")
+	proto.RegisterType((*RedeemRateRecord)(nil), "celinium.liquidstake.v1.RedeemRateRecord")
 }
 
 func init() {
@@ -273,41 +742,74 @@ func init() {
 }
 
 var fileDescriptor_9717b2e9147633e9 = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbd, 0x6e, 0x1a, 0x41,
-	0x10, 0xe6, 0x62, 0x0c, 0x66, 0xc0, 0xb2, 0xb2, 0xb2, 0x92, 0x03, 0x45, 0x07, 0xa2, 0x42, 0x51,
-	0x0c, 0x32, 0x96, 0x52, 0xe4, 0x4f, 0x32, 0x50, 0x84, 0x2e, 0x3a, 0x4b, 0x14, 0x69, 0xac, 0x65,
-	0x6f, 0x0c, 0x2b, 0xc3, 0x2e, 0xd9, 0x5b, 0xc0, 0x79, 0x8b, 0xd4, 0xa9, 0xf2, 0x10, 0xce, 0x3b,
-	0xb8, 0xb4, 0x5c, 0x45, 0x29, 0xac, 0x08, 0x9a, 0x3c, 0x46, 0x74, 0x7b, 0x70, 0xb9, 0x23, 0xb2,
-	0x90, 0xd2, 0x31, 0xf3, 0x7d, 0xdf, 0x30, 0x33, 0xdf, 0xdc, 0xc2, 0x73, 0x86, 0x23, 0x2e, 0xf8,
-	0x74, 0xdc, 0x18, 0xf1, 0x4f, 0x53, 0xee, 0xf9, 0x9a, 0x5e, 0x62, 0x63, 0x76, 0xdc, 0xf0, 0xe5,
-	0x54, 0x31, 0x3c, 0x67, 0x43, 0xca, 0x45, 0x7d, 0xa2, 0xa4, 0x96, 0xe4, 0xe9, 0x9a, 0x5b, 0x8f,
-	0x71, 0xeb, 0xb3, 0xe3, 0xd2, 0xe1, 0x40, 0x0e, 0xa4, 0xe1, 0x34, 0x82, 0x5f, 0x21, 0xbd, 0x54,
-	0x64, 0xd2, 0x1f, 0x4b, 0xff, 0x3c, 0x04, 0xc2, 0x20, 0x84, 0xaa, 0x5f, 0x2d, 0xc8, 0xf5, 0xe8,
-	0x88, 0x7b, 0x54, 0x4b, 0x45, 0x9a, 0x90, 0xa5, 0x9e, 0xa7, 0xd0, 0xf7, 0x6d, 0xab, 0x62, 0xd5,
-	0x72, 0x2d, 0xfb, 0xee, 0xfa, 0xe8, 0x70, 0x25, 0x38, 0x0d, 0x91, 0x33, 0xad, 0xb8, 0x18, 0xb8,
-	0x6b, 0x22, 0x79, 0x0d, 0x79, 0x2d, 0x2f, 0x51, 0x9c, 0x8e, 0xe5, 0x54, 0x68, 0xfb, 0x91, 0xd1,
-	0x15, 0x6f, 0xee, 0xcb, 0xa9, 0x9f, 0xf7, 0xe5, 0x9d, 0xae, 0xd0, 0x77, 0xd7, 0x47, 0xb0, 0x2a,
-	0xd1, 0x15, 0xda, 0x8d, 0xb3, 0xc9, 0x13, 0xc8, 0xcc, 0x91, 0x0f, 0x86, 0xda, 0xde, 0xa9, 0x58,
-	0xb5, 0xb4, 0xbb, 0x8a, 0x5e, 0xa5, 0x7f, 0x7f, 0x2b, 0x5b, 0xd5, 0xef, 0xbb, 0x90, 0x3f, 0x33,
-	0xd3, 0xb7, 0x83, 0xe1, 0x89, 0x0d, 0x59, 0xb3, 0x85, 0x6e, 0x27, 0x6c, 0xcf, 0x5d, 0x87, 0xa4,
-	0x0a, 0x05, 0x26, 0x85, 0x40, 0xa6, 0xb9, 0x0c, 0x60, 0xd3, 0x85, 0x9b, 0xc8, 0x91, 0x17, 0xf0,
-	0x58, 0x2b, 0x2a, 0xfc, 0x0b, 0x54, 0xed, 0x21, 0x15, 0x02, 0x47, 0xdd, 0x8e, 0xf9, 0xdb, 0x9c,
-	0xfb, 0x2f, 0x40, 0xde, 0x40, 0xb1, 0x8f, 0x6c, 0x78, 0xd2, 0x8c, 0xb6, 0x13, 0xcc, 0xff, 0x41,
-	0xe1, 0x05, 0xbf, 0xb2, 0xd3, 0x46, 0xf5, 0x30, 0x81, 0xbc, 0x07, 0x98, 0xad, 0xd3, 0xbe, 0xbd,
-	0x5b, 0xd9, 0xa9, 0xe5, 0x9b, 0xd5, 0xfa, 0x03, 0xae, 0xd5, 0xa3, 0x0a, 0xad, 0x74, 0xb0, 0x37,
-	0x37, 0xa6, 0x25, 0x2d, 0x38, 0x98, 0x73, 0x3d, 0xf4, 0x14, 0x9d, 0xaf, 0x0c, 0xb0, 0x33, 0x5b,
-	0xac, 0xd9, 0x14, 0x90, 0x77, 0xb0, 0x8f, 0xcc, 0x57, 0x32, 0xaa, 0x90, 0xdd, 0x52, 0x21, 0x49,
-	0x0f, 0x7a, 0xf0, 0x70, 0x84, 0x03, 0xaa, 0x71, 0x5d, 0x61, 0x6f, 0x5b, 0x0f, 0x1b, 0x02, 0xd2,
-	0x86, 0x03, 0x85, 0x1e, 0x8e, 0x27, 0x81, 0x1b, 0x8a, 0x6a, 0x2e, 0xed, 0x5c, 0xf2, 0x54, 0x3a,
-	0xc8, 0x62, 0xa7, 0xd2, 0x41, 0xe6, 0x6e, 0x2a, 0x48, 0x09, 0xf6, 0x78, 0x9f, 0x75, 0x50, 0xc8,
-	0xb1, 0x0d, 0xc6, 0x83, 0x28, 0x26, 0x15, 0xc8, 0x0b, 0xaa, 0xf9, 0x0c, 0x43, 0x38, 0x6f, 0xe0,
-	0x78, 0x8a, 0xd4, 0x82, 0x31, 0x14, 0x9f, 0xc5, 0x58, 0x05, 0xc3, 0xda, 0x4c, 0x93, 0xb7, 0x50,
-	0x30, 0xe6, 0x78, 0xab, 0xa3, 0xde, 0xdf, 0x76, 0xd4, 0x09, 0x7a, 0xb5, 0x07, 0xd0, 0xfb, 0xeb,
-	0x60, 0xf2, 0x16, 0xac, 0xff, 0xbf, 0x85, 0xd6, 0xcb, 0x9b, 0x85, 0x63, 0xdd, 0x2e, 0x1c, 0xeb,
-	0xd7, 0xc2, 0xb1, 0xbe, 0x2c, 0x9d, 0xd4, 0xed, 0xd2, 0x49, 0xfd, 0x58, 0x3a, 0xa9, 0x8f, 0xcf,
-	0xa2, 0xc7, 0xe3, 0x2a, 0xf1, 0x7c, 0xe8, 0xcf, 0x13, 0xf4, 0xfb, 0x19, 0xf3, 0xad, 0x9f, 0xfc,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x13, 0xf3, 0xd6, 0x4f, 0x63, 0x04, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xcf, 0xc5, 0x8e, 0x63, 0x8f, 0xed, 0xa6, 0xdd, 0xa6, 0x70, 0x89, 0x2a, 0xc7, 0x58, 0xa8,
+	0xb2, 0x10, 0x75, 0x94, 0x14, 0x81, 0xf8, 0xd3, 0xa2, 0x26, 0x06, 0x25, 0x82, 0x87, 0x68, 0x2d,
+	0x52, 0x89, 0x97, 0x6a, 0x73, 0xb7, 0xb1, 0x97, 0xde, 0xed, 0x9a, 0xbb, 0xb5, 0x9d, 0x7c, 0x0b,
+	0xde, 0x78, 0x03, 0x3e, 0x44, 0x3e, 0x44, 0x25, 0x78, 0xa8, 0xca, 0x4b, 0xc5, 0x43, 0x85, 0x92,
+	0x17, 0x3e, 0x06, 0xda, 0xbd, 0xbd, 0xf3, 0x9d, 0xe3, 0xe4, 0x5a, 0x78, 0xbb, 0x99, 0xf9, 0xcd,
+	0xdc, 0xec, 0xcc, 0xfc, 0x66, 0x17, 0x3e, 0x70, 0xa8, 0xc7, 0x38, 0x1b, 0xf9, 0x9b, 0x1e, 0xfb,
+	0x71, 0xc4, 0xdc, 0x50, 0x92, 0x67, 0x74, 0x73, 0xbc, 0xb5, 0x19, 0x8a, 0x51, 0xe0, 0xd0, 0xa7,
+	0xce, 0x80, 0x30, 0xde, 0x19, 0x06, 0x42, 0x0a, 0xf4, 0x6e, 0x8c, 0xed, 0xa4, 0xb0, 0x9d, 0xf1,
+	0xd6, 0xfa, 0x6a, 0x5f, 0xf4, 0x85, 0xc6, 0x6c, 0xaa, 0xaf, 0x08, 0xbe, 0xbe, 0xe6, 0x88, 0xd0,
+	0x17, 0xe1, 0xd3, 0xc8, 0x10, 0x09, 0x91, 0xa9, 0x75, 0x66, 0x41, 0xe5, 0x90, 0x78, 0xcc, 0x25,
+	0x52, 0x04, 0x68, 0x1b, 0x96, 0x89, 0xeb, 0x06, 0x34, 0x0c, 0x6d, 0xab, 0x69, 0xb5, 0x2b, 0x3b,
+	0xf6, 0xcb, 0xb3, 0xfb, 0xab, 0xc6, 0xe1, 0x71, 0x64, 0xe9, 0xc9, 0x80, 0xf1, 0x3e, 0x8e, 0x81,
+	0xe8, 0x73, 0xa8, 0x4a, 0xf1, 0x8c, 0xf2, 0xc7, 0xbe, 0x18, 0x71, 0x69, 0x2f, 0x6a, 0xbf, 0xb5,
+	0xe7, 0xaf, 0x37, 0x16, 0xfe, 0x7a, 0xbd, 0x51, 0xd8, 0xe7, 0xf2, 0xe5, 0xd9, 0x7d, 0x30, 0x21,
+	0xf6, 0xb9, 0xc4, 0x69, 0x34, 0x7a, 0x07, 0x4a, 0x13, 0xca, 0xfa, 0x03, 0x69, 0x17, 0x9a, 0x56,
+	0xbb, 0x88, 0x8d, 0x84, 0x6c, 0x58, 0x0e, 0x3d, 0x12, 0x0e, 0xa8, 0x6b, 0x17, 0x9b, 0x56, 0xbb,
+	0x8c, 0x63, 0xf1, 0xb3, 0xe2, 0x3f, 0xbf, 0x6d, 0x58, 0xad, 0x57, 0xcb, 0x50, 0xed, 0xe9, 0xba,
+	0xec, 0xaa, 0xb2, 0x28, 0xbc, 0xae, 0xcf, 0x7e, 0x37, 0x4a, 0x1c, 0xc7, 0x22, 0x6a, 0x41, 0xcd,
+	0x11, 0x9c, 0x53, 0x47, 0x32, 0xa1, 0xcc, 0x3a, 0x3f, 0x9c, 0xd1, 0xa1, 0x0f, 0xe1, 0x96, 0x0c,
+	0x08, 0x0f, 0x8f, 0x69, 0xb0, 0x3b, 0x20, 0x9c, 0x53, 0x6f, 0xbf, 0xab, 0x13, 0xaa, 0xe0, 0xcb,
+	0x06, 0xf4, 0x05, 0xac, 0x1d, 0x51, 0x67, 0xf0, 0x60, 0x3b, 0xa9, 0x9b, 0xaa, 0xcc, 0x41, 0x40,
+	0x8f, 0xd9, 0x89, 0xce, 0xb6, 0x82, 0xaf, 0x06, 0xa0, 0x3d, 0x80, 0x71, 0xac, 0x0e, 0xed, 0xa5,
+	0x66, 0xa1, 0x5d, 0xdd, 0x6e, 0x75, 0xae, 0xe8, 0x67, 0x27, 0x89, 0xb0, 0x53, 0x54, 0x15, 0xc5,
+	0x29, 0x5f, 0xb4, 0x03, 0x2b, 0x13, 0x26, 0x07, 0x6e, 0x40, 0x26, 0xa6, 0x35, 0x76, 0x29, 0xa7,
+	0x69, 0xb3, 0x0e, 0xe8, 0x11, 0xd4, 0xa9, 0x13, 0x06, 0x22, 0x89, 0xb0, 0x9c, 0x13, 0x21, 0x0b,
+	0x57, 0x39, 0xb8, 0xd4, 0xa3, 0x7d, 0x22, 0x69, 0x1c, 0xa1, 0x9c, 0x97, 0xc3, 0x8c, 0x03, 0xda,
+	0x85, 0x95, 0x80, 0xba, 0xd4, 0x1f, 0xaa, 0x6e, 0x04, 0x44, 0x32, 0x61, 0x57, 0xb2, 0x43, 0xd4,
+	0xa5, 0x4e, 0x6a, 0x88, 0xba, 0xd4, 0xc1, 0xb3, 0x1e, 0x68, 0x1d, 0xca, 0xec, 0xc8, 0xe9, 0x52,
+	0x2e, 0x7c, 0x1b, 0x74, 0x0f, 0x12, 0x19, 0x35, 0xa1, 0xca, 0x89, 0x64, 0x63, 0x1a, 0x99, 0xab,
+	0xda, 0x9c, 0x56, 0xa1, 0xb6, 0x3a, 0x46, 0xc0, 0xc6, 0x29, 0x54, 0x4d, 0xa3, 0x66, 0xd5, 0xe8,
+	0x21, 0xd4, 0x74, 0x73, 0x5c, 0x33, 0xee, 0xf5, 0xbc, 0x71, 0xcf, 0xc0, 0x11, 0x81, 0x95, 0x90,
+	0x7a, 0xd1, 0xe0, 0x1d, 0x08, 0x8f, 0x39, 0xa7, 0xf6, 0x8d, 0xa6, 0xd5, 0xae, 0x6e, 0x6f, 0xe5,
+	0x8f, 0x40, 0x2f, 0xeb, 0x68, 0x26, 0x62, 0x36, 0x9e, 0xa2, 0xd4, 0x80, 0x78, 0x92, 0xba, 0xf6,
+	0x8a, 0x66, 0x8e, 0x91, 0xd0, 0x16, 0x94, 0x42, 0x49, 0xe4, 0x28, 0xb4, 0x6f, 0x36, 0xad, 0x76,
+	0x3d, 0xc9, 0xf9, 0x56, 0x8a, 0x47, 0x3d, 0x0d, 0xc0, 0x06, 0x88, 0x1e, 0x42, 0x79, 0xc2, 0xb8,
+	0xdb, 0x15, 0x13, 0x6e, 0xdf, 0xd2, 0x69, 0xbe, 0x77, 0x65, 0x9a, 0x4f, 0x0c, 0x10, 0x27, 0x2e,
+	0xe8, 0x23, 0xb8, 0x93, 0xe2, 0xfa, 0x9e, 0x08, 0xe5, 0x5e, 0xc4, 0x75, 0xa4, 0xb9, 0x3e, 0xdf,
+	0xd8, 0xfa, 0xc3, 0x82, 0x72, 0x1c, 0x0c, 0xad, 0xc2, 0x12, 0x1d, 0x0a, 0x67, 0xa0, 0x59, 0x5d,
+	0xc4, 0x91, 0xa0, 0x8e, 0x42, 0xde, 0x70, 0xdb, 0x18, 0xa0, 0x9a, 0x0f, 0x47, 0xf8, 0x43, 0x8f,
+	0x4a, 0xaa, 0x99, 0x5d, 0xc6, 0x89, 0xac, 0x7a, 0x1a, 0xd0, 0x09, 0x09, 0xe2, 0x9e, 0x16, 0x73,
+	0x7b, 0x9a, 0x86, 0xa3, 0xbb, 0x50, 0x89, 0x69, 0xc5, 0xed, 0x25, 0x1d, 0x7b, 0xaa, 0x68, 0x1d,
+	0x02, 0x1c, 0x4e, 0x39, 0x9b, 0x65, 0xbf, 0xf5, 0xdf, 0xd9, 0xdf, 0xfa, 0xa5, 0x00, 0xf6, 0x55,
+	0xa3, 0x81, 0x1e, 0x41, 0x39, 0x94, 0x01, 0x91, 0xb4, 0x7f, 0xaa, 0x2b, 0x57, 0xdf, 0x69, 0x99,
+	0xd3, 0xac, 0x5f, 0xf6, 0xe9, 0x19, 0x24, 0x4e, 0x7c, 0xd0, 0x97, 0x50, 0xf7, 0xc9, 0xc9, 0xae,
+	0xf0, 0x7d, 0x16, 0x86, 0x4c, 0x70, 0x7b, 0x31, 0x8f, 0x90, 0x59, 0x3c, 0xfa, 0x04, 0x2a, 0x3e,
+	0xe3, 0xdf, 0x0d, 0x25, 0xf3, 0xa3, 0x7a, 0x5f, 0xeb, 0x3c, 0xc5, 0xa2, 0x6f, 0xe0, 0xb6, 0x4f,
+	0x4e, 0x0e, 0x85, 0x64, 0xbc, 0x7f, 0x20, 0x26, 0x34, 0xc0, 0x7a, 0x21, 0x14, 0xf3, 0x42, 0xcc,
+	0xf3, 0x42, 0x1d, 0x40, 0x21, 0x27, 0xc3, 0x70, 0x20, 0x64, 0x6f, 0x74, 0xe4, 0x33, 0x29, 0xa9,
+	0xd9, 0xb9, 0x15, 0x3c, 0xc7, 0xa2, 0x7e, 0x6e, 0xae, 0x99, 0x27, 0x7a, 0x16, 0xbf, 0x26, 0x8e,
+	0x14, 0x81, 0x5d, 0xca, 0xfd, 0xf9, 0x1c, 0xaf, 0xd6, 0x9f, 0x8b, 0x50, 0x57, 0x63, 0xfd, 0xff,
+	0x6e, 0xd7, 0x4f, 0x01, 0x9c, 0xb7, 0x68, 0x43, 0x0a, 0xac, 0x58, 0x32, 0x7a, 0xc3, 0x06, 0x18,
+	0xa0, 0xba, 0xcb, 0xc7, 0xd3, 0x22, 0xe6, 0x13, 0x21, 0x8d, 0x56, 0x8b, 0xe7, 0x07, 0xc2, 0x3c,
+	0xea, 0x1a, 0x12, 0x18, 0x09, 0x7d, 0x05, 0x37, 0xcd, 0xca, 0x67, 0x22, 0x7e, 0x25, 0x94, 0xf2,
+	0x22, 0x5f, 0x72, 0x31, 0x17, 0xff, 0xef, 0x16, 0xdc, 0x56, 0x55, 0xed, 0x26, 0xe6, 0x83, 0x40,
+	0x88, 0x63, 0x45, 0xc2, 0x84, 0x1c, 0xe6, 0x09, 0x30, 0x55, 0xa0, 0x06, 0xc0, 0x34, 0x9e, 0xae,
+	0x62, 0x0d, 0xa7, 0x34, 0xd1, 0xfe, 0xcf, 0x04, 0xd4, 0x35, 0xab, 0xe1, 0x59, 0x35, 0x7a, 0x1f,
+	0xea, 0x49, 0xd8, 0x7d, 0x7e, 0x1c, 0x4d, 0x66, 0x0d, 0x67, 0x95, 0xe8, 0x1e, 0xdc, 0x48, 0x14,
+	0x51, 0xb8, 0x25, 0x0d, 0x9b, 0xd1, 0xb6, 0x7e, 0xb6, 0xe0, 0x4e, 0x66, 0x46, 0x7a, 0x66, 0x28,
+	0xaf, 0x79, 0xd0, 0xa8, 0xfd, 0x1e, 0xad, 0xd1, 0xc5, 0xe8, 0xc9, 0x14, 0x49, 0xe8, 0xdb, 0xcc,
+	0x6a, 0x29, 0xe8, 0xd5, 0x72, 0xef, 0xca, 0xd5, 0x92, 0xf9, 0xeb, 0x9c, 0xf5, 0xf2, 0xab, 0x05,
+	0x37, 0x31, 0x75, 0x29, 0xf5, 0x31, 0x91, 0x14, 0x53, 0x47, 0x04, 0xee, 0x35, 0x49, 0x25, 0x7b,
+	0x7a, 0x31, 0xbd, 0xa7, 0xe7, 0xdc, 0xec, 0x85, 0xb7, 0xbe, 0xd9, 0xa7, 0xe7, 0x55, 0xa5, 0x2e,
+	0xc4, 0xe7, 0xdd, 0xf9, 0xf8, 0xf9, 0x79, 0xc3, 0x7a, 0x71, 0xde, 0xb0, 0xfe, 0x3e, 0x6f, 0x58,
+	0x3f, 0x5d, 0x34, 0x16, 0x5e, 0x5c, 0x34, 0x16, 0x5e, 0x5d, 0x34, 0x16, 0xbe, 0xbf, 0x9b, 0xbc,
+	0xa4, 0x4f, 0x32, 0x6f, 0x69, 0x79, 0x3a, 0xa4, 0xe1, 0x51, 0x49, 0x3f, 0x7c, 0x1f, 0xfc, 0x3b,
+	0x00, 0xc0, 0x05, 0xfe, 0xe8, 0x70, 0x0b, 0x00, 0x00,
 }
 
 func (this *Validator) Equal(that interface{}) bool {
@@ -338,20 +840,62 @@ func (this *Validator) Equal(that interface{}) bool {
 	if this.Weight != that1.Weight {
 		return false
 	}
+	if this.Slashed != that1.Slashed {
+		return false
+	}
 	return true
 }
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *HostValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	that1, ok := that.(*HostValidator)
+	if !ok {
+		that2, ok := that.(HostValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Commission.Equal(that1.Commission) {
+		return false
+	}
+	if !this.Uptime.Equal(that1.Uptime) {
+		return false
+	}
+	if !this.VotingPower.Equal(that1.VotingPower) {
+		return false
+	}
+	if this.Jailed != that1.Jailed {
+		return false
+	}
+	if !this.DelegationAmount.Equal(that1.DelegationAmount) {
+		return false
+	}
+	return true
+}
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -360,6 +904,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Weight != 0 {
 		i = encodeVarintSourceChain(dAtA, i, uint64(m.Weight))
 		i--
@@ -405,6 +959,54 @@ func (m *SourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TokenAmountHostHeight != 0 {
+		i = encodeVarintSourceChain(dAtA, i, uint64(m.TokenAmountHostHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.WindDown != nil {
+		{
+			size, err := m.WindDown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSourceChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Status != 0 {
+		i = encodeVarintSourceChain(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.SelectionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	{
 		size := m.StakedAmount.Size()
 		i -= size
@@ -512,6 +1114,74 @@ func (m *SourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WindDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Withdrawn {
+		i--
+		if m.Withdrawn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintSourceChain(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Validators) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -549,116 +1219,1607 @@ func (m *Validators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSourceChain(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSourceChain(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorSelectionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorSelectionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSelectionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
+	{
+		size := m.SlashedWeightFactor.Size()
+		i -= size
+		if _, err := m.SlashedWeightFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
 	}
-	l = m.TokenAmount.Size()
-	n += 1 + l + sovSourceChain(uint64(l))
-	if m.Weight != 0 {
-		n += 1 + sovSourceChain(uint64(m.Weight))
+	i--
+	dAtA[i] = 0x32
+	if len(m.SnapshotSubmitters) > 0 {
+		for iNdEx := len(m.SnapshotSubmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SnapshotSubmitters[iNdEx])
+			copy(dAtA[i:], m.SnapshotSubmitters[iNdEx])
+			i = encodeVarintSourceChain(dAtA, i, uint64(len(m.SnapshotSubmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
+	{
+		size := m.MaxVotingPowerRatio.Size()
+		i -= size
+		if _, err := m.MaxVotingPowerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinUptime.Size()
+		i -= size
+		if _, err := m.MinUptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Strategy != 0 {
+		i = encodeVarintSourceChain(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SourceChain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HostValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HostValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
+	{
+		size := m.DelegationAmount.Size()
+		i -= size
+		if _, err := m.DelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
 	}
-	l = len(m.ConnectionID)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.TransferChannelID)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
 	}
-	l = len(m.Bech32ValidatorAddrPrefix)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovSourceChain(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostDelegationProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostDelegationProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostDelegationProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorProof) > 0 {
+		i -= len(m.ValidatorProof)
+		copy(dAtA[i:], m.ValidatorProof)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.ValidatorProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorInfo) > 0 {
+		i -= len(m.ValidatorInfo)
+		copy(dAtA[i:], m.ValidatorInfo)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.ValidatorInfo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegationProof) > 0 {
+		i -= len(m.DelegationProof)
+		copy(dAtA[i:], m.DelegationProof)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.DelegationProof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegation) > 0 {
+		i -= len(m.Delegation)
+		copy(dAtA[i:], m.Delegation)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.Delegation)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostValidatorSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostValidatorSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostValidatorSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSourceChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintSourceChain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedeemRateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedeemRateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedeemRateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSourceChain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Redemptionratio.Size()
+		i -= size
+		if _, err := m.Redemptionratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSourceChain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintSourceChain(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintSourceChain(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSourceChain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSourceChain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = m.TokenAmount.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	if m.Weight != 0 {
+		n += 1 + sovSourceChain(uint64(m.Weight))
+	}
+	if m.Slashed {
+		n += 2
+	}
+	return n
+}
+
+func (m *SourceChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.ConnectionID)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.TransferChannelID)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.Bech32ValidatorAddrPrefix)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSourceChain(uint64(l))
+		}
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.EcsrowAddress)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.DelegateAddress)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = m.Redemptionratio.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.DerivativeDenom)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	l = m.SelectionPolicy.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 2 + sovSourceChain(uint64(m.Status))
+	}
+	if m.WindDown != nil {
+		l = m.WindDown.Size()
+		n += 2 + l + sovSourceChain(uint64(l))
+	}
+	if m.TokenAmountHostHeight != 0 {
+		n += 2 + sovSourceChain(uint64(m.TokenAmountHostHeight))
+	}
+	return n
+}
+
+func (m *WindDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovSourceChain(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	if m.Complete {
+		n += 2
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	if m.Withdrawn {
+		n += 2
+	}
+	return n
+}
+
+func (m *Validators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSourceChain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorSelectionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovSourceChain(uint64(m.Strategy))
+	}
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	l = m.MinUptime.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	l = m.MaxVotingPowerRatio.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	if len(m.SnapshotSubmitters) > 0 {
+		for _, s := range m.SnapshotSubmitters {
+			l = len(s)
+			n += 1 + l + sovSourceChain(uint64(l))
+		}
+	}
+	l = m.SlashedWeightFactor.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	return n
+}
+
+func (m *HostValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	l = m.Uptime.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	l = m.VotingPower.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	return n
+}
+
+func (m *HostDelegationProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.Delegation)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.DelegationProof)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.ValidatorInfo)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	l = len(m.ValidatorProof)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	return n
+}
+
+func (m *HostValidatorSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSourceChain(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSourceChain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedeemRateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovSourceChain(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSourceChain(uint64(m.Epoch))
+	}
+	l = m.Redemptionratio.Size()
+	n += 1 + l + sovSourceChain(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSourceChain(uint64(m.Height))
+	}
+	return n
+}
+
+func sovSourceChain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSourceChain(x uint64) (n int) {
+	return sovSourceChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSourceChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Slashed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSourceChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSourceChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32ValidatorAddrPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32ValidatorAddrPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcsrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EcsrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptionratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redemptionratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SelectionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SourceChainStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindDown == nil {
+				m.WindDown = &WindDown{}
+			}
+			if err := m.WindDown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAmountHostHeight", wireType)
+			}
+			m.TokenAmountHostHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenAmountHostHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSourceChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSourceChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdrawn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSourceChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSourceChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSourceChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
-	}
-	l = len(m.EcsrowAddress)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
-	}
-	l = len(m.DelegateAddress)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
-	}
-	l = m.Redemptionratio.Size()
-	n += 1 + l + sovSourceChain(uint64(l))
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
-	}
-	l = len(m.NativeDenom)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
-	}
-	l = len(m.DerivativeDenom)
-	if l > 0 {
-		n += 1 + l + sovSourceChain(uint64(l))
-	}
-	l = m.StakedAmount.Size()
-	n += 1 + l + sovSourceChain(uint64(l))
-	return n
-}
 
-func (m *Validators) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovSourceChain(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSourceChain(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSourceChain(x uint64) (n int) {
-	return sovSourceChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSelectionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -681,15 +2842,34 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSelectionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSelectionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= ValidatorSelectionStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -717,11 +2897,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -749,15 +2931,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinUptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerRatio", wireType)
 			}
-			m.Weight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -767,11 +2949,92 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVotingPowerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotSubmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotSubmitters = append(m.SnapshotSubmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedWeightFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedWeightFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSourceChain(dAtA[iNdEx:])
@@ -793,7 +3056,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceChain) Unmarshal(dAtA []byte) error {
+func (m *HostValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -816,15 +3079,15 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -852,11 +3115,11 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -884,11 +3147,13 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -916,11 +3181,13 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferChannelID = string(dAtA[iNdEx:postIndex])
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32ValidatorAddrPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -948,13 +3215,35 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32ValidatorAddrPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -964,29 +3253,79 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSourceChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostDelegationProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSourceChain
 			}
-			iNdEx = postIndex
-		case 6:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostDelegationProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostDelegationProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1014,13 +3353,13 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcsrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -1030,29 +3369,31 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSourceChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EcsrowAddress = string(dAtA[iNdEx:postIndex])
+			m.Delegation = append(m.Delegation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegation == nil {
+				m.Delegation = []byte{}
+			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -1062,29 +3403,31 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSourceChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegationProof = append(m.DelegationProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegationProof == nil {
+				m.DelegationProof = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redemptionratio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorInfo", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -1094,31 +3437,31 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSourceChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redemptionratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorInfo = append(m.ValidatorInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorInfo == nil {
+				m.ValidatorInfo = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorProof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -1128,27 +3471,79 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSourceChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorProof = append(m.ValidatorProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorProof == nil {
+				m.ValidatorProof = []byte{}
+			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSourceChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostValidatorSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSourceChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostValidatorSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostValidatorSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1176,13 +3571,13 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeDenom", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -1192,29 +3587,16 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSourceChain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSourceChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DerivativeDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -1224,23 +3606,23 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSourceChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, HostValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1265,7 +3647,7 @@ func (m *SourceChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validators) Unmarshal(dAtA []byte) error {
+func (m *RedeemRateRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1288,17 +3670,17 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedeemRateRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedeemRateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSourceChain
@@ -1308,26 +3690,96 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSourceChain
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSourceChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptionratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSourceChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redemptionratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSourceChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSourceChain(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/celinium-network/celinium/x/liquidstake/types"
 )
@@ -18,6 +19,7 @@ func TestSourceChainAllocateFunds(t *testing.T) {
 					"validator1",
 					sdk.ZeroInt(),
 					rand.Uint64()%100000 + types.MinValidatorWeight, //nolint:gosec
+					false,
 				},
 			},
 		},
@@ -27,11 +29,13 @@ func TestSourceChainAllocateFunds(t *testing.T) {
 					"validator1",
 					sdk.ZeroInt(),
 					rand.Uint64()%100000 + types.MinValidatorWeight, //nolint:gosec
+					false,
 				},
 				{
 					"validator2",
 					sdk.ZeroInt(),
 					rand.Uint64()%100000 + types.MinValidatorWeight, //nolint:gosec
+					false,
 				},
 			},
 		},
@@ -41,16 +45,19 @@ func TestSourceChainAllocateFunds(t *testing.T) {
 					"validator1",
 					sdk.ZeroInt(),
 					rand.Uint64()%100000 + types.MinValidatorWeight, //nolint:gosec
+					false,
 				},
 				{
 					"validator2",
 					sdk.ZeroInt(),
 					rand.Uint64()%100000 + types.MinValidatorWeight, //nolint:gosec
+					false,
 				},
 				{
 					"validator3",
 					sdk.ZeroInt(),
 					rand.Uint64()%100000 + types.MinValidatorWeight, //nolint:gosec
+					false,
 				},
 			},
 		},
@@ -76,3 +83,44 @@ func TestSourceChainAllocateFunds(t *testing.T) {
 		checkAlloc(&srcChains[i], totalFunds, allocFunds)
 	}
 }
+
+func TestAllocateTokenForZeroWeightValidators(t *testing.T) {
+	srcChain := types.SourceChain{
+		Validators: []types.Validator{
+			{Address: "validator1", TokenAmount: math.ZeroInt()},
+			{Address: "validator2", TokenAmount: math.ZeroInt()},
+		},
+	}
+
+	allocated := srcChain.AllocateTokenForValidator(math.NewInt(101))
+	require.Len(t, allocated.Validators, 2)
+	require.Equal(t, math.NewInt(50), allocated.Validators[0].TokenAmount)
+	require.Equal(t, math.NewInt(51), allocated.Validators[1].TokenAmount)
+}
+
+func TestAllocateUnbondingForValidator(t *testing.T) {
+	// validator1 is removed from the selection but still holds stake, validator3 is newly selected.
+	srcChain := types.SourceChain{
+		StakedAmount: math.NewInt(1000),
+		Validators: []types.Validator{
+			{Address: "validator1", TokenAmount: math.NewInt(300), Weight: 0},
+			{Address: "validator2", TokenAmount: math.NewInt(700), Weight: 5000},
+			{Address: "validator3", TokenAmount: math.ZeroInt(), Weight: 5000},
+		},
+	}
+
+	allocated := srcChain.AllocateUnbondingForValidator(math.NewInt(200))
+	require.Equal(t, []types.Validator{
+		{Address: "validator1", TokenAmount: math.NewInt(200), Weight: 0},
+	}, allocated.Validators)
+
+	// the share of validator3 is undelegated from validator2.
+	allocated = srcChain.AllocateUnbondingForValidator(math.NewInt(900))
+	require.Equal(t, []types.Validator{
+		{Address: "validator1", TokenAmount: math.NewInt(300), Weight: 0},
+		{Address: "validator2", TokenAmount: math.NewInt(600), Weight: 5000},
+	}, allocated.Validators)
+
+	allocated = srcChain.AllocateUnbondingForValidator(math.NewInt(1000))
+	require.Len(t, allocated.Validators, 2)
+}
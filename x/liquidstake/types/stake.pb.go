@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -344,6 +348,259 @@ func (m *EpochProxyUnbonding) GetUnbondings() []ProxyUnbonding {
 	return nil
 }
 
+// Identifies the proxy unbonding of a source chain in an epoch.
+type EpochChainPair struct {
+	Epoch   uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+}
+
+func (m *EpochChainPair) Reset()         { *m = EpochChainPair{} }
+func (m *EpochChainPair) String() string { return proto.CompactTextString(m) }
+func (*EpochChainPair) ProtoMessage()    {}
+func (*EpochChainPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9beff2e65f7b246b, []int{4}
+}
+func (m *EpochChainPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochChainPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochChainPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochChainPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochChainPair.Merge(m, src)
+}
+func (m *EpochChainPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochChainPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochChainPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochChainPair proto.InternalMessageInfo
+
+func (m *EpochChainPair) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochChainPair) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+// Represents a time slice of the proxy unbonding completion queue.
+type EpochChainPairs struct {
+	Pairs []EpochChainPair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs"`
+}
+
+func (m *EpochChainPairs) Reset()         { *m = EpochChainPairs{} }
+func (m *EpochChainPairs) String() string { return proto.CompactTextString(m) }
+func (*EpochChainPairs) ProtoMessage()    {}
+func (*EpochChainPairs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9beff2e65f7b246b, []int{5}
+}
+func (m *EpochChainPairs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochChainPairs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochChainPairs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochChainPairs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochChainPairs.Merge(m, src)
+}
+func (m *EpochChainPairs) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochChainPairs) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochChainPairs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochChainPairs proto.InternalMessageInfo
+
+func (m *EpochChainPairs) GetPairs() []EpochChainPair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+// Records the progress of the epoch processing which continues in the subsequent blocks.
+type ProcessingCursor struct {
+	// The epoch which started the processing.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The store key from which the processing continues.
+	NextKey []byte `protobuf:"bytes,2,opt,name=nextKey,proto3" json:"nextKey,omitempty"`
+	// The block height at which the last batch was processed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ProcessingCursor) Reset()         { *m = ProcessingCursor{} }
+func (m *ProcessingCursor) String() string { return proto.CompactTextString(m) }
+func (*ProcessingCursor) ProtoMessage()    {}
+func (*ProcessingCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9beff2e65f7b246b, []int{6}
+}
+func (m *ProcessingCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProcessingCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProcessingCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProcessingCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessingCursor.Merge(m, src)
+}
+func (m *ProcessingCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProcessingCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessingCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessingCursor proto.InternalMessageInfo
+
+func (m *ProcessingCursor) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProcessingCursor) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func (m *ProcessingCursor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Represents a delegation, undelegation or claim of a delegator. It's kept after
+// the records of the source chain which it relates to are pruned.
+type UserHistoryEntry struct {
+	// The action of the delegator: delegate, undelegate or claim.
+	Action  UserHistoryAction `protobuf:"varint,1,opt,name=action,proto3,customtype=UserHistoryAction" json:"action"`
+	ChainID string            `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// The number of delegation epoch for delegate, the number of undelegation epoch for the others.
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The token which the delegator paid, the redeemed token for claim.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// The token which the delegator received or will receive.
+	Received types.Coin `protobuf:"bytes,5,opt,name=received,proto3" json:"received"`
+	Height   int64      `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time  `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *UserHistoryEntry) Reset()         { *m = UserHistoryEntry{} }
+func (m *UserHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*UserHistoryEntry) ProtoMessage()    {}
+func (*UserHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9beff2e65f7b246b, []int{7}
+}
+func (m *UserHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserHistoryEntry.Merge(m, src)
+}
+func (m *UserHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserHistoryEntry proto.InternalMessageInfo
+
+func (m *UserHistoryEntry) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *UserHistoryEntry) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *UserHistoryEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *UserHistoryEntry) GetReceived() types.Coin {
+	if m != nil {
+		return m.Received
+	}
+	return types.Coin{}
+}
+
+func (m *UserHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *UserHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 type IBCQuery struct {
 	QueryType    string `protobuf:"bytes,1,opt,name=queryType,proto3" json:"queryType,omitempty"`
 	QueryPathKey string `protobuf:"bytes,2,opt,name=queryPathKey,proto3" json:"queryPathKey,omitempty"`
@@ -357,7 +614,7 @@ func (m *IBCQuery) Reset()         { *m = IBCQuery{} }
 func (m *IBCQuery) String() string { return proto.CompactTextString(m) }
 func (*IBCQuery) ProtoMessage()    {}
 func (*IBCQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9beff2e65f7b246b, []int{4}
+	return fileDescriptor_9beff2e65f7b246b, []int{8}
 }
 func (m *IBCQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,6 +690,10 @@ func init() {
 	proto.RegisterType((*UserUnbonding)(nil), "celinium.liquidstake.v1.UserUnbonding")
 	proto.RegisterType((*ProxyUnbonding)(nil), "celinium.liquidstake.v1.ProxyUnbonding")
 	proto.RegisterType((*EpochProxyUnbonding)(nil), "celinium.liquidstake.v1.EpochProxyUnbonding")
+	proto.RegisterType((*EpochChainPair)(nil), "celinium.liquidstake.v1.EpochChainPair")
+	proto.RegisterType((*EpochChainPairs)(nil), "celinium.liquidstake.v1.EpochChainPairs")
+	proto.RegisterType((*ProcessingCursor)(nil), "celinium.liquidstake.v1.ProcessingCursor")
+	proto.RegisterType((*UserHistoryEntry)(nil), "celinium.liquidstake.v1.UserHistoryEntry")
 	proto.RegisterType((*IBCQuery)(nil), "celinium.liquidstake.v1.IBCQuery")
 }
 
@@ -441,50 +702,62 @@ func init() {
 }
 
 var fileDescriptor_9beff2e65f7b246b = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x4e, 0xdb, 0x4c,
-	0x10, 0x8e, 0x9d, 0x10, 0xc8, 0x06, 0xf8, 0xff, 0x2e, 0xb4, 0x35, 0x94, 0x1a, 0xcb, 0x3d, 0x34,
-	0xaa, 0x54, 0x47, 0x40, 0xdb, 0x5b, 0x55, 0x11, 0xd2, 0x83, 0x55, 0x81, 0xc0, 0x85, 0x4b, 0x2f,
-	0x95, 0x63, 0x8f, 0xc2, 0x8a, 0x78, 0x37, 0xd8, 0xeb, 0x88, 0xf4, 0x0d, 0x2a, 0xf5, 0xd0, 0x87,
-	0xe9, 0xa9, 0x4f, 0xc0, 0xa9, 0x42, 0x3d, 0x55, 0x3d, 0xa0, 0x0a, 0x5e, 0xa4, 0xda, 0xf5, 0x26,
-	0xb1, 0x83, 0x50, 0xb9, 0x79, 0x66, 0xbf, 0xd9, 0x99, 0xef, 0x9b, 0xcf, 0x8b, 0x9e, 0x04, 0xd0,
-	0x23, 0x94, 0xa4, 0x51, 0xb3, 0x47, 0x4e, 0x53, 0x12, 0x26, 0xdc, 0x3f, 0x81, 0xe6, 0x60, 0xa3,
-	0x29, 0x3f, 0x9c, 0x7e, 0xcc, 0x38, 0xc3, 0x0f, 0x47, 0x20, 0x27, 0x07, 0x72, 0x06, 0x1b, 0xab,
-	0xcb, 0x5d, 0xd6, 0x65, 0x12, 0xd3, 0x14, 0x5f, 0x19, 0x7c, 0x75, 0x25, 0x60, 0x49, 0xc4, 0x92,
-	0x8f, 0xd9, 0x41, 0x16, 0xa8, 0x23, 0x33, 0x8b, 0x9a, 0x1d, 0x3f, 0x11, 0x5d, 0x3a, 0xc0, 0xfd,
-	0x8d, 0x66, 0xc0, 0x08, 0xcd, 0xce, 0xed, 0x2f, 0x3a, 0xfa, 0x6f, 0x3f, 0x66, 0x67, 0xc3, 0x36,
-	0xf4, 0xa0, 0xeb, 0x73, 0xc2, 0x28, 0x5e, 0x44, 0x3a, 0x09, 0x0d, 0xcd, 0xd2, 0x1a, 0x15, 0x4f,
-	0x27, 0x21, 0xde, 0x42, 0x15, 0x51, 0x61, 0xe8, 0x96, 0xd6, 0xa8, 0x6f, 0xae, 0x38, 0xaa, 0x81,
-	0xb8, 0xd2, 0x51, 0x57, 0x3a, 0x3b, 0x8c, 0xd0, 0x56, 0xe5, 0xfc, 0x72, 0xbd, 0xe4, 0x49, 0x30,
-	0x7e, 0x89, 0xaa, 0x09, 0xf7, 0x79, 0x9a, 0x18, 0x65, 0x4b, 0x6b, 0x2c, 0xb4, 0x1e, 0x8b, 0xb3,
-	0xdf, 0x97, 0xeb, 0xf7, 0xa7, 0xba, 0xbd, 0x97, 0x20, 0x4f, 0x81, 0xb1, 0x85, 0xea, 0xd0, 0x67,
-	0xc1, 0xf1, 0x5e, 0x1a, 0x75, 0x20, 0x36, 0x2a, 0x72, 0x88, 0x7c, 0x0a, 0x1b, 0x68, 0x36, 0x38,
-	0xf6, 0x09, 0x75, 0xdb, 0xc6, 0x8c, 0xa5, 0x35, 0x6a, 0xde, 0x28, 0xc4, 0xdb, 0x68, 0x31, 0x06,
-	0x42, 0x07, 0x90, 0xf0, 0xed, 0x88, 0xa5, 0x94, 0x1b, 0x55, 0x01, 0x68, 0xad, 0xa8, 0xd6, 0x65,
-	0x97, 0xf2, 0x9f, 0xdf, 0x9e, 0x23, 0x35, 0xbf, 0x4b, 0xb9, 0x37, 0x55, 0x60, 0x7f, 0xd6, 0xd1,
-	0xc2, 0x51, 0x02, 0xf1, 0x11, 0xed, 0x30, 0x1a, 0x12, 0xda, 0x15, 0x62, 0xb8, 0x6d, 0x29, 0x46,
-	0xcd, 0xd3, 0xdd, 0x76, 0xbe, 0xbd, 0x5e, 0x6c, 0xbf, 0x8c, 0x66, 0xe4, 0x9c, 0x92, 0x70, 0xc5,
-	0xcb, 0x02, 0xbc, 0x86, 0x6a, 0x61, 0x46, 0x96, 0x65, 0x74, 0x6a, 0xde, 0x24, 0x81, 0x57, 0xd1,
-	0x5c, 0x0c, 0x01, 0x90, 0x01, 0xc4, 0x8a, 0xcd, 0x38, 0xc6, 0x6f, 0x10, 0x8a, 0x21, 0x04, 0x88,
-	0x84, 0xb6, 0x92, 0xca, 0x1d, 0xc4, 0xcf, 0x95, 0xe0, 0xd7, 0xa8, 0x1e, 0xf4, 0x88, 0x1f, 0x65,
-	0x12, 0x1b, 0xb3, 0x72, 0x0f, 0x8f, 0x94, 0x18, 0x4b, 0x05, 0x9a, 0x6a, 0x0b, 0x79, 0xbc, 0xfd,
-	0x43, 0x47, 0x8b, 0x72, 0x59, 0x13, 0x31, 0x72, 0xe4, 0xb5, 0x22, 0xf9, 0x03, 0xf4, 0xa0, 0x93,
-	0xc6, 0x14, 0xc2, 0x36, 0xc4, 0x64, 0xe0, 0x73, 0x32, 0x00, 0xb5, 0x03, 0xfd, 0x5f, 0x3b, 0xb8,
-	0xa5, 0x10, 0xef, 0xa2, 0x7b, 0x19, 0x99, 0x3d, 0x99, 0x3d, 0x64, 0x27, 0x40, 0xa5, 0xb6, 0x77,
-	0x90, 0xe1, 0x66, 0x25, 0x36, 0x11, 0x4a, 0x25, 0x91, 0x43, 0x12, 0x81, 0x32, 0x56, 0x2e, 0x83,
-	0x5f, 0x8c, 0x0d, 0x3b, 0x23, 0x85, 0x5a, 0x53, 0x13, 0x2f, 0x17, 0x35, 0x98, 0xf2, 0xeb, 0x33,
-	0xf4, 0x7f, 0x41, 0x48, 0x37, 0x4c, 0x8c, 0xaa, 0x55, 0x6e, 0xd4, 0xbc, 0x1b, 0x79, 0xfb, 0x13,
-	0x5a, 0x7a, 0x2b, 0x3c, 0x31, 0x25, 0xea, 0xd8, 0x37, 0x5a, 0xde, 0x37, 0xbb, 0xa3, 0x71, 0x09,
-	0xed, 0x26, 0x86, 0x6e, 0x95, 0x1b, 0xf5, 0xcd, 0xa7, 0xce, 0x2d, 0xef, 0x82, 0x53, 0xbc, 0x72,
-	0xe4, 0x85, 0xc9, 0x05, 0xf6, 0x77, 0x0d, 0xcd, 0xb9, 0xad, 0x9d, 0x83, 0x14, 0xe2, 0xa1, 0xf0,
-	0xe4, 0xa9, 0xf8, 0x38, 0x1c, 0xf6, 0x41, 0x2d, 0x72, 0x92, 0xc0, 0x36, 0x9a, 0x97, 0xc1, 0xbe,
-	0xcf, 0x8f, 0xdf, 0xc1, 0x50, 0xd9, 0xbc, 0x90, 0x13, 0x46, 0xe0, 0x24, 0x02, 0x96, 0x72, 0xe5,
-	0xf6, 0x51, 0x98, 0xb7, 0x48, 0xa5, 0x68, 0x11, 0x1b, 0xcd, 0x07, 0x8c, 0x52, 0x08, 0xc4, 0x6f,
-	0x3f, 0xfe, 0x7b, 0x0b, 0xb9, 0x89, 0x16, 0xd5, 0x9c, 0x16, 0xad, 0x57, 0xe7, 0x57, 0xa6, 0x76,
-	0x71, 0x65, 0x6a, 0x7f, 0xae, 0x4c, 0xed, 0xeb, 0xb5, 0x59, 0xba, 0xb8, 0x36, 0x4b, 0xbf, 0xae,
-	0xcd, 0xd2, 0x87, 0xb5, 0xf1, 0x6b, 0x7a, 0x56, 0x78, 0x4f, 0xf9, 0xb0, 0x0f, 0x49, 0xa7, 0x2a,
-	0xdf, 0xb8, 0xad, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xea, 0x26, 0xcf, 0xf5, 0x74, 0x05, 0x00,
-	0x00,
+	// 867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xae, 0xed, 0x6d, 0xfc, 0x9c, 0xa4, 0xed, 0x34, 0x94, 0x4d, 0x08, 0x8e, 0xb5, 0x1c,
+	0xb0, 0x90, 0x58, 0x2b, 0x29, 0xff, 0x24, 0x84, 0x20, 0xb6, 0x2b, 0x61, 0xa1, 0x56, 0xe9, 0x92,
+	0x72, 0xe8, 0x05, 0xad, 0x77, 0x87, 0xf5, 0xa8, 0xde, 0x19, 0x77, 0x66, 0xd6, 0x8a, 0xf9, 0x06,
+	0x48, 0x1c, 0xfa, 0x61, 0x38, 0x21, 0x71, 0xef, 0x09, 0x55, 0x9c, 0x10, 0x87, 0x82, 0x92, 0x2f,
+	0x82, 0x66, 0x76, 0xd6, 0xde, 0x4d, 0x15, 0x6a, 0x6e, 0xf3, 0xde, 0xfc, 0xde, 0xbf, 0xdf, 0x7b,
+	0xf3, 0x06, 0xde, 0x8b, 0xf0, 0x94, 0x50, 0x92, 0xa5, 0xbd, 0x29, 0x79, 0x96, 0x91, 0x58, 0xc8,
+	0xf0, 0x29, 0xee, 0xcd, 0x8f, 0x7a, 0xfa, 0xe0, 0xcf, 0x38, 0x93, 0x0c, 0xbd, 0x5d, 0x80, 0xfc,
+	0x12, 0xc8, 0x9f, 0x1f, 0xed, 0xef, 0x26, 0x2c, 0x61, 0x1a, 0xd3, 0x53, 0xa7, 0x1c, 0xbe, 0xbf,
+	0x17, 0x31, 0x91, 0x32, 0xf1, 0x7d, 0x7e, 0x91, 0x0b, 0xe6, 0xaa, 0x9d, 0x4b, 0xbd, 0x71, 0x28,
+	0x54, 0x94, 0x31, 0x96, 0xe1, 0x51, 0x2f, 0x62, 0x84, 0x9a, 0xfb, 0xc3, 0x84, 0xb1, 0x64, 0x8a,
+	0x7b, 0x5a, 0x1a, 0x67, 0x3f, 0xf4, 0x24, 0x49, 0xb1, 0x90, 0x61, 0x3a, 0xcb, 0x01, 0xde, 0xcf,
+	0x36, 0xdc, 0x3c, 0xe5, 0xec, 0x7c, 0x31, 0xc4, 0x53, 0x9c, 0x84, 0x92, 0x30, 0x8a, 0x76, 0xc0,
+	0x26, 0xb1, 0x6b, 0x75, 0xac, 0x6e, 0x3d, 0xb0, 0x49, 0x8c, 0xee, 0x41, 0x5d, 0xb9, 0x74, 0xed,
+	0x8e, 0xd5, 0x6d, 0x1d, 0xef, 0xf9, 0x26, 0x03, 0x15, 0xd3, 0x37, 0x31, 0xfd, 0x01, 0x23, 0xb4,
+	0x5f, 0x7f, 0xf1, 0xea, 0x70, 0x23, 0xd0, 0x60, 0xf4, 0x31, 0x38, 0x42, 0x86, 0x32, 0x13, 0x6e,
+	0xad, 0x63, 0x75, 0xb7, 0xfb, 0xef, 0xaa, 0xbb, 0xbf, 0x5e, 0x1d, 0xbe, 0x75, 0x25, 0xda, 0xb7,
+	0x1a, 0x14, 0x18, 0x30, 0xea, 0x40, 0x0b, 0xcf, 0x58, 0x34, 0x79, 0x98, 0xa5, 0x63, 0xcc, 0xdd,
+	0xba, 0x4e, 0xa2, 0xac, 0x42, 0x2e, 0xdc, 0x88, 0x26, 0x21, 0xa1, 0xa3, 0xa1, 0xdb, 0xe8, 0x58,
+	0xdd, 0x66, 0x50, 0x88, 0xe8, 0x04, 0x76, 0x38, 0x26, 0x74, 0x8e, 0x85, 0x3c, 0x49, 0x59, 0x46,
+	0xa5, 0xeb, 0x28, 0x40, 0x7f, 0xcf, 0x84, 0xae, 0x8d, 0xa8, 0xfc, 0xe3, 0x97, 0x0f, 0xc1, 0xe4,
+	0x3f, 0xa2, 0x32, 0xb8, 0x62, 0xe0, 0xfd, 0x64, 0xc3, 0xf6, 0x63, 0x81, 0xf9, 0x63, 0x3a, 0x66,
+	0x34, 0x26, 0x34, 0x51, 0x64, 0x8c, 0x86, 0x9a, 0x8c, 0x66, 0x60, 0x8f, 0x86, 0xe5, 0xf0, 0x76,
+	0x35, 0xfc, 0x2e, 0x34, 0x74, 0x9e, 0xba, 0xe0, 0x7a, 0x90, 0x0b, 0xe8, 0x00, 0x9a, 0x71, 0x5e,
+	0x2c, 0xcb, 0xcb, 0x69, 0x06, 0x2b, 0x05, 0xda, 0x87, 0x4d, 0x8e, 0x23, 0x4c, 0xe6, 0x98, 0x9b,
+	0x6a, 0x96, 0x32, 0xfa, 0x12, 0x80, 0xe3, 0x18, 0xe3, 0x54, 0x71, 0xeb, 0x3a, 0xeb, 0x91, 0x5f,
+	0x32, 0x41, 0x5f, 0x40, 0x2b, 0x9a, 0x92, 0x30, 0xcd, 0x29, 0x76, 0x6f, 0xe8, 0x3e, 0xbc, 0x63,
+	0xc8, 0xb8, 0x53, 0x29, 0xd3, 0x74, 0xa1, 0x8c, 0xf7, 0x7e, 0xb7, 0x61, 0x47, 0x37, 0x6b, 0x45,
+	0x46, 0xa9, 0x78, 0xab, 0x5a, 0xfc, 0x23, 0xb8, 0x3b, 0xce, 0x38, 0xc5, 0xf1, 0x10, 0x73, 0x32,
+	0x0f, 0x25, 0x99, 0x63, 0xd3, 0x03, 0xfb, 0x4d, 0x3d, 0xb8, 0xc6, 0x10, 0x3d, 0x80, 0xdb, 0x79,
+	0x31, 0x0f, 0xb5, 0xf6, 0x8c, 0x3d, 0xc5, 0xd4, 0xad, 0xad, 0x47, 0xc3, 0xeb, 0x96, 0xa8, 0x0d,
+	0x90, 0xe9, 0x42, 0xce, 0x48, 0x8a, 0xcd, 0x60, 0x95, 0x34, 0xe8, 0xa3, 0xe5, 0xc0, 0x36, 0x34,
+	0x51, 0x07, 0x26, 0xe3, 0xdd, 0x2a, 0x07, 0x57, 0xe6, 0xf5, 0x03, 0xb8, 0x55, 0x21, 0x72, 0x14,
+	0x0b, 0xd7, 0xe9, 0xd4, 0xba, 0xcd, 0xe0, 0x35, 0xbd, 0xf7, 0x23, 0xdc, 0xb9, 0xaf, 0x66, 0xe2,
+	0x0a, 0xa9, 0xcb, 0xb9, 0xb1, 0xca, 0x73, 0xf3, 0xa0, 0x48, 0x97, 0xd0, 0x44, 0xb8, 0x76, 0xa7,
+	0xd6, 0x6d, 0x1d, 0xbf, 0xef, 0x5f, 0xb3, 0x38, 0xfc, 0xaa, 0xcb, 0x62, 0x16, 0x56, 0x0e, 0xbc,
+	0xaf, 0x60, 0x47, 0xc7, 0x1e, 0xa8, 0x7e, 0x9d, 0x86, 0x84, 0x5f, 0x13, 0xf6, 0xda, 0xf1, 0xf6,
+	0xbe, 0x83, 0x9b, 0x55, 0x0f, 0x02, 0x0d, 0xa0, 0x31, 0x53, 0x07, 0xd7, 0x7a, 0x43, 0x7a, 0x55,
+	0x43, 0x93, 0x5e, 0x6e, 0xeb, 0x3d, 0x81, 0x5b, 0xa7, 0x9c, 0x45, 0x58, 0x08, 0x42, 0x93, 0x41,
+	0xc6, 0x05, 0xfb, 0x8f, 0xdc, 0x28, 0x3e, 0x97, 0xdf, 0xe0, 0x85, 0xce, 0x6d, 0x2b, 0x28, 0x44,
+	0x74, 0x17, 0x9c, 0x09, 0x26, 0xc9, 0x44, 0xea, 0xf9, 0xa8, 0x05, 0x46, 0xf2, 0x7e, 0xb3, 0xf3,
+	0xf6, 0x7c, 0x4d, 0x84, 0x64, 0x7c, 0x71, 0x9f, 0x4a, 0xbe, 0x40, 0x47, 0xe0, 0x84, 0x91, 0x5a,
+	0x3d, 0xda, 0xfb, 0xf6, 0x72, 0x34, 0x6f, 0x97, 0x90, 0x27, 0x1a, 0x10, 0x18, 0xe0, 0xff, 0x7e,
+	0xf4, 0x9f, 0x82, 0x13, 0xe6, 0xd3, 0x5f, 0x5f, 0x6f, 0x5e, 0x0d, 0x1c, 0x7d, 0xbe, 0xdc, 0x07,
+	0xb1, 0xdb, 0x58, 0xcf, 0x74, 0x69, 0x50, 0x62, 0xc1, 0x29, 0xb3, 0x80, 0x3e, 0x83, 0xba, 0x5a,
+	0xfb, 0x7a, 0x01, 0xb4, 0x8e, 0xf7, 0xfd, 0xfc, 0x4f, 0xf0, 0x8b, 0x3f, 0xc1, 0x3f, 0x2b, 0xfe,
+	0x84, 0xfe, 0xa6, 0xf2, 0xf8, 0xfc, 0xef, 0x43, 0x2b, 0xd0, 0x16, 0xde, 0xaf, 0x16, 0x6c, 0x8e,
+	0xfa, 0x83, 0x47, 0x19, 0xe6, 0x0b, 0xb5, 0xc9, 0x9e, 0xa9, 0xc3, 0xd9, 0x62, 0x86, 0xcd, 0xf3,
+	0x5f, 0x29, 0x90, 0x07, 0x5b, 0x5a, 0x38, 0x0d, 0xe5, 0xa4, 0xe8, 0x50, 0x33, 0xa8, 0xe8, 0x14,
+	0x8d, 0xca, 0x2d, 0xcb, 0xa4, 0xa1, 0xab, 0x10, 0xcb, 0x04, 0xd7, 0xab, 0x04, 0x7b, 0xb0, 0x15,
+	0x31, 0x4a, 0xb1, 0x6e, 0xc4, 0x72, 0xe7, 0x57, 0x74, 0xab, 0x26, 0x38, 0xa5, 0x26, 0xf4, 0x3f,
+	0x79, 0x71, 0xd1, 0xb6, 0x5e, 0x5e, 0xb4, 0xad, 0x7f, 0x2e, 0xda, 0xd6, 0xf3, 0xcb, 0xf6, 0xc6,
+	0xcb, 0xcb, 0xf6, 0xc6, 0x9f, 0x97, 0xed, 0x8d, 0x27, 0x07, 0xcb, 0x4f, 0xfa, 0xbc, 0xf2, 0x4d,
+	0xcb, 0xc5, 0x0c, 0x8b, 0xb1, 0xa3, 0x89, 0xb9, 0xf7, 0xef, 0x00, 0x03, 0x70, 0xe7, 0xe5, 0xcb,
+	0x07, 0x00, 0x00,
 }
 
 func (m *ProxyDelegation) Marshal() (dAtA []byte, err error) {
@@ -734,7 +1007,7 @@ func (m *EpochProxyUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *IBCQuery) Marshal() (dAtA []byte, err error) {
+func (m *EpochChainPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -744,105 +1017,290 @@ func (m *IBCQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IBCQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *EpochChainPair) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IBCQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EpochChainPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ConnectionID) > 0 {
-		i -= len(m.ConnectionID)
-		copy(dAtA[i:], m.ConnectionID)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.ConnectionID)))
-		i--
-		dAtA[i] = 0x2a
-	}
 	if len(m.ChainID) > 0 {
 		i -= len(m.ChainID)
 		copy(dAtA[i:], m.ChainID)
 		i = encodeVarintStake(dAtA, i, uint64(len(m.ChainID)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Timeout != 0 {
-		i = encodeVarintStake(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.QueryPathKey) > 0 {
-		i -= len(m.QueryPathKey)
-		copy(dAtA[i:], m.QueryPathKey)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.QueryPathKey)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.QueryType) > 0 {
-		i -= len(m.QueryType)
-		copy(dAtA[i:], m.QueryType)
-		i = encodeVarintStake(dAtA, i, uint64(len(m.QueryType)))
+	if m.Epoch != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Epoch))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStake(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EpochChainPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ProxyDelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EpochChainPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochChainPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovStake(uint64(m.Id))
-	}
-	l = m.Coin.Size()
-	n += 1 + l + sovStake(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovStake(uint64(m.Status))
-	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovStake(uint64(m.EpochNumber))
-	}
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = m.ReinvestAmount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UserUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
+func (m *ProcessingCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainID)
+	return dAtA[:n], nil
+}
+
+func (m *ProcessingCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessingCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStake(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Epoch != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ConnectionID) > 0 {
+		i -= len(m.ConnectionID)
+		copy(dAtA[i:], m.ConnectionID)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.ConnectionID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QueryPathKey) > 0 {
+		i -= len(m.QueryPathKey)
+		copy(dAtA[i:], m.QueryPathKey)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.QueryPathKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProxyDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStake(uint64(m.Id))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovStake(uint64(m.Status))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovStake(uint64(m.EpochNumber))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.ReinvestAmount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *UserUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.ChainID)
 	if l > 0 {
 		n += 1 + l + sovStake(uint64(l))
 	}
@@ -912,6 +1370,84 @@ func (m *EpochProxyUnbonding) Size() (n int) {
 	return n
 }
 
+func (m *EpochChainPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovStake(uint64(m.Epoch))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochChainPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProcessingCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovStake(uint64(m.Epoch))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStake(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *UserHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovStake(uint64(m.Action))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovStake(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovStake(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
 func (m *IBCQuery) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1726,6 +2262,551 @@ func (m *EpochProxyUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochChainPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochChainPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochChainPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochChainPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochChainPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochChainPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, EpochChainPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessingCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessingCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessingCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= UserHistoryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *IBCQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
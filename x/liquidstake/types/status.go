@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // ProxyDelegation status
 type ProxyDelegationStatus uint32
 
@@ -17,6 +19,17 @@ func IsProxyDelegationProcessing(status ProxyDelegationStatus) bool {
 	return status != ProxyDelegationDone
 }
 
+// AllDone return true if all the ProxyUnbondings of the epoch are done.
+func (e EpochProxyUnbonding) AllDone() bool {
+	for _, unbonding := range e.Unbondings {
+		if unbonding.Status != ProxyUnbondingDone {
+			return false
+		}
+	}
+
+	return true
+}
+
 // UndelegationRecord status
 type UserUnbondingStatus uint32
 
@@ -39,3 +52,70 @@ const (
 	ProxyUnbondingStartFailed
 	ProxyUnbondingTransferFailed
 )
+
+// SourceChain status
+type SourceChainStatus uint32
+
+const (
+	SourceChainActive SourceChainStatus = iota
+	SourceChainPausedDeposits
+	SourceChainPausedAll
+	SourceChainDeregistering
+)
+
+func (s SourceChainStatus) String() string {
+	switch s {
+	case SourceChainActive:
+		return "active"
+	case SourceChainPausedDeposits:
+		return "paused_deposits"
+	case SourceChainPausedAll:
+		return "paused_all"
+	case SourceChainDeregistering:
+		return "deregistering"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(s))
+	}
+}
+
+// IsValid return true if the status is known.
+func (s SourceChainStatus) IsValid() bool {
+	return s <= SourceChainDeregistering
+}
+
+// DepositAllowed return true if the user can delegate to source chain.
+func (s SourceChainStatus) DepositAllowed() bool {
+	return s == SourceChainActive
+}
+
+// WithdrawAllowed return true if the user can undelegate and claim from source chain.
+func (s SourceChainStatus) WithdrawAllowed() bool {
+	return s != SourceChainPausedAll
+}
+
+// StakingAllowed return true if new ProxyDelegation and reinvestment can be started for source chain.
+func (s SourceChainStatus) StakingAllowed() bool {
+	return s == SourceChainActive || s == SourceChainPausedDeposits
+}
+
+// The action of UserHistoryEntry
+type UserHistoryAction uint32
+
+const (
+	UserHistoryDelegate UserHistoryAction = iota
+	UserHistoryUndelegate
+	UserHistoryClaim
+)
+
+func (a UserHistoryAction) String() string {
+	switch a {
+	case UserHistoryDelegate:
+		return "delegate"
+	case UserHistoryUndelegate:
+		return "undelegate"
+	case UserHistoryClaim:
+		return "claim"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(a))
+	}
+}
@@ -7,7 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -48,6 +49,9 @@ type MsgRegisterSourceChain struct {
 	DerivativeDenom string `protobuf:"bytes,7,opt,name=derivativeDenom,proto3" json:"derivativeDenom,omitempty"`
 	// The caller of this transaction. It needs to have certain permissions
 	Caller string `protobuf:"bytes,8,opt,name=caller,proto3" json:"caller,omitempty"`
+	// The bank metadata of native token. The display, symbol and exponent of derivative token are
+	// copied from it. If not set, the metadata of ibc denom of native token on this chain will be used.
+	NativeDenomMetadata *types.Metadata `protobuf:"bytes,9,opt,name=nativeDenomMetadata,proto3" json:"nativeDenomMetadata,omitempty"`
 }
 
 func (m *MsgRegisterSourceChain) Reset()         { *m = MsgRegisterSourceChain{} }
@@ -139,6 +143,13 @@ func (m *MsgRegisterSourceChain) GetCaller() string {
 	return ""
 }
 
+func (m *MsgRegisterSourceChain) GetNativeDenomMetadata() *types.Metadata {
+	if m != nil {
+		return m.NativeDenomMetadata
+	}
+	return nil
+}
+
 // MsgRegisterSourceChainResponse define the MsgRegisterSourceChain response type.
 type MsgRegisterSourceChainResponse struct {
 }
@@ -567,7 +578,7 @@ type MsgReinvest struct {
 	// The delegation epoch.
 	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	// The funds to reinvest
-	Funds *types.Coin `protobuf:"bytes,3,opt,name=funds,proto3" json:"funds,omitempty"`
+	Funds *types1.Coin `protobuf:"bytes,3,opt,name=funds,proto3" json:"funds,omitempty"`
 	// The Merkle proof of the funds.
 	FundsProof string `protobuf:"bytes,4,opt,name=fundsProof,proto3" json:"fundsProof,omitempty"`
 	// The height at which the Merkle proof is stored.
@@ -623,7 +634,7 @@ func (m *MsgReinvest) GetEpoch() uint64 {
 	return 0
 }
 
-func (m *MsgReinvest) GetFunds() *types.Coin {
+func (m *MsgReinvest) GetFunds() *types1.Coin {
 	if m != nil {
 		return m.Funds
 	}
@@ -789,1181 +800,4698 @@ func (m *MsgClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterSourceChain)(nil), "celinium.liquidstake.v1.MsgRegisterSourceChain")
-	proto.RegisterType((*MsgRegisterSourceChainResponse)(nil), "celinium.liquidstake.v1.MsgRegisterSourceChainResponse")
-	proto.RegisterType((*MsgEditVadlidators)(nil), "celinium.liquidstake.v1.MsgEditVadlidators")
-	proto.RegisterType((*MsgEditValidatorsResponse)(nil), "celinium.liquidstake.v1.MsgEditValidatorsResponse")
-	proto.RegisterType((*MsgRebalanceValidators)(nil), "celinium.liquidstake.v1.MsgRebalanceValidators")
-	proto.RegisterType((*RebalanceValidatorsResponse)(nil), "celinium.liquidstake.v1.RebalanceValidatorsResponse")
-	proto.RegisterType((*MsgDelegate)(nil), "celinium.liquidstake.v1.MsgDelegate")
-	proto.RegisterType((*MsgDelegateResponse)(nil), "celinium.liquidstake.v1.MsgDelegateResponse")
-	proto.RegisterType((*MsgUndelegate)(nil), "celinium.liquidstake.v1.MsgUndelegate")
-	proto.RegisterType((*MsgUndelegateResponse)(nil), "celinium.liquidstake.v1.MsgUndelegateResponse")
-	proto.RegisterType((*MsgReinvest)(nil), "celinium.liquidstake.v1.MsgReinvest")
-	proto.RegisterType((*MsgReinvestResponse)(nil), "celinium.liquidstake.v1.MsgReinvestResponse")
-	proto.RegisterType((*MsgClaim)(nil), "celinium.liquidstake.v1.MsgClaim")
-	proto.RegisterType((*MsgClaimResponse)(nil), "celinium.liquidstake.v1.MsgClaimResponse")
+// MsgUpdateValidatorSelectionPolicy defines the message to update the validator selection policy of a source chain.
+type MsgUpdateValidatorSelectionPolicy struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// The new policy.
+	Policy ValidatorSelectionPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
 }
 
-func init() { proto.RegisterFile("celinium/liquidstake/v1/tx.proto", fileDescriptor_f805c8e926b8a0ec) }
-
-var fileDescriptor_f805c8e926b8a0ec = []byte{
-	// 798 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x8e, 0xe3, 0x44,
-	0x10, 0xce, 0xcf, 0x24, 0x3b, 0x5b, 0xe1, 0xb7, 0xf7, 0xcf, 0xf1, 0x2e, 0xde, 0x10, 0xad, 0xd0,
-	0x00, 0x8b, 0xad, 0x64, 0x11, 0x5c, 0xb8, 0x90, 0x04, 0x69, 0x83, 0x14, 0x69, 0x65, 0xb4, 0x2b,
-	0xc4, 0x81, 0x51, 0xc7, 0xee, 0x38, 0x2d, 0x9c, 0xee, 0x8c, 0xbb, 0x13, 0x05, 0x38, 0x70, 0xe7,
-	0xc4, 0xc3, 0xf0, 0x10, 0x73, 0x1c, 0x71, 0x42, 0x1c, 0x46, 0x68, 0xe6, 0x05, 0x90, 0x78, 0x01,
-	0xe4, 0xf6, 0x7f, 0x26, 0xf1, 0x0c, 0xa7, 0xbd, 0x75, 0x55, 0x7d, 0x55, 0xf5, 0x55, 0xb9, 0xbf,
-	0x96, 0xa1, 0xe3, 0x10, 0x9f, 0x32, 0xba, 0x5a, 0x58, 0x3e, 0x3d, 0x59, 0x51, 0x57, 0x48, 0xfc,
-	0x03, 0xb1, 0xd6, 0x3d, 0x4b, 0x6e, 0xcc, 0x65, 0xc0, 0x25, 0x47, 0x0f, 0x12, 0x84, 0x99, 0x43,
-	0x98, 0xeb, 0x9e, 0x7e, 0xd7, 0xe3, 0x1e, 0x57, 0x18, 0x2b, 0x3c, 0x45, 0x70, 0xbd, 0xed, 0x70,
-	0xb1, 0xe0, 0xe2, 0x38, 0x0a, 0x44, 0x46, 0x1c, 0x32, 0x22, 0xcb, 0x9a, 0x62, 0x11, 0xb6, 0x98,
-	0x12, 0x89, 0x7b, 0x96, 0xc3, 0x29, 0x8b, 0xe3, 0x1f, 0xed, 0xe3, 0x22, 0xf8, 0x2a, 0x70, 0xc8,
-	0xb1, 0x33, 0xc7, 0x09, 0xb6, 0xfb, 0x4f, 0x0d, 0xee, 0x4f, 0x84, 0x67, 0x13, 0x8f, 0x0a, 0x49,
-	0x82, 0x6f, 0x14, 0x62, 0x18, 0x02, 0x90, 0x06, 0xb7, 0xd4, 0x61, 0x3c, 0xd2, 0xaa, 0x9d, 0xea,
-	0xd1, 0x6d, 0x3b, 0x31, 0x51, 0x17, 0xde, 0x70, 0x38, 0x63, 0xc4, 0x91, 0x94, 0x87, 0xe1, 0x9a,
-	0x0a, 0x17, 0x7c, 0xe8, 0x29, 0xbc, 0x2b, 0x03, 0x2c, 0xd8, 0x8c, 0x04, 0xc3, 0x39, 0x66, 0x8c,
-	0xf8, 0xe3, 0x91, 0x56, 0x57, 0xc0, 0xab, 0x01, 0xf4, 0x05, 0xb4, 0xa7, 0xc4, 0x99, 0x3f, 0xeb,
-	0xbf, 0xc2, 0x3e, 0x75, 0xb1, 0xe4, 0xc1, 0x97, 0xae, 0x1b, 0xbc, 0x08, 0xc8, 0x8c, 0x6e, 0xb4,
-	0x03, 0x95, 0xb5, 0x1f, 0x80, 0x9e, 0x03, 0xac, 0x13, 0xb7, 0xd0, 0x1a, 0x9d, 0xfa, 0x51, 0xab,
-	0xdf, 0x35, 0xf7, 0xec, 0xdb, 0x4c, 0x2b, 0x0c, 0x0e, 0x4e, 0xcf, 0x1f, 0x57, 0xec, 0x5c, 0x2e,
-	0xea, 0x40, 0x8b, 0x61, 0x49, 0xd7, 0x64, 0x44, 0x18, 0x5f, 0x68, 0x4d, 0xd5, 0x39, 0xef, 0x42,
-	0x47, 0xf0, 0xb6, 0x4b, 0x02, 0xba, 0xce, 0xa1, 0x6e, 0x29, 0xd4, 0xb6, 0x1b, 0xdd, 0x87, 0xa6,
-	0x83, 0x7d, 0x9f, 0x04, 0xda, 0xa1, 0x02, 0xc4, 0x56, 0xb7, 0x03, 0xc6, 0xee, 0x8d, 0xdb, 0x44,
-	0x2c, 0x39, 0x13, 0xa4, 0xfb, 0x6b, 0x15, 0xd0, 0x44, 0x78, 0x5f, 0xb9, 0x54, 0xbe, 0xc2, 0x6e,
-	0x4a, 0x6e, 0xff, 0x07, 0x19, 0x14, 0x16, 0x50, 0xbb, 0xe9, 0x02, 0x0a, 0xa3, 0x67, 0x74, 0xeb,
-	0x05, 0xba, 0x0f, 0xa1, 0x9d, 0x72, 0x49, 0xc0, 0x29, 0xd3, 0xaf, 0xe3, 0xdb, 0x33, 0xc5, 0x3e,
-	0x66, 0x0e, 0xc9, 0x10, 0x25, 0x64, 0xb3, 0x46, 0xb5, 0x42, 0xa3, 0xf7, 0xe0, 0xe1, 0x8e, 0x42,
-	0x69, 0xab, 0x0d, 0xb4, 0x26, 0xc2, 0x1b, 0x11, 0x9f, 0x78, 0x58, 0x92, 0xb0, 0xbe, 0x53, 0xac,
-	0x1f, 0x9b, 0xa8, 0x07, 0x4d, 0xbc, 0xe0, 0x2b, 0x26, 0xa3, 0xfa, 0x83, 0x76, 0xf8, 0x95, 0xff,
-	0x3a, 0x7f, 0x5c, 0x1f, 0x33, 0xf9, 0xc7, 0xef, 0x9f, 0x40, 0xac, 0xa5, 0x31, 0x93, 0x76, 0x0c,
-	0x44, 0x8f, 0xe0, 0xb6, 0x1b, 0x15, 0xe6, 0xc9, 0xf8, 0x99, 0xa3, 0x7b, 0x0f, 0xee, 0xe4, 0x3a,
-	0xa7, 0x84, 0x7e, 0x82, 0x37, 0x27, 0xc2, 0x7b, 0xc9, 0xdc, 0xd7, 0x40, 0xe9, 0x01, 0xdc, 0x2b,
-	0xf4, 0x4e, 0x49, 0x9d, 0x56, 0xd5, 0x9a, 0x6c, 0x42, 0xd9, 0x9a, 0x08, 0x99, 0x71, 0x72, 0x8b,
-	0x9c, 0x5c, 0x74, 0x17, 0x1a, 0x64, 0xc9, 0x9d, 0xb9, 0xa2, 0x74, 0x60, 0x47, 0x06, 0xb2, 0xa0,
-	0x31, 0x5b, 0x31, 0x57, 0xa8, 0x96, 0xad, 0x7e, 0xdb, 0x8c, 0xa9, 0x85, 0x6f, 0x8d, 0x19, 0xbf,
-	0x35, 0xe6, 0x90, 0x53, 0x66, 0x47, 0x38, 0x64, 0x00, 0xa8, 0xc3, 0x8b, 0x80, 0xf3, 0x59, 0x2c,
-	0xd5, 0x9c, 0x27, 0x54, 0xd4, 0x32, 0x3c, 0x3c, 0x27, 0xd4, 0x9b, 0x4b, 0xad, 0xa1, 0x9a, 0xe5,
-	0x5d, 0xb9, 0xfb, 0xd0, 0x2c, 0xdc, 0x87, 0x68, 0xed, 0xc9, 0x24, 0xe9, 0x84, 0xdf, 0xc2, 0xe1,
-	0x44, 0x78, 0x43, 0x1f, 0xd3, 0x45, 0x76, 0xc9, 0xdc, 0xe2, 0x25, 0x73, 0x8b, 0xeb, 0xab, 0x6d,
-	0xad, 0x2f, 0x9b, 0xbd, 0x9e, 0x9b, 0xbd, 0x8b, 0xe0, 0x9d, 0xa4, 0x72, 0xd2, 0xad, 0xff, 0x6f,
-	0x03, 0xea, 0x13, 0xe1, 0xa1, 0x5f, 0xe0, 0xce, 0xae, 0x37, 0xd2, 0xda, 0x2b, 0xb2, 0xdd, 0x12,
-	0xd7, 0x3f, 0xff, 0x9f, 0x09, 0x09, 0x11, 0x74, 0x02, 0x6f, 0x15, 0x35, 0x88, 0x3e, 0x2e, 0x2b,
-	0xb5, 0xf5, 0x76, 0xe8, 0xfd, 0xeb, 0xc1, 0xdb, 0x8a, 0x43, 0x3f, 0x03, 0xba, 0x2a, 0xc8, 0xeb,
-	0x46, 0xbe, 0x22, 0x60, 0xfd, 0xd3, 0xbd, 0x09, 0x25, 0x72, 0x47, 0xdf, 0xc3, 0x61, 0xaa, 0xf5,
-	0x27, 0x65, 0x2d, 0x13, 0x94, 0xfe, 0xf4, 0x26, 0xa8, 0xb4, 0xbe, 0x0b, 0x90, 0x93, 0xee, 0x07,
-	0x65, 0xb9, 0x19, 0x4e, 0x37, 0x6f, 0x86, 0xcb, 0x4f, 0x91, 0x4a, 0xf1, 0x49, 0xf9, 0xe2, 0x22,
-	0x54, 0xf9, 0x14, 0xdb, 0x62, 0x40, 0x2f, 0xa1, 0x11, 0x29, 0xe1, 0xfd, 0xb2, 0x34, 0x05, 0xd1,
-	0x3f, 0xbc, 0x16, 0x92, 0x94, 0x1d, 0x7c, 0x76, 0x7a, 0x61, 0x54, 0xcf, 0x2e, 0x8c, 0xea, 0xdf,
-	0x17, 0x46, 0xf5, 0xb7, 0x4b, 0xa3, 0x72, 0x76, 0x69, 0x54, 0xfe, 0xbc, 0x34, 0x2a, 0xdf, 0x3d,
-	0x4a, 0xff, 0x2d, 0x36, 0x85, 0xbf, 0x0b, 0xf9, 0xe3, 0x92, 0x88, 0x69, 0x53, 0xfd, 0x54, 0x3c,
-	0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x80, 0x3b, 0x8e, 0xed, 0x0e, 0x09, 0x00, 0x00,
+func (m *MsgUpdateValidatorSelectionPolicy) Reset()         { *m = MsgUpdateValidatorSelectionPolicy{} }
+func (m *MsgUpdateValidatorSelectionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateValidatorSelectionPolicy) ProtoMessage()    {}
+func (*MsgUpdateValidatorSelectionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Register a new source chain for liquid stake. At least one correct Validator information must be provided
-	RegisterSourceChain(ctx context.Context, in *MsgRegisterSourceChain, opts ...grpc.CallOption) (*MsgRegisterSourceChainResponse, error)
-	// Edit the weight of validators for a source chain. Add this validators if they don't exist.
-	EditValidators(ctx context.Context, in *MsgEditVadlidators, opts ...grpc.CallOption) (*MsgEditValidatorsResponse, error)
-	// Rebalance the amount of delegation funds according to the weight of Validators.
-	// Note that this cannot be instant.
-	RebalanceValidator(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*RebalanceValidatorsResponse, error)
-	// Delegate define a method for performing a cross chain delegation of coins.
-	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
-	// Undelegate defines a method for performing an cross chain undelegation from dest chain to source chain.
-	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
-	// Reinvest defines a method for reinvestment which use the reward form delegate.
-	Reinvest(ctx context.Context, in *MsgReinvest, opts ...grpc.CallOption) (*MsgReinvestResponse, error)
-	// Claim define a method for user claim the reward of delegation.
-	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+func (m *MsgUpdateValidatorSelectionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateValidatorSelectionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorSelectionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateValidatorSelectionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorSelectionPolicy.Merge(m, src)
+}
+func (m *MsgUpdateValidatorSelectionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorSelectionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorSelectionPolicy.DiscardUnknown(m)
 }
 
-func (c *msgClient) RegisterSourceChain(ctx context.Context, in *MsgRegisterSourceChain, opts ...grpc.CallOption) (*MsgRegisterSourceChainResponse, error) {
-	out := new(MsgRegisterSourceChainResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/RegisterSourceChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateValidatorSelectionPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateValidatorSelectionPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) EditValidators(ctx context.Context, in *MsgEditVadlidators, opts ...grpc.CallOption) (*MsgEditValidatorsResponse, error) {
-	out := new(MsgEditValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/EditValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateValidatorSelectionPolicy) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RebalanceValidator(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*RebalanceValidatorsResponse, error) {
-	out := new(RebalanceValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/RebalanceValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateValidatorSelectionPolicy) GetPolicy() ValidatorSelectionPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return out, nil
+	return ValidatorSelectionPolicy{}
 }
 
-func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error) {
-	out := new(MsgDelegateResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Delegate", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgUpdateValidatorSelectionPolicyResponse defines the MsgUpdateValidatorSelectionPolicy response type.
+type MsgUpdateValidatorSelectionPolicyResponse struct {
+}
+
+func (m *MsgUpdateValidatorSelectionPolicyResponse) Reset() {
+	*m = MsgUpdateValidatorSelectionPolicyResponse{}
+}
+func (m *MsgUpdateValidatorSelectionPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateValidatorSelectionPolicyResponse) ProtoMessage() {}
+func (*MsgUpdateValidatorSelectionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{15}
+}
+func (m *MsgUpdateValidatorSelectionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateValidatorSelectionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateValidatorSelectionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateValidatorSelectionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateValidatorSelectionPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateValidatorSelectionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateValidatorSelectionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateValidatorSelectionPolicyResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
-	out := new(MsgUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Undelegate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateValidatorSelectionPolicyResponse proto.InternalMessageInfo
+
+// MsgSubmitHostValidatorSnapshot defines the message to submit the validator snapshot of a source chain.
+type MsgSubmitHostValidatorSnapshot struct {
+	// The submitter of the snapshot. It must be one of the `snapshotSubmitters` in the policy.
+	Submitter string                `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	Snapshot  HostValidatorSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot"`
+	// The proofs of the delegation amounts in the snapshot, one for each validator which has a delegation amount.
+	Proofs []HostDelegationProof `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs"`
+}
+
+func (m *MsgSubmitHostValidatorSnapshot) Reset()         { *m = MsgSubmitHostValidatorSnapshot{} }
+func (m *MsgSubmitHostValidatorSnapshot) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitHostValidatorSnapshot) ProtoMessage()    {}
+func (*MsgSubmitHostValidatorSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{16}
+}
+func (m *MsgSubmitHostValidatorSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitHostValidatorSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitHostValidatorSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitHostValidatorSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitHostValidatorSnapshot.Merge(m, src)
+}
+func (m *MsgSubmitHostValidatorSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitHostValidatorSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitHostValidatorSnapshot.DiscardUnknown(m)
 }
 
-func (c *msgClient) Reinvest(ctx context.Context, in *MsgReinvest, opts ...grpc.CallOption) (*MsgReinvestResponse, error) {
-	out := new(MsgReinvestResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Reinvest", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitHostValidatorSnapshot proto.InternalMessageInfo
+
+func (m *MsgSubmitHostValidatorSnapshot) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
-	out := new(MsgClaimResponse)
-	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Claim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitHostValidatorSnapshot) GetSnapshot() HostValidatorSnapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return out, nil
+	return HostValidatorSnapshot{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Register a new source chain for liquid stake. At least one correct Validator information must be provided
-	RegisterSourceChain(context.Context, *MsgRegisterSourceChain) (*MsgRegisterSourceChainResponse, error)
-	// Edit the weight of validators for a source chain. Add this validators if they don't exist.
-	EditValidators(context.Context, *MsgEditVadlidators) (*MsgEditValidatorsResponse, error)
-	// Rebalance the amount of delegation funds according to the weight of Validators.
-	// Note that this cannot be instant.
-	RebalanceValidator(context.Context, *MsgRebalanceValidators) (*RebalanceValidatorsResponse, error)
-	// Delegate define a method for performing a cross chain delegation of coins.
-	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
-	// Undelegate defines a method for performing an cross chain undelegation from dest chain to source chain.
-	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
-	// Reinvest defines a method for reinvestment which use the reward form delegate.
-	Reinvest(context.Context, *MsgReinvest) (*MsgReinvestResponse, error)
-	// Claim define a method for user claim the reward of delegation.
-	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+func (m *MsgSubmitHostValidatorSnapshot) GetProofs() []HostDelegationProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSubmitHostValidatorSnapshotResponse defines the MsgSubmitHostValidatorSnapshot response type.
+type MsgSubmitHostValidatorSnapshotResponse struct {
 }
 
-func (*UnimplementedMsgServer) RegisterSourceChain(ctx context.Context, req *MsgRegisterSourceChain) (*MsgRegisterSourceChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterSourceChain not implemented")
+func (m *MsgSubmitHostValidatorSnapshotResponse) Reset() {
+	*m = MsgSubmitHostValidatorSnapshotResponse{}
 }
-func (*UnimplementedMsgServer) EditValidators(ctx context.Context, req *MsgEditVadlidators) (*MsgEditValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditValidators not implemented")
+func (m *MsgSubmitHostValidatorSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitHostValidatorSnapshotResponse) ProtoMessage()    {}
+func (*MsgSubmitHostValidatorSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{17}
 }
-func (*UnimplementedMsgServer) RebalanceValidator(ctx context.Context, req *MsgRebalanceValidators) (*RebalanceValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RebalanceValidator not implemented")
+func (m *MsgSubmitHostValidatorSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (*MsgDelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
+func (m *MsgSubmitHostValidatorSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitHostValidatorSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+func (m *MsgSubmitHostValidatorSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitHostValidatorSnapshotResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Reinvest(ctx context.Context, req *MsgReinvest) (*MsgReinvestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reinvest not implemented")
+func (m *MsgSubmitHostValidatorSnapshotResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+func (m *MsgSubmitHostValidatorSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitHostValidatorSnapshotResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSubmitHostValidatorSnapshotResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines the message to update the liquidstake module parameters.
+type MsgUpdateParams struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The new parameters.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func _Msg_RegisterSourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterSourceChain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterSourceChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Msg/RegisterSourceChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterSourceChain(ctx, req.(*MsgRegisterSourceChain))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{18}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func _Msg_EditValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditVadlidators)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditValidators(ctx, in)
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Msg/EditValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditValidators(ctx, req.(*MsgEditVadlidators))
-	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Msg_RebalanceValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRebalanceValidators)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RebalanceValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Msg/RebalanceValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RebalanceValidator(ctx, req.(*MsgRebalanceValidators))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateParamsResponse defines the MsgUpdateParams response type.
+type MsgUpdateParamsResponse struct {
 }
 
-func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Delegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Msg/Delegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Delegate(ctx, req.(*MsgDelegate))
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{19}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Undelegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Msg/Undelegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgResumeSourceChain defines the message to resume a halted source chain.
+// The redeem rate will be recomputed without bounds check.
+type MsgResumeSourceChain struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
-func _Msg_Reinvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReinvest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Reinvest(ctx, in)
+func (m *MsgResumeSourceChain) Reset()         { *m = MsgResumeSourceChain{} }
+func (m *MsgResumeSourceChain) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeSourceChain) ProtoMessage()    {}
+func (*MsgResumeSourceChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{20}
+}
+func (m *MsgResumeSourceChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeSourceChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeSourceChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Msg/Reinvest",
+}
+func (m *MsgResumeSourceChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeSourceChain.Merge(m, src)
+}
+func (m *MsgResumeSourceChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeSourceChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeSourceChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeSourceChain proto.InternalMessageInfo
+
+func (m *MsgResumeSourceChain) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Reinvest(ctx, req.(*MsgReinvest))
+	return ""
+}
+
+func (m *MsgResumeSourceChain) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaim)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgResumeSourceChainResponse defines the MsgResumeSourceChain response type.
+type MsgResumeSourceChainResponse struct {
+}
+
+func (m *MsgResumeSourceChainResponse) Reset()         { *m = MsgResumeSourceChainResponse{} }
+func (m *MsgResumeSourceChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeSourceChainResponse) ProtoMessage()    {}
+func (*MsgResumeSourceChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{21}
+}
+func (m *MsgResumeSourceChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeSourceChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeSourceChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Claim(ctx, in)
+}
+func (m *MsgResumeSourceChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeSourceChainResponse.Merge(m, src)
+}
+func (m *MsgResumeSourceChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeSourceChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeSourceChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeSourceChainResponse proto.InternalMessageInfo
+
+// MsgSetSourceChainStatus defines the message to set the status of a source chain.
+type MsgSetSourceChainStatus struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// The new status.
+	Status SourceChainStatus `protobuf:"varint,3,opt,name=status,proto3,customtype=SourceChainStatus" json:"status"`
+}
+
+func (m *MsgSetSourceChainStatus) Reset()         { *m = MsgSetSourceChainStatus{} }
+func (m *MsgSetSourceChainStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSourceChainStatus) ProtoMessage()    {}
+func (*MsgSetSourceChainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{22}
+}
+func (m *MsgSetSourceChainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSourceChainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSourceChainStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celinium.liquidstake.v1.Msg/Claim",
+}
+func (m *MsgSetSourceChainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSourceChainStatus.Merge(m, src)
+}
+func (m *MsgSetSourceChainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSourceChainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSourceChainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSourceChainStatus proto.InternalMessageInfo
+
+func (m *MsgSetSourceChainStatus) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
+	return ""
+}
+
+func (m *MsgSetSourceChainStatus) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celinium.liquidstake.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterSourceChain",
-			Handler:    _Msg_RegisterSourceChain_Handler,
-		},
-		{
-			MethodName: "EditValidators",
-			Handler:    _Msg_EditValidators_Handler,
-		},
-		{
-			MethodName: "RebalanceValidator",
-			Handler:    _Msg_RebalanceValidator_Handler,
-		},
-		{
-			MethodName: "Delegate",
-			Handler:    _Msg_Delegate_Handler,
-		},
-		{
-			MethodName: "Undelegate",
-			Handler:    _Msg_Undelegate_Handler,
-		},
-		{
-			MethodName: "Reinvest",
-			Handler:    _Msg_Reinvest_Handler,
-		},
-		{
-			MethodName: "Claim",
-			Handler:    _Msg_Claim_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celinium/liquidstake/v1/tx.proto",
+// MsgSetSourceChainStatusResponse defines the MsgSetSourceChainStatus response type.
+type MsgSetSourceChainStatusResponse struct {
 }
 
-func (m *MsgRegisterSourceChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetSourceChainStatusResponse) Reset()         { *m = MsgSetSourceChainStatusResponse{} }
+func (m *MsgSetSourceChainStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSourceChainStatusResponse) ProtoMessage()    {}
+func (*MsgSetSourceChainStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{23}
+}
+func (m *MsgSetSourceChainStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSourceChainStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSourceChainStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetSourceChainStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSourceChainStatusResponse.Merge(m, src)
+}
+func (m *MsgSetSourceChainStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSourceChainStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSourceChainStatusResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterSourceChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetSourceChainStatusResponse proto.InternalMessageInfo
+
+// MsgDeregisterSourceChain defines the message to deregister a source chain. New deposits are stopped,
+// all the stake is undelegated and the source chain is removed after all derivative tokens are redeemed.
+type MsgDeregisterSourceChain struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
 }
 
-func (m *MsgRegisterSourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0x42
+func (m *MsgDeregisterSourceChain) Reset()         { *m = MsgDeregisterSourceChain{} }
+func (m *MsgDeregisterSourceChain) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterSourceChain) ProtoMessage()    {}
+func (*MsgDeregisterSourceChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{24}
+}
+func (m *MsgDeregisterSourceChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterSourceChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterSourceChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DerivativeDenom) > 0 {
-		i -= len(m.DerivativeDenom)
-		copy(dAtA[i:], m.DerivativeDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeDenom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.NativeDenom) > 0 {
-		i -= len(m.NativeDenom)
-		copy(dAtA[i:], m.NativeDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Bech32ValidatorAddrPrefix) > 0 {
-		i -= len(m.Bech32ValidatorAddrPrefix)
-		copy(dAtA[i:], m.Bech32ValidatorAddrPrefix)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Bech32ValidatorAddrPrefix)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TrasnferChannelID) > 0 {
-		i -= len(m.TrasnferChannelID)
-		copy(dAtA[i:], m.TrasnferChannelID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrasnferChannelID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConnectionID) > 0 {
-		i -= len(m.ConnectionID)
-		copy(dAtA[i:], m.ConnectionID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDeregisterSourceChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterSourceChain.Merge(m, src)
+}
+func (m *MsgDeregisterSourceChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterSourceChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterSourceChain.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterSourceChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeregisterSourceChain proto.InternalMessageInfo
+
+func (m *MsgDeregisterSourceChain) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterSourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDeregisterSourceChain) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
 }
 
-func (m *MsgRegisterSourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgDeregisterSourceChainResponse defines the MsgDeregisterSourceChain response type.
+type MsgDeregisterSourceChainResponse struct {
 }
 
-func (m *MsgEditVadlidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeregisterSourceChainResponse) Reset()         { *m = MsgDeregisterSourceChainResponse{} }
+func (m *MsgDeregisterSourceChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterSourceChainResponse) ProtoMessage()    {}
+func (*MsgDeregisterSourceChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{25}
+}
+func (m *MsgDeregisterSourceChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterSourceChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterSourceChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDeregisterSourceChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterSourceChainResponse.Merge(m, src)
+}
+func (m *MsgDeregisterSourceChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterSourceChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterSourceChainResponse.DiscardUnknown(m)
 }
 
-func (m *MsgEditVadlidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDeregisterSourceChainResponse proto.InternalMessageInfo
+
+// MsgUpdateDerivativeDenomMetadata defines the message to update the bank metadata of derivative token.
+type MsgUpdateDerivativeDenomMetadata struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// The new metadata, the base denom must be the derivative denom of source chain.
+	Metadata types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *MsgEditVadlidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateDerivativeDenomMetadata) Reset()         { *m = MsgUpdateDerivativeDenomMetadata{} }
+func (m *MsgUpdateDerivativeDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDerivativeDenomMetadata) ProtoMessage()    {}
+func (*MsgUpdateDerivativeDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{26}
+}
+func (m *MsgUpdateDerivativeDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDerivativeDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDerivativeDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateDerivativeDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDerivativeDenomMetadata.Merge(m, src)
+}
+func (m *MsgUpdateDerivativeDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDerivativeDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDerivativeDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDerivativeDenomMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateDerivativeDenomMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgEditValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateDerivativeDenomMetadata) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgEditValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateDerivativeDenomMetadata) GetMetadata() types.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types.Metadata{}
 }
 
-func (m *MsgEditValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgUpdateDerivativeDenomMetadataResponse defines the MsgUpdateDerivativeDenomMetadata response type.
+type MsgUpdateDerivativeDenomMetadataResponse struct {
 }
 
-func (m *MsgRebalanceValidators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateDerivativeDenomMetadataResponse) Reset() {
+	*m = MsgUpdateDerivativeDenomMetadataResponse{}
+}
+func (m *MsgUpdateDerivativeDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDerivativeDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateDerivativeDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{27}
+}
+func (m *MsgUpdateDerivativeDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDerivativeDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDerivativeDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateDerivativeDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDerivativeDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateDerivativeDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDerivativeDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDerivativeDenomMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRebalanceValidators) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateDerivativeDenomMetadataResponse proto.InternalMessageInfo
+
+// MsgClearValidatorSlashed defines the message to clear the slashed flag of source chain validators,
+// their weights are no longer reduced by the slashed weight factor.
+type MsgClearValidatorSlashed struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The chain id of source chain.
+	ChainID string `protobuf:"bytes,2,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	// The addresses of the validators.
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
-func (m *MsgRebalanceValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgClearValidatorSlashed) Reset()         { *m = MsgClearValidatorSlashed{} }
+func (m *MsgClearValidatorSlashed) String() string { return proto.CompactTextString(m) }
+func (*MsgClearValidatorSlashed) ProtoMessage()    {}
+func (*MsgClearValidatorSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{28}
+}
+func (m *MsgClearValidatorSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearValidatorSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearValidatorSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClearValidatorSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearValidatorSlashed.Merge(m, src)
+}
+func (m *MsgClearValidatorSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearValidatorSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearValidatorSlashed.DiscardUnknown(m)
 }
 
-func (m *RebalanceValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClearValidatorSlashed proto.InternalMessageInfo
+
+func (m *MsgClearValidatorSlashed) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RebalanceValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClearValidatorSlashed) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
 }
 
-func (m *RebalanceValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClearValidatorSlashed) GetValidators() []string {
+	if m != nil {
+		return m.Validators
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClearValidatorSlashedResponse defines the MsgClearValidatorSlashed response type.
+type MsgClearValidatorSlashedResponse struct {
 }
 
-func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgClearValidatorSlashedResponse) Reset()         { *m = MsgClearValidatorSlashedResponse{} }
+func (m *MsgClearValidatorSlashedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClearValidatorSlashedResponse) ProtoMessage()    {}
+func (*MsgClearValidatorSlashedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f805c8e926b8a0ec, []int{29}
+}
+func (m *MsgClearValidatorSlashedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClearValidatorSlashedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClearValidatorSlashedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClearValidatorSlashedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClearValidatorSlashedResponse.Merge(m, src)
+}
+func (m *MsgClearValidatorSlashedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClearValidatorSlashedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClearValidatorSlashedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClearValidatorSlashedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterSourceChain)(nil), "celinium.liquidstake.v1.MsgRegisterSourceChain")
+	proto.RegisterType((*MsgRegisterSourceChainResponse)(nil), "celinium.liquidstake.v1.MsgRegisterSourceChainResponse")
+	proto.RegisterType((*MsgEditVadlidators)(nil), "celinium.liquidstake.v1.MsgEditVadlidators")
+	proto.RegisterType((*MsgEditValidatorsResponse)(nil), "celinium.liquidstake.v1.MsgEditValidatorsResponse")
+	proto.RegisterType((*MsgRebalanceValidators)(nil), "celinium.liquidstake.v1.MsgRebalanceValidators")
+	proto.RegisterType((*RebalanceValidatorsResponse)(nil), "celinium.liquidstake.v1.RebalanceValidatorsResponse")
+	proto.RegisterType((*MsgDelegate)(nil), "celinium.liquidstake.v1.MsgDelegate")
+	proto.RegisterType((*MsgDelegateResponse)(nil), "celinium.liquidstake.v1.MsgDelegateResponse")
+	proto.RegisterType((*MsgUndelegate)(nil), "celinium.liquidstake.v1.MsgUndelegate")
+	proto.RegisterType((*MsgUndelegateResponse)(nil), "celinium.liquidstake.v1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgReinvest)(nil), "celinium.liquidstake.v1.MsgReinvest")
+	proto.RegisterType((*MsgReinvestResponse)(nil), "celinium.liquidstake.v1.MsgReinvestResponse")
+	proto.RegisterType((*MsgClaim)(nil), "celinium.liquidstake.v1.MsgClaim")
+	proto.RegisterType((*MsgClaimResponse)(nil), "celinium.liquidstake.v1.MsgClaimResponse")
+	proto.RegisterType((*MsgUpdateValidatorSelectionPolicy)(nil), "celinium.liquidstake.v1.MsgUpdateValidatorSelectionPolicy")
+	proto.RegisterType((*MsgUpdateValidatorSelectionPolicyResponse)(nil), "celinium.liquidstake.v1.MsgUpdateValidatorSelectionPolicyResponse")
+	proto.RegisterType((*MsgSubmitHostValidatorSnapshot)(nil), "celinium.liquidstake.v1.MsgSubmitHostValidatorSnapshot")
+	proto.RegisterType((*MsgSubmitHostValidatorSnapshotResponse)(nil), "celinium.liquidstake.v1.MsgSubmitHostValidatorSnapshotResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "celinium.liquidstake.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "celinium.liquidstake.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResumeSourceChain)(nil), "celinium.liquidstake.v1.MsgResumeSourceChain")
+	proto.RegisterType((*MsgResumeSourceChainResponse)(nil), "celinium.liquidstake.v1.MsgResumeSourceChainResponse")
+	proto.RegisterType((*MsgSetSourceChainStatus)(nil), "celinium.liquidstake.v1.MsgSetSourceChainStatus")
+	proto.RegisterType((*MsgSetSourceChainStatusResponse)(nil), "celinium.liquidstake.v1.MsgSetSourceChainStatusResponse")
+	proto.RegisterType((*MsgDeregisterSourceChain)(nil), "celinium.liquidstake.v1.MsgDeregisterSourceChain")
+	proto.RegisterType((*MsgDeregisterSourceChainResponse)(nil), "celinium.liquidstake.v1.MsgDeregisterSourceChainResponse")
+	proto.RegisterType((*MsgUpdateDerivativeDenomMetadata)(nil), "celinium.liquidstake.v1.MsgUpdateDerivativeDenomMetadata")
+	proto.RegisterType((*MsgUpdateDerivativeDenomMetadataResponse)(nil), "celinium.liquidstake.v1.MsgUpdateDerivativeDenomMetadataResponse")
+	proto.RegisterType((*MsgClearValidatorSlashed)(nil), "celinium.liquidstake.v1.MsgClearValidatorSlashed")
+	proto.RegisterType((*MsgClearValidatorSlashedResponse)(nil), "celinium.liquidstake.v1.MsgClearValidatorSlashedResponse")
+}
+
+func init() { proto.RegisterFile("celinium/liquidstake/v1/tx.proto", fileDescriptor_f805c8e926b8a0ec) }
+
+var fileDescriptor_f805c8e926b8a0ec = []byte{
+	// 1347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x8f, 0xd3, 0xc6,
+	0x17, 0x5f, 0x6f, 0xd8, 0xb0, 0xfb, 0x96, 0x1f, 0x5f, 0x86, 0x05, 0x12, 0x03, 0xde, 0x60, 0x21,
+	0x14, 0x60, 0x49, 0xbe, 0x09, 0x2d, 0x2d, 0x55, 0x2b, 0x44, 0x76, 0x2b, 0xb1, 0x48, 0x11, 0xab,
+	0x44, 0xa0, 0xaa, 0x87, 0xa2, 0x89, 0x3d, 0xeb, 0xb8, 0x38, 0x76, 0xf0, 0x4c, 0xa2, 0xdd, 0xf6,
+	0x50, 0xa9, 0x52, 0xa5, 0xaa, 0xea, 0xa1, 0xb7, 0x9e, 0x5a, 0xf5, 0x8f, 0xa0, 0x7f, 0x40, 0x2f,
+	0x15, 0x87, 0x1e, 0x10, 0xa7, 0xaa, 0x07, 0x54, 0xc1, 0xff, 0xd0, 0x73, 0xe5, 0xb1, 0x3d, 0xb6,
+	0xb3, 0xb6, 0x93, 0x5d, 0xb1, 0xea, 0xcd, 0x33, 0xf3, 0x79, 0xef, 0x7d, 0x3e, 0x6f, 0xde, 0xcc,
+	0x3c, 0x19, 0x2a, 0x1a, 0xb1, 0x4c, 0xdb, 0x1c, 0x0d, 0xea, 0x96, 0xf9, 0x74, 0x64, 0xea, 0x94,
+	0xe1, 0x27, 0xa4, 0x3e, 0x6e, 0xd4, 0xd9, 0x4e, 0x6d, 0xe8, 0x3a, 0xcc, 0x41, 0xe7, 0x42, 0x44,
+	0x2d, 0x86, 0xa8, 0x8d, 0x1b, 0xf2, 0x8a, 0xe1, 0x18, 0x0e, 0xc7, 0xd4, 0xbd, 0x2f, 0x1f, 0x2e,
+	0x97, 0x35, 0x87, 0x0e, 0x1c, 0xfa, 0xd8, 0x5f, 0xf0, 0x07, 0xc1, 0x92, 0xe2, 0x8f, 0xea, 0x3d,
+	0x4c, 0xbd, 0x10, 0x3d, 0xc2, 0x70, 0xa3, 0xae, 0x39, 0xa6, 0xbd, 0x67, 0xdd, 0x7e, 0x22, 0xd6,
+	0xbd, 0x41, 0xb0, 0x7e, 0x2d, 0x8b, 0x2b, 0x75, 0x46, 0xae, 0x46, 0x1e, 0x6b, 0x7d, 0x2c, 0x7c,
+	0x5d, 0xce, 0xc2, 0x0e, 0xb1, 0x8b, 0x07, 0x01, 0x23, 0xf5, 0xb7, 0x02, 0x9c, 0x6d, 0x53, 0xa3,
+	0x43, 0x0c, 0x93, 0x32, 0xe2, 0x76, 0xb9, 0x9f, 0x75, 0xcf, 0x0d, 0x2a, 0xc1, 0x51, 0xfe, 0xb1,
+	0xb9, 0x51, 0x92, 0x2a, 0x52, 0x75, 0xa9, 0x13, 0x0e, 0x91, 0x0a, 0xc7, 0x34, 0xc7, 0xb6, 0x89,
+	0xc6, 0x4c, 0xc7, 0x5b, 0x9e, 0xe7, 0xcb, 0x89, 0x39, 0xb4, 0x06, 0xa7, 0x98, 0x8b, 0xa9, 0xbd,
+	0x4d, 0xdc, 0xf5, 0x3e, 0xb6, 0x6d, 0x62, 0x6d, 0x6e, 0x94, 0x0a, 0x1c, 0xb8, 0x77, 0x01, 0x7d,
+	0x08, 0xe5, 0x1e, 0xd1, 0xfa, 0x37, 0x9b, 0x8f, 0xb0, 0x65, 0xea, 0x98, 0x39, 0xee, 0x5d, 0x5d,
+	0x77, 0xb7, 0x5c, 0xb2, 0x6d, 0xee, 0x94, 0x8e, 0x70, 0xab, 0x6c, 0x00, 0xba, 0x07, 0x30, 0x0e,
+	0xa7, 0x69, 0x69, 0xa1, 0x52, 0xa8, 0x2e, 0x37, 0xd5, 0x5a, 0xc6, 0xae, 0xd5, 0x84, 0x87, 0xd6,
+	0x91, 0xe7, 0xaf, 0x56, 0xe7, 0x3a, 0x31, 0x5b, 0x54, 0x81, 0x65, 0x1b, 0x33, 0x73, 0x4c, 0x36,
+	0x88, 0xed, 0x0c, 0x4a, 0x45, 0x1e, 0x39, 0x3e, 0x85, 0xaa, 0x70, 0x52, 0x27, 0xae, 0x39, 0x8e,
+	0xa1, 0x8e, 0x72, 0xd4, 0xe4, 0x34, 0x3a, 0x0b, 0x45, 0x0d, 0x5b, 0x16, 0x71, 0x4b, 0x8b, 0x1c,
+	0x10, 0x8c, 0xd0, 0x03, 0x38, 0x1d, 0x73, 0xd8, 0x26, 0x0c, 0xeb, 0x98, 0xe1, 0xd2, 0x52, 0x45,
+	0xaa, 0x2e, 0x37, 0x2f, 0xd6, 0x82, 0x82, 0xe1, 0xbb, 0x1e, 0x94, 0x40, 0x2d, 0x04, 0x75, 0xd2,
+	0x2c, 0xd5, 0x0a, 0x28, 0xe9, 0x5b, 0xd8, 0x21, 0x74, 0xe8, 0xd8, 0x94, 0xa8, 0xdf, 0x49, 0x80,
+	0xda, 0xd4, 0xf8, 0x58, 0x37, 0xd9, 0x23, 0xac, 0x0b, 0xb5, 0xd9, 0x3b, 0xdc, 0x4a, 0x64, 0x74,
+	0x7e, 0xd6, 0x8c, 0x26, 0x72, 0x19, 0xe9, 0x2f, 0xc4, 0xf5, 0xab, 0xe7, 0xa1, 0x2c, 0xb8, 0x84,
+	0x60, 0xc1, 0xf4, 0x7e, 0x50, 0x8e, 0x3d, 0x6c, 0x61, 0x5b, 0x23, 0x8f, 0xf0, 0x0c, 0x64, 0xa3,
+	0x40, 0xf3, 0x89, 0x40, 0x17, 0xe1, 0x7c, 0x8a, 0x23, 0x11, 0x6a, 0x07, 0x96, 0xdb, 0xd4, 0xd8,
+	0x20, 0x16, 0x31, 0x30, 0x23, 0x9e, 0x7f, 0x2d, 0xe9, 0x3f, 0x18, 0xa2, 0x06, 0x14, 0xf1, 0xc0,
+	0x19, 0xd9, 0xcc, 0xf7, 0xdf, 0x2a, 0x7b, 0x65, 0xf3, 0xd7, 0xab, 0xd5, 0xc2, 0xa6, 0xcd, 0x5e,
+	0x3e, 0xbb, 0x01, 0xc1, 0x8e, 0x6d, 0xda, 0xac, 0x13, 0x00, 0xd1, 0x05, 0x58, 0xd2, 0x7d, 0xc7,
+	0x4e, 0x28, 0x3f, 0x9a, 0x50, 0xcf, 0xc0, 0xe9, 0x58, 0x64, 0x41, 0xe8, 0x0b, 0x38, 0xde, 0xa6,
+	0xc6, 0x43, 0x5b, 0xff, 0x0f, 0x28, 0x9d, 0x83, 0x33, 0x89, 0xd8, 0x82, 0xd4, 0x73, 0x89, 0xa7,
+	0xa9, 0x43, 0x4c, 0x7b, 0x4c, 0x28, 0x8b, 0x38, 0xe9, 0x49, 0x4e, 0x3a, 0x5a, 0x81, 0x05, 0x32,
+	0x74, 0xb4, 0x3e, 0xa7, 0x74, 0xa4, 0xe3, 0x0f, 0x50, 0x1d, 0x16, 0xb6, 0x47, 0xb6, 0x4e, 0x79,
+	0xc8, 0xe5, 0x66, 0x39, 0xaa, 0x6f, 0x4a, 0x44, 0x7d, 0xaf, 0x3b, 0xa6, 0xdd, 0xf1, 0x71, 0x48,
+	0x01, 0xe0, 0x1f, 0x5b, 0xae, 0xe3, 0x6c, 0x07, 0x67, 0x3f, 0x36, 0xe3, 0x1d, 0xd1, 0xa1, 0xf7,
+	0x71, 0x8f, 0x98, 0x46, 0x9f, 0x95, 0x16, 0x78, 0xb0, 0xf8, 0x54, 0xac, 0x1e, 0x8a, 0x89, 0x7a,
+	0xf0, 0xd3, 0x1e, 0x2a, 0x11, 0x0a, 0x3f, 0x81, 0xc5, 0x36, 0x35, 0xd6, 0x2d, 0x6c, 0x0e, 0xa2,
+	0x22, 0xd3, 0x93, 0x45, 0xa6, 0x27, 0xd3, 0x37, 0x3f, 0x91, 0xbe, 0x48, 0x7b, 0x21, 0xa6, 0x5d,
+	0x45, 0xf0, 0xbf, 0xd0, 0xb3, 0x88, 0xf6, 0xbb, 0x04, 0x97, 0xbc, 0x4c, 0x0f, 0x75, 0xcc, 0xa2,
+	0xaa, 0xec, 0x12, 0xcb, 0xbf, 0x39, 0xb7, 0x1c, 0xcb, 0xd4, 0x76, 0xd1, 0x2d, 0x58, 0xc2, 0x23,
+	0xd6, 0x77, 0x5c, 0x93, 0xed, 0xfa, 0x4c, 0x5a, 0xa5, 0x97, 0xcf, 0x6e, 0xac, 0x04, 0xc9, 0xf3,
+	0xee, 0x3e, 0x42, 0x69, 0x97, 0xb9, 0xa6, 0x6d, 0x74, 0x22, 0x68, 0xbc, 0x62, 0xe6, 0x93, 0x15,
+	0xf3, 0x00, 0x8a, 0x43, 0xee, 0x3b, 0xd8, 0x88, 0xc6, 0xf4, 0xd3, 0x3c, 0x41, 0x2a, 0xb8, 0x2e,
+	0x03, 0x37, 0xea, 0x75, 0xb8, 0x3a, 0x55, 0x87, 0x50, 0xfd, 0x8f, 0xc4, 0xef, 0xa8, 0xee, 0xa8,
+	0x37, 0x30, 0xd9, 0x3d, 0x87, 0x46, 0x47, 0xbf, 0x6b, 0xe3, 0x21, 0xed, 0x3b, 0xcc, 0x93, 0x4c,
+	0xf9, 0x32, 0x23, 0xee, 0x74, 0xc9, 0x02, 0x8a, 0xb6, 0x60, 0x91, 0x06, 0x3e, 0xb8, 0xe6, 0xe5,
+	0x66, 0x2d, 0x53, 0x5a, 0x6a, 0xe4, 0x40, 0x97, 0xf0, 0x82, 0xee, 0x43, 0x91, 0x97, 0x93, 0x57,
+	0xb3, 0xde, 0xc5, 0xb7, 0x96, 0xeb, 0x2f, 0x38, 0xc6, 0x9e, 0x66, 0xcf, 0x48, 0x64, 0x89, 0x7b,
+	0x50, 0xab, 0x70, 0x25, 0x5f, 0xb7, 0x48, 0xd1, 0xb7, 0x12, 0x9c, 0x14, 0x09, 0xdd, 0xe2, 0x6f,
+	0xf4, 0x81, 0xcb, 0xe0, 0x23, 0x28, 0xfa, 0xaf, 0x7c, 0x90, 0x91, 0xd5, 0x4c, 0x05, 0x7e, 0x20,
+	0x41, 0x9a, 0x8f, 0xd4, 0x32, 0x9c, 0x9b, 0x60, 0x22, 0x58, 0xf6, 0x61, 0x85, 0x9f, 0x21, 0x3a,
+	0x1a, 0x90, 0x78, 0xb3, 0xf0, 0xd6, 0x0b, 0x56, 0x55, 0xe0, 0x42, 0x5a, 0x24, 0xc1, 0xe4, 0x67,
+	0x89, 0xb3, 0xec, 0x12, 0x16, 0x5b, 0xed, 0x32, 0xcc, 0x46, 0xf4, 0x10, 0x8e, 0x4f, 0x03, 0x8a,
+	0x94, 0xfb, 0xe6, 0xc7, 0xe7, 0xb8, 0xb8, 0x70, 0x4f, 0xed, 0x09, 0xde, 0x09, 0x80, 0xea, 0x25,
+	0x58, 0xcd, 0xe0, 0x27, 0x34, 0x58, 0x50, 0xe2, 0x0f, 0x81, 0x9b, 0xd2, 0x7e, 0xbd, 0xfd, 0x8c,
+	0xaa, 0x50, 0xc9, 0x8a, 0x26, 0x18, 0xfd, 0x2a, 0x41, 0x45, 0xec, 0xfd, 0x46, 0xb2, 0xa3, 0x09,
+	0x1b, 0x8e, 0x43, 0x48, 0xef, 0x1d, 0x58, 0x1c, 0x84, 0x8d, 0x50, 0x61, 0x86, 0x46, 0x28, 0x3c,
+	0xb3, 0xa1, 0x91, 0x7a, 0x0d, 0xaa, 0xd3, 0x68, 0x0b, 0x8d, 0xdf, 0x4b, 0x3c, 0xed, 0xeb, 0x16,
+	0xc1, 0x6e, 0x74, 0x1e, 0x2d, 0x4c, 0xfb, 0x44, 0x3f, 0x04, 0x6d, 0x4a, 0xa2, 0x97, 0xf2, 0xae,
+	0x94, 0xa5, 0x78, 0x9f, 0x14, 0x6c, 0x4b, 0x2a, 0x9b, 0x90, 0x72, 0xf3, 0x8f, 0x13, 0x50, 0x68,
+	0x53, 0x03, 0x7d, 0x05, 0xa7, 0xd3, 0x5a, 0xf5, 0x7a, 0xe6, 0xf9, 0x4e, 0x6f, 0x0c, 0xe5, 0xf7,
+	0xf6, 0x69, 0x10, 0x12, 0x41, 0x4f, 0xe1, 0x44, 0xb2, 0x73, 0x43, 0xd7, 0xf3, 0x5c, 0x4d, 0x74,
+	0x9c, 0x72, 0x73, 0x3a, 0x78, 0xb2, 0x4f, 0x43, 0x5f, 0x02, 0xda, 0xdb, 0xc6, 0x4d, 0x93, 0x3c,
+	0x89, 0xa7, 0xf2, 0x3b, 0x99, 0x06, 0x39, 0x4d, 0x22, 0xfa, 0x0c, 0x16, 0x45, 0x87, 0x78, 0x39,
+	0x2f, 0x64, 0x88, 0x92, 0xd7, 0x66, 0x41, 0x09, 0xff, 0x3a, 0x40, 0xac, 0xe1, 0xbb, 0x92, 0x67,
+	0x1b, 0xe1, 0xe4, 0xda, 0x6c, 0xb8, 0xb8, 0x0a, 0xd1, 0xc0, 0x5d, 0xce, 0x4f, 0x9c, 0x8f, 0x92,
+	0xd7, 0x66, 0x41, 0x09, 0xff, 0x0f, 0x61, 0xc1, 0xef, 0x9f, 0x2e, 0xe5, 0x99, 0x71, 0x88, 0x7c,
+	0x75, 0x2a, 0x44, 0xb8, 0xfd, 0x45, 0x02, 0x65, 0x4a, 0xa3, 0xf4, 0x41, 0x6e, 0x26, 0x72, 0x6d,
+	0xe5, 0xd6, 0xc1, 0x6d, 0x05, 0xc5, 0x1f, 0x25, 0x38, 0x9f, 0xd7, 0xd5, 0xe4, 0x1e, 0xb4, 0x1c,
+	0x43, 0xf9, 0xce, 0x01, 0x0d, 0x05, 0xb3, 0xcf, 0xe1, 0x58, 0xa2, 0x97, 0xa8, 0x4e, 0x57, 0xeb,
+	0x23, 0xe5, 0xff, 0xcf, 0x8a, 0x14, 0xb1, 0x76, 0xe1, 0xd4, 0xde, 0x96, 0xe0, 0x46, 0x7e, 0x09,
+	0x4d, 0xc0, 0xe5, 0x77, 0xf7, 0x05, 0x17, 0xa1, 0xbf, 0x96, 0x60, 0x25, 0xb5, 0x07, 0xc8, 0x55,
+	0x91, 0x66, 0x21, 0xbf, 0xbf, 0x5f, 0x0b, 0x41, 0xe2, 0x1b, 0x09, 0xce, 0xa4, 0xbf, 0xe2, 0x8d,
+	0xfc, 0xdb, 0x20, 0xc5, 0x44, 0xbe, 0xbd, 0x6f, 0x13, 0xc1, 0xe3, 0x27, 0x09, 0x2e, 0xe6, 0x3f,
+	0xdd, 0xb7, 0xa7, 0xef, 0x6d, 0x86, 0xa9, 0x7c, 0xf7, 0xc0, 0xa6, 0x89, 0x3c, 0xa5, 0x3f, 0xbb,
+	0x8d, 0xfc, 0x5b, 0x21, 0xc5, 0x44, 0xbe, 0xbd, 0x6f, 0x93, 0x90, 0x47, 0xeb, 0xd6, 0xf3, 0xd7,
+	0x8a, 0xf4, 0xe2, 0xb5, 0x22, 0xfd, 0xfd, 0x5a, 0x91, 0x7e, 0x78, 0xa3, 0xcc, 0xbd, 0x78, 0xa3,
+	0xcc, 0xfd, 0xf9, 0x46, 0x99, 0xfb, 0xf4, 0x82, 0xf8, 0x6b, 0xb6, 0x93, 0xf8, 0x6f, 0xc6, 0x76,
+	0x87, 0x84, 0xf6, 0x8a, 0xfc, 0xa7, 0xd9, 0xcd, 0x7f, 0x07, 0x00, 0x03, 0xaf, 0xe6, 0x7d, 0x34,
+	0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Register a new source chain for liquid stake. At least one correct Validator information must be provided
+	RegisterSourceChain(ctx context.Context, in *MsgRegisterSourceChain, opts ...grpc.CallOption) (*MsgRegisterSourceChainResponse, error)
+	// Edit the weight of validators for a source chain. Add this validators if they don't exist.
+	EditValidators(ctx context.Context, in *MsgEditVadlidators, opts ...grpc.CallOption) (*MsgEditValidatorsResponse, error)
+	// Rebalance the amount of delegation funds according to the weight of Validators.
+	// Note that this cannot be instant.
+	RebalanceValidator(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*RebalanceValidatorsResponse, error)
+	// Delegate define a method for performing a cross chain delegation of coins.
+	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
+	// Undelegate defines a method for performing an cross chain undelegation from dest chain to source chain.
+	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// Reinvest defines a method for reinvestment which use the reward form delegate.
+	Reinvest(ctx context.Context, in *MsgReinvest, opts ...grpc.CallOption) (*MsgReinvestResponse, error)
+	// Claim define a method for user claim the reward of delegation.
+	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	// UpdateValidatorSelectionPolicy defines a governance operation for updating the validator selection policy of a source chain.
+	UpdateValidatorSelectionPolicy(ctx context.Context, in *MsgUpdateValidatorSelectionPolicy, opts ...grpc.CallOption) (*MsgUpdateValidatorSelectionPolicyResponse, error)
+	// SubmitHostValidatorSnapshot defines a method for relayer to submit the validator snapshot of a source chain.
+	SubmitHostValidatorSnapshot(ctx context.Context, in *MsgSubmitHostValidatorSnapshot, opts ...grpc.CallOption) (*MsgSubmitHostValidatorSnapshotResponse, error)
+	// UpdateParams defines a governance operation for updating the liquidstake module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResumeSourceChain defines a governance operation for resuming a halted source chain.
+	ResumeSourceChain(ctx context.Context, in *MsgResumeSourceChain, opts ...grpc.CallOption) (*MsgResumeSourceChainResponse, error)
+	// SetSourceChainStatus defines a governance operation for pausing or activating a source chain.
+	SetSourceChainStatus(ctx context.Context, in *MsgSetSourceChainStatus, opts ...grpc.CallOption) (*MsgSetSourceChainStatusResponse, error)
+	// DeregisterSourceChain defines a governance operation for winding down and removing a source chain.
+	DeregisterSourceChain(ctx context.Context, in *MsgDeregisterSourceChain, opts ...grpc.CallOption) (*MsgDeregisterSourceChainResponse, error)
+	// UpdateDerivativeDenomMetadata defines a governance operation for updating the bank metadata of derivative token.
+	UpdateDerivativeDenomMetadata(ctx context.Context, in *MsgUpdateDerivativeDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDerivativeDenomMetadataResponse, error)
+	// ClearValidatorSlashed defines a governance operation for clearing the slashed flag of source chain validators.
+	ClearValidatorSlashed(ctx context.Context, in *MsgClearValidatorSlashed, opts ...grpc.CallOption) (*MsgClearValidatorSlashedResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterSourceChain(ctx context.Context, in *MsgRegisterSourceChain, opts ...grpc.CallOption) (*MsgRegisterSourceChainResponse, error) {
+	out := new(MsgRegisterSourceChainResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/RegisterSourceChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EditValidators(ctx context.Context, in *MsgEditVadlidators, opts ...grpc.CallOption) (*MsgEditValidatorsResponse, error) {
+	out := new(MsgEditValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/EditValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RebalanceValidator(ctx context.Context, in *MsgRebalanceValidators, opts ...grpc.CallOption) (*RebalanceValidatorsResponse, error) {
+	out := new(RebalanceValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/RebalanceValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error) {
+	out := new(MsgDelegateResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Delegate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Undelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) Reinvest(ctx context.Context, in *MsgReinvest, opts ...grpc.CallOption) (*MsgReinvestResponse, error) {
+	out := new(MsgReinvestResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Reinvest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
+	out := new(MsgClaimResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateValidatorSelectionPolicy(ctx context.Context, in *MsgUpdateValidatorSelectionPolicy, opts ...grpc.CallOption) (*MsgUpdateValidatorSelectionPolicyResponse, error) {
+	out := new(MsgUpdateValidatorSelectionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/UpdateValidatorSelectionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitHostValidatorSnapshot(ctx context.Context, in *MsgSubmitHostValidatorSnapshot, opts ...grpc.CallOption) (*MsgSubmitHostValidatorSnapshotResponse, error) {
+	out := new(MsgSubmitHostValidatorSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/SubmitHostValidatorSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ResumeSourceChain(ctx context.Context, in *MsgResumeSourceChain, opts ...grpc.CallOption) (*MsgResumeSourceChainResponse, error) {
+	out := new(MsgResumeSourceChainResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/ResumeSourceChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgReinvest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetSourceChainStatus(ctx context.Context, in *MsgSetSourceChainStatus, opts ...grpc.CallOption) (*MsgSetSourceChainStatusResponse, error) {
+	out := new(MsgSetSourceChainStatusResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/SetSourceChainStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgReinvest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeregisterSourceChain(ctx context.Context, in *MsgDeregisterSourceChain, opts ...grpc.CallOption) (*MsgDeregisterSourceChainResponse, error) {
+	out := new(MsgDeregisterSourceChainResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/DeregisterSourceChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgReinvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ProofHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProofHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.FundsProof) > 0 {
-		i -= len(m.FundsProof)
-		copy(dAtA[i:], m.FundsProof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FundsProof)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Funds != nil {
-		{
-			size, err := m.Funds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateDerivativeDenomMetadata(ctx context.Context, in *MsgUpdateDerivativeDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDerivativeDenomMetadataResponse, error) {
+	out := new(MsgUpdateDerivativeDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/UpdateDerivativeDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgReinvestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClearValidatorSlashed(ctx context.Context, in *MsgClearValidatorSlashed, opts ...grpc.CallOption) (*MsgClearValidatorSlashedResponse, error) {
+	out := new(MsgClearValidatorSlashedResponse)
+	err := c.cc.Invoke(ctx, "/celinium.liquidstake.v1.Msg/ClearValidatorSlashed", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgReinvestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Register a new source chain for liquid stake. At least one correct Validator information must be provided
+	RegisterSourceChain(context.Context, *MsgRegisterSourceChain) (*MsgRegisterSourceChainResponse, error)
+	// Edit the weight of validators for a source chain. Add this validators if they don't exist.
+	EditValidators(context.Context, *MsgEditVadlidators) (*MsgEditValidatorsResponse, error)
+	// Rebalance the amount of delegation funds according to the weight of Validators.
+	// Note that this cannot be instant.
+	RebalanceValidator(context.Context, *MsgRebalanceValidators) (*RebalanceValidatorsResponse, error)
+	// Delegate define a method for performing a cross chain delegation of coins.
+	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
+	// Undelegate defines a method for performing an cross chain undelegation from dest chain to source chain.
+	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// Reinvest defines a method for reinvestment which use the reward form delegate.
+	Reinvest(context.Context, *MsgReinvest) (*MsgReinvestResponse, error)
+	// Claim define a method for user claim the reward of delegation.
+	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+	// UpdateValidatorSelectionPolicy defines a governance operation for updating the validator selection policy of a source chain.
+	UpdateValidatorSelectionPolicy(context.Context, *MsgUpdateValidatorSelectionPolicy) (*MsgUpdateValidatorSelectionPolicyResponse, error)
+	// SubmitHostValidatorSnapshot defines a method for relayer to submit the validator snapshot of a source chain.
+	SubmitHostValidatorSnapshot(context.Context, *MsgSubmitHostValidatorSnapshot) (*MsgSubmitHostValidatorSnapshotResponse, error)
+	// UpdateParams defines a governance operation for updating the liquidstake module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResumeSourceChain defines a governance operation for resuming a halted source chain.
+	ResumeSourceChain(context.Context, *MsgResumeSourceChain) (*MsgResumeSourceChainResponse, error)
+	// SetSourceChainStatus defines a governance operation for pausing or activating a source chain.
+	SetSourceChainStatus(context.Context, *MsgSetSourceChainStatus) (*MsgSetSourceChainStatusResponse, error)
+	// DeregisterSourceChain defines a governance operation for winding down and removing a source chain.
+	DeregisterSourceChain(context.Context, *MsgDeregisterSourceChain) (*MsgDeregisterSourceChainResponse, error)
+	// UpdateDerivativeDenomMetadata defines a governance operation for updating the bank metadata of derivative token.
+	UpdateDerivativeDenomMetadata(context.Context, *MsgUpdateDerivativeDenomMetadata) (*MsgUpdateDerivativeDenomMetadataResponse, error)
+	// ClearValidatorSlashed defines a governance operation for clearing the slashed flag of source chain validators.
+	ClearValidatorSlashed(context.Context, *MsgClearValidatorSlashed) (*MsgClearValidatorSlashedResponse, error)
 }
 
-func (m *MsgReinvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) RegisterSourceChain(ctx context.Context, req *MsgRegisterSourceChain) (*MsgRegisterSourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSourceChain not implemented")
+}
+func (*UnimplementedMsgServer) EditValidators(ctx context.Context, req *MsgEditVadlidators) (*MsgEditValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditValidators not implemented")
+}
+func (*UnimplementedMsgServer) RebalanceValidator(ctx context.Context, req *MsgRebalanceValidators) (*RebalanceValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceValidator not implemented")
+}
+func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (*MsgDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
+}
+func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+}
+func (*UnimplementedMsgServer) Reinvest(ctx context.Context, req *MsgReinvest) (*MsgReinvestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reinvest not implemented")
+}
+func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (*UnimplementedMsgServer) UpdateValidatorSelectionPolicy(ctx context.Context, req *MsgUpdateValidatorSelectionPolicy) (*MsgUpdateValidatorSelectionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidatorSelectionPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SubmitHostValidatorSnapshot(ctx context.Context, req *MsgSubmitHostValidatorSnapshot) (*MsgSubmitHostValidatorSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitHostValidatorSnapshot not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ResumeSourceChain(ctx context.Context, req *MsgResumeSourceChain) (*MsgResumeSourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSourceChain not implemented")
+}
+func (*UnimplementedMsgServer) SetSourceChainStatus(ctx context.Context, req *MsgSetSourceChainStatus) (*MsgSetSourceChainStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSourceChainStatus not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterSourceChain(ctx context.Context, req *MsgDeregisterSourceChain) (*MsgDeregisterSourceChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterSourceChain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDerivativeDenomMetadata(ctx context.Context, req *MsgUpdateDerivativeDenomMetadata) (*MsgUpdateDerivativeDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDerivativeDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) ClearValidatorSlashed(ctx context.Context, req *MsgClearValidatorSlashed) (*MsgClearValidatorSlashedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearValidatorSlashed not implemented")
 }
 
-func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x18
+func _Msg_RegisterSourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSourceChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSourceChain(ctx, in)
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/RegisterSourceChain",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSourceChain(ctx, req.(*MsgRegisterSourceChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EditValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditVadlidators)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).EditValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/EditValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditValidators(ctx, req.(*MsgEditVadlidators))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_RebalanceValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceValidators)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgRegisterSourceChain) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceValidator(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/RebalanceValidator",
 	}
-	l = len(m.ConnectionID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceValidator(ctx, req.(*MsgRebalanceValidators))
 	}
-	l = len(m.TrasnferChannelID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Bech32ValidatorAddrPrefix)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Delegate(ctx, in)
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/Delegate",
 	}
-	l = len(m.NativeDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delegate(ctx, req.(*MsgDelegate))
 	}
-	l = len(m.DerivativeDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterSourceChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Undelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/Undelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgEditVadlidators) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Reinvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReinvest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).Reinvest(ctx, in)
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/Reinvest",
 	}
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Reinvest(ctx, req.(*MsgReinvest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgEditValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaim)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRebalanceValidators) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Claim(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/Claim",
 	}
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RebalanceValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateValidatorSelectionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateValidatorSelectionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDelegate) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateValidatorSelectionPolicy(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/UpdateValidatorSelectionPolicy",
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateValidatorSelectionPolicy(ctx, req.(*MsgUpdateValidatorSelectionPolicy))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitHostValidatorSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitHostValidatorSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitHostValidatorSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/SubmitHostValidatorSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitHostValidatorSnapshot(ctx, req.(*MsgSubmitHostValidatorSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/UpdateParams",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ResumeSourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeSourceChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeSourceChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/ResumeSourceChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeSourceChain(ctx, req.(*MsgResumeSourceChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReinvest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetSourceChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSourceChainStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetSourceChainStatus(ctx, in)
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovTx(uint64(m.Epoch))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/SetSourceChainStatus",
 	}
-	if m.Funds != nil {
-		l = m.Funds.Size()
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSourceChainStatus(ctx, req.(*MsgSetSourceChainStatus))
 	}
-	l = len(m.FundsProof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterSourceChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterSourceChain)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProofHeight != 0 {
-		n += 1 + sovTx(uint64(m.ProofHeight))
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterSourceChain(ctx, in)
 	}
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/DeregisterSourceChain",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterSourceChain(ctx, req.(*MsgDeregisterSourceChain))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReinvestResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateDerivativeDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDerivativeDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDerivativeDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/UpdateDerivativeDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDerivativeDenomMetadata(ctx, req.(*MsgUpdateDerivativeDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClearValidatorSlashed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearValidatorSlashed)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ClearValidatorSlashed(ctx, in)
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.liquidstake.v1.Msg/ClearValidatorSlashed",
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovTx(uint64(m.Epoch))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearValidatorSlashed(ctx, req.(*MsgClearValidatorSlashed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celinium.liquidstake.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterSourceChain",
+			Handler:    _Msg_RegisterSourceChain_Handler,
+		},
+		{
+			MethodName: "EditValidators",
+			Handler:    _Msg_EditValidators_Handler,
+		},
+		{
+			MethodName: "RebalanceValidator",
+			Handler:    _Msg_RebalanceValidator_Handler,
+		},
+		{
+			MethodName: "Delegate",
+			Handler:    _Msg_Delegate_Handler,
+		},
+		{
+			MethodName: "Undelegate",
+			Handler:    _Msg_Undelegate_Handler,
+		},
+		{
+			MethodName: "Reinvest",
+			Handler:    _Msg_Reinvest_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Msg_Claim_Handler,
+		},
+		{
+			MethodName: "UpdateValidatorSelectionPolicy",
+			Handler:    _Msg_UpdateValidatorSelectionPolicy_Handler,
+		},
+		{
+			MethodName: "SubmitHostValidatorSnapshot",
+			Handler:    _Msg_SubmitHostValidatorSnapshot_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ResumeSourceChain",
+			Handler:    _Msg_ResumeSourceChain_Handler,
+		},
+		{
+			MethodName: "SetSourceChainStatus",
+			Handler:    _Msg_SetSourceChainStatus_Handler,
+		},
+		{
+			MethodName: "DeregisterSourceChain",
+			Handler:    _Msg_DeregisterSourceChain_Handler,
+		},
+		{
+			MethodName: "UpdateDerivativeDenomMetadata",
+			Handler:    _Msg_UpdateDerivativeDenomMetadata_Handler,
+		},
+		{
+			MethodName: "ClearValidatorSlashed",
+			Handler:    _Msg_ClearValidatorSlashed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celinium/liquidstake/v1/tx.proto",
+}
+
+func (m *MsgRegisterSourceChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSourceChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NativeDenomMetadata != nil {
+		{
+			size, err := m.NativeDenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DerivativeDenom) > 0 {
+		i -= len(m.DerivativeDenom)
+		copy(dAtA[i:], m.DerivativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DerivativeDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Bech32ValidatorAddrPrefix) > 0 {
+		i -= len(m.Bech32ValidatorAddrPrefix)
+		copy(dAtA[i:], m.Bech32ValidatorAddrPrefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bech32ValidatorAddrPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TrasnferChannelID) > 0 {
+		i -= len(m.TrasnferChannelID)
+		copy(dAtA[i:], m.TrasnferChannelID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrasnferChannelID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionID) > 0 {
+		i -= len(m.ConnectionID)
+		copy(dAtA[i:], m.ConnectionID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditVadlidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditVadlidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditVadlidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceValidators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceValidators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RebalanceValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebalanceValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinvest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinvest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FundsProof) > 0 {
+		i -= len(m.FundsProof)
+		copy(dAtA[i:], m.FundsProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FundsProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Funds != nil {
+		{
+			size, err := m.Funds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinvestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinvestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorSelectionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSelectionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSelectionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateValidatorSelectionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateValidatorSelectionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateValidatorSelectionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitHostValidatorSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitHostValidatorSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitHostValidatorSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitHostValidatorSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitHostValidatorSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitHostValidatorSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeSourceChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeSourceChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeSourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeSourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeSourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeSourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSourceChainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSourceChainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSourceChainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSourceChainStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSourceChainStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSourceChainStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterSourceChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterSourceChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterSourceChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterSourceChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterSourceChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterSourceChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDerivativeDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDerivativeDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDerivativeDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDerivativeDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDerivativeDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDerivativeDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearValidatorSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearValidatorSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearValidatorSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearValidatorSlashedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearValidatorSlashedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearValidatorSlashedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterSourceChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrasnferChannelID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Bech32ValidatorAddrPrefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DerivativeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NativeDenomMetadata != nil {
+		l = m.NativeDenomMetadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterSourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditVadlidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRebalanceValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RebalanceValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReinvest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if m.Funds != nil {
+		l = m.Funds.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FundsProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.ProofHeight))
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReinvestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *MsgClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateValidatorSelectionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateValidatorSelectionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitHostValidatorSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Snapshot.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitHostValidatorSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeSourceChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeSourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSourceChainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgSetSourceChainStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterSourceChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterSourceChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDerivativeDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDerivativeDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClearValidatorSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClearValidatorSlashedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSourceChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrasnferChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrasnferChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32ValidatorAddrPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32ValidatorAddrPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NativeDenomMetadata == nil {
+				m.NativeDenomMetadata = &types.Metadata{}
+			}
+			if err := m.NativeDenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSourceChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSourceChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditVadlidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditVadlidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditVadlidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebalanceValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebalanceValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReinvest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReinvest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReinvest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Funds == nil {
+				m.Funds = &types1.Coin{}
+			}
+			if err := m.Funds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReinvestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReinvestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReinvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateValidatorSelectionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1986,15 +5514,15 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterSourceChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateValidatorSelectionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterSourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateValidatorSelectionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2022,11 +5550,11 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2054,13 +5582,13 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrasnferChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2070,93 +5598,128 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrasnferChannelID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32ValidatorAddrPrefix", wireType)
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateValidatorSelectionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bech32ValidatorAddrPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateValidatorSelectionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateValidatorSelectionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitHostValidatorSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitHostValidatorSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitHostValidatorSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2184,13 +5747,13 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2200,29 +5763,30 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivativeDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2232,23 +5796,25 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			m.Proofs = append(m.Proofs, HostDelegationProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2271,7 +5837,7 @@ func (m *MsgRegisterSourceChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterSourceChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitHostValidatorSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2294,10 +5860,10 @@ func (m *MsgRegisterSourceChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterSourceChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitHostValidatorSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterSourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitHostValidatorSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2321,7 +5887,7 @@ func (m *MsgRegisterSourceChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditVadlidators) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2344,49 +5910,17 @@ func (m *MsgEditVadlidators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditVadlidators: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditVadlidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2396,31 +5930,29 @@ func (m *MsgEditVadlidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2430,23 +5962,24 @@ func (m *MsgEditVadlidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2469,7 +6002,7 @@ func (m *MsgEditVadlidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2492,10 +6025,10 @@ func (m *MsgEditValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2519,7 +6052,7 @@ func (m *MsgEditValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeSourceChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2542,15 +6075,15 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRebalanceValidators: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeSourceChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRebalanceValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeSourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2578,11 +6111,11 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2610,7 +6143,7 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2633,7 +6166,7 @@ func (m *MsgRebalanceValidators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeSourceChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2656,10 +6189,10 @@ func (m *RebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RebalanceValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeSourceChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RebalanceValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeSourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2683,7 +6216,7 @@ func (m *RebalanceValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSourceChainStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,15 +6239,15 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSourceChainStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSourceChainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2742,11 +6275,11 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2774,15 +6307,13 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2792,24 +6323,11 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= SourceChainStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2831,7 +6349,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSourceChainStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2854,10 +6372,10 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSourceChainStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSourceChainStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2881,7 +6399,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterSourceChain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,15 +6422,15 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterSourceChain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterSourceChain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2940,45 +6458,11 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3006,7 +6490,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3029,7 +6513,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterSourceChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3052,10 +6536,10 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterSourceChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterSourceChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3079,7 +6563,7 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReinvest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDerivativeDenomMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3102,15 +6586,15 @@ func (m *MsgReinvest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReinvest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDerivativeDenomMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReinvest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDerivativeDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3138,66 +6622,11 @@ func (m *MsgReinvest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Funds == nil {
-				m.Funds = &types.Coin{}
-			}
-			if err := m.Funds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundsProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3225,32 +6654,13 @@ func (m *MsgReinvest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundsProof = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			m.ProofHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3260,23 +6670,24 @@ func (m *MsgReinvest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3299,7 +6710,7 @@ func (m *MsgReinvest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReinvestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateDerivativeDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3322,10 +6733,10 @@ func (m *MsgReinvestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReinvestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateDerivativeDenomMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReinvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateDerivativeDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3349,7 +6760,7 @@ func (m *MsgReinvestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgClearValidatorSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3372,15 +6783,15 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearValidatorSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearValidatorSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3408,11 +6819,11 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3440,13 +6851,13 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			m.Epoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3456,11 +6867,24 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3482,7 +6906,7 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClearValidatorSlashedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3505,10 +6929,10 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearValidatorSlashedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearValidatorSlashedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,217 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorWeightPrecision is the total weight distributed to the eligible validators
+// when the weights are computed by a ValidatorSelectionPolicy.
+const ValidatorWeightPrecision = uint64(100000000)
+
+// ValidatorSelectionStrategy define the strategy used to compute the weight of validators
+type ValidatorSelectionStrategy uint32
+
+const (
+	ValidatorSelectionManual ValidatorSelectionStrategy = iota
+	ValidatorSelectionEqual
+	ValidatorSelectionInverseVotingPower
+	ValidatorSelectionCommissionCapped
+)
+
+func (s ValidatorSelectionStrategy) String() string {
+	switch s {
+	case ValidatorSelectionManual:
+		return "manual"
+	case ValidatorSelectionEqual:
+		return "equal"
+	case ValidatorSelectionInverseVotingPower:
+		return "inverse_voting_power"
+	case ValidatorSelectionCommissionCapped:
+		return "commission_capped"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(s))
+	}
+}
+
+// Validate verify the parameters of ValidatorSelectionPolicy
+func (p ValidatorSelectionPolicy) Validate() error {
+	if p.Strategy > ValidatorSelectionCommissionCapped {
+		return fmt.Errorf("unknown validator selection strategy: %d", p.Strategy)
+	}
+
+	decs := map[string]sdk.Dec{
+		"max commission":         p.MaxCommission,
+		"min uptime":             p.MinUptime,
+		"max voting power ratio": p.MaxVotingPowerRatio,
+		"slashed weight factor":  p.SlashedWeightFactor,
+	}
+	for name, d := range decs {
+		if d.IsNil() {
+			continue
+		}
+		if d.IsNegative() || d.GT(sdk.OneDec()) {
+			return fmt.Errorf("%s must be in [0, 1], get: %s", name, d)
+		}
+	}
+
+	for _, submitter := range p.SnapshotSubmitters {
+		if _, err := sdk.AccAddressFromBech32(submitter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsSnapshotSubmitter return true if the address is allowed to submit snapshot.
+func (p ValidatorSelectionPolicy) IsSnapshotSubmitter(address string) bool {
+	for _, s := range p.SnapshotSubmitters {
+		if s == address {
+			return true
+		}
+	}
+	return false
+}
+
+// SlashedWeight return the weight of slashed validator which is reduced by the SlashedWeightFactor.
+func (p ValidatorSelectionPolicy) SlashedWeight(weight uint64) uint64 {
+	if p.SlashedWeightFactor.IsNil() {
+		return weight
+	}
+
+	return sdk.NewDecFromInt(math.NewIntFromUint64(weight)).Mul(p.SlashedWeightFactor).TruncateInt().Uint64()
+}
+
+// Validate verify the HostValidatorSnapshot
+func (s HostValidatorSnapshot) Validate(bech32ValidatorAddrPrefix string) error {
+	if len(s.Validators) == 0 {
+		return fmt.Errorf("empty validator snapshot")
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range s.Validators {
+		if !verifyValidatorAddress(v.Address, bech32ValidatorAddrPrefix) {
+			return fmt.Errorf("invalid validator address of souce chain, Address: %s", v.Address)
+		}
+		if seen[v.Address] {
+			return fmt.Errorf("duplicate validator in snapshot, Address: %s", v.Address)
+		}
+		seen[v.Address] = true
+
+		if v.Commission.IsNil() || v.Commission.IsNegative() || v.Commission.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid commission of validator %s", v.Address)
+		}
+		if v.Uptime.IsNil() || v.Uptime.IsNegative() || v.Uptime.GT(sdk.OneDec()) {
+			return fmt.Errorf("invalid uptime of validator %s", v.Address)
+		}
+		if v.VotingPower.IsNil() || v.VotingPower.IsNegative() {
+			return fmt.Errorf("invalid voting power of validator %s", v.Address)
+		}
+		if !v.DelegationAmount.IsNil() && v.DelegationAmount.IsNegative() {
+			return fmt.Errorf("invalid delegation amount of validator %s", v.Address)
+		}
+	}
+
+	return nil
+}
+
+// EligibleValidators return the validators in snapshot which satisfy the policy.
+func (p ValidatorSelectionPolicy) EligibleValidators(snapshot HostValidatorSnapshot) []HostValidator {
+	totalPower := math.ZeroInt()
+	for _, v := range snapshot.Validators {
+		totalPower = totalPower.Add(v.VotingPower)
+	}
+
+	var eligible []HostValidator
+	for _, v := range snapshot.Validators {
+		if v.Jailed || !v.VotingPower.IsPositive() {
+			continue
+		}
+		if !p.MaxCommission.IsNil() && p.MaxCommission.IsPositive() && v.Commission.GT(p.MaxCommission) {
+			continue
+		}
+		if !p.MinUptime.IsNil() && p.MinUptime.IsPositive() && v.Uptime.LT(p.MinUptime) {
+			continue
+		}
+		if !p.MaxVotingPowerRatio.IsNil() && p.MaxVotingPowerRatio.IsPositive() &&
+			sdk.NewDecFromInt(v.VotingPower).Quo(sdk.NewDecFromInt(totalPower)).GT(p.MaxVotingPowerRatio) {
+			continue
+		}
+		eligible = append(eligible, v)
+	}
+
+	return eligible
+}
+
+// ComputeWeights compute the weight of validators in snapshot according the strategy.
+// The sum of the weights is ValidatorWeightPrecision. Validators are not eligible will not be returned.
+func (p ValidatorSelectionPolicy) ComputeWeights(snapshot HostValidatorSnapshot) map[string]uint64 {
+	eligible := p.EligibleValidators(snapshot)
+	if len(eligible) == 0 {
+		return nil
+	}
+
+	scores := make([]sdk.Dec, len(eligible))
+	totalScore := sdk.ZeroDec()
+	for i, v := range eligible {
+		switch p.Strategy {
+		case ValidatorSelectionInverseVotingPower:
+			scores[i] = sdk.OneDec().Quo(sdk.NewDecFromInt(v.VotingPower))
+		case ValidatorSelectionCommissionCapped:
+			scores[i] = sdk.OneDec().Sub(v.Commission)
+		default:
+			scores[i] = sdk.OneDec()
+		}
+		totalScore = totalScore.Add(scores[i])
+	}
+
+	weights := make(map[string]uint64)
+	if !totalScore.IsPositive() {
+		return weights
+	}
+
+	precision := sdk.NewDecFromInt(math.NewIntFromUint64(ValidatorWeightPrecision))
+	for i, v := range eligible {
+		w := scores[i].Mul(precision).Quo(totalScore).TruncateInt().Uint64()
+		if w == 0 {
+			continue
+		}
+		weights[v.Address] = w
+	}
+
+	return weights
+}
+
+// ApplyWeights set the weight of validators. The validators which are not in `weights` will get
+// zero weight but keep in the list, because they may still have delegated tokens. The weight of
+// slashed validators is still reduced by the SlashedWeightFactor.
+func (s *SourceChain) ApplyWeights(weights map[string]uint64) {
+	exist := make(map[string]bool)
+	for i, v := range s.Validators {
+		exist[v.Address] = true
+		s.Validators[i].Weight = weights[v.Address]
+		if v.Slashed {
+			s.Validators[i].Weight = s.SelectionPolicy.SlashedWeight(s.Validators[i].Weight)
+		}
+	}
+
+	var newAddrs []string
+	for addr := range weights {
+		if !exist[addr] {
+			newAddrs = append(newAddrs, addr)
+		}
+	}
+	sort.Strings(newAddrs)
+
+	for _, addr := range newAddrs {
+		s.Validators = append(s.Validators, Validator{
+			Address:     addr,
+			TokenAmount: math.ZeroInt(),
+			Weight:      weights[addr],
+		})
+	}
+}
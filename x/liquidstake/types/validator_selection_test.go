@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celinium-network/celinium/x/liquidstake/types"
+)
+
+func mockHostValidator(address string, commission, uptime sdk.Dec, power math.Int, jailed bool) types.HostValidator {
+	return types.HostValidator{
+		Address:     address,
+		Commission:  commission,
+		Uptime:      uptime,
+		VotingPower: power,
+		Jailed:      jailed,
+	}
+}
+
+func mockHostValidatorSnapshot() types.HostValidatorSnapshot {
+	return types.HostValidatorSnapshot{
+		ChainID: "source",
+		Height:  100,
+		Validators: []types.HostValidator{
+			mockHostValidator("validator1", sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(99, 2), math.NewInt(1000), false),
+			mockHostValidator("validator2", sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(99, 2), math.NewInt(3000), false),
+			mockHostValidator("validator3", sdk.NewDecWithPrec(30, 2), sdk.NewDecWithPrec(99, 2), math.NewInt(2000), false),
+			mockHostValidator("validator4", sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(50, 2), math.NewInt(2000), false),
+			mockHostValidator("validator5", sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(99, 2), math.NewInt(2000), true),
+		},
+	}
+}
+
+func TestComputeWeights(t *testing.T) {
+	snapshot := mockHostValidatorSnapshot()
+
+	policy := types.ValidatorSelectionPolicy{
+		Strategy:  types.ValidatorSelectionEqual,
+		MinUptime: sdk.NewDecWithPrec(90, 2),
+	}
+	weights := policy.ComputeWeights(snapshot)
+	require.Len(t, weights, 3)
+	require.Equal(t, weights["validator1"], weights["validator2"])
+	require.Equal(t, weights["validator1"], weights["validator3"])
+
+	policy.Strategy = types.ValidatorSelectionInverseVotingPower
+	weights = policy.ComputeWeights(snapshot)
+	require.Len(t, weights, 3)
+	require.Equal(t, weights["validator1"]/3, weights["validator2"])
+	require.Equal(t, weights["validator1"]/2, weights["validator3"])
+
+	policy.Strategy = types.ValidatorSelectionCommissionCapped
+	policy.MaxCommission = sdk.NewDecWithPrec(20, 2)
+	weights = policy.ComputeWeights(snapshot)
+	require.Len(t, weights, 2)
+	require.Greater(t, weights["validator1"], weights["validator2"])
+
+	policy.MaxVotingPowerRatio = sdk.NewDecWithPrec(20, 2)
+	weights = policy.ComputeWeights(snapshot)
+	require.Len(t, weights, 1)
+	require.Equal(t, types.ValidatorWeightPrecision, weights["validator1"])
+}
+
+func TestApplyWeights(t *testing.T) {
+	sourceChain := types.SourceChain{
+		Validators: []types.Validator{
+			{"validator1", math.NewInt(100), 1000, false},
+			{"validator2", math.NewInt(100), 1000, false},
+		},
+	}
+
+	sourceChain.ApplyWeights(map[string]uint64{"validator2": 5000, "validator3": 3000})
+	require.Len(t, sourceChain.Validators, 3)
+	require.Equal(t, uint64(0), sourceChain.Validators[0].Weight)
+	require.Equal(t, uint64(5000), sourceChain.Validators[1].Weight)
+	require.Equal(t, uint64(3000), sourceChain.Validators[2].Weight)
+	require.True(t, sourceChain.Validators[2].TokenAmount.IsZero())
+
+	allocated := sourceChain.AllocateTokenForValidator(math.NewInt(8000))
+	require.Len(t, allocated.Validators, 2)
+	require.Equal(t, math.NewInt(5000), allocated.Validators[0].TokenAmount)
+	require.Equal(t, math.NewInt(3000), allocated.Validators[1].TokenAmount)
+}
+
+func TestApplyWeightsToSlashedValidator(t *testing.T) {
+	sourceChain := types.SourceChain{
+		Validators: []types.Validator{
+			{"validator1", math.NewInt(100), 1000, true},
+			{"validator2", math.NewInt(100), 1000, false},
+		},
+		SelectionPolicy: types.ValidatorSelectionPolicy{SlashedWeightFactor: sdk.NewDecWithPrec(5, 1)},
+	}
+
+	sourceChain.ApplyWeights(map[string]uint64{"validator1": 5000, "validator2": 5000})
+	require.Equal(t, uint64(2500), sourceChain.Validators[0].Weight)
+	require.Equal(t, uint64(5000), sourceChain.Validators[1].Weight)
+
+	// the weight which is greater than MaxInt64 is not overflowed.
+	require.Equal(t, uint64(1<<63-1), sourceChain.SelectionPolicy.SlashedWeight(1<<64-1))
+}
@@ -7,6 +7,8 @@ import (
 )
 
 func (k Keeper) EndBlocker(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	k.ProcessDenomRemovals(ctx)
 	k.ProcessCompletedUnbonding(ctx)
+	k.ProcessCompletedRedelegation(ctx)
 	return nil, nil
 }
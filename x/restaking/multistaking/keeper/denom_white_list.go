@@ -1 +1,428 @@
 package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+// maxForceUnbondAttempts is the number of passes a position is tried before it's skipped by the denom removal.
+const maxForceUnbondAttempts = 3
+
+// AddMultiStakingDenom adds the denom into white list with its caps by the authority. The caps are updated
+// if the denom is already in white list, and the removal of the denom is canceled if it's in grace period.
+func (k Keeper) AddMultiStakingDenom(ctx sdk.Context, msg *types.MsgAddMultiStakingDenom) error {
+	if k.authority != msg.Authority {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if msg.Deonm == k.stakingkeeper.BondDenom(ctx) {
+		return sdkerrors.Wrapf(types.ErrForbidStakingDenom, "denom: %s is native token", msg.Deonm)
+	}
+
+	config := types.NewMultiStakingDenomConfig(msg.Deonm, msg.MaxTotalAmount, msg.MaxValidatorPowerShare, msg.MinDelegation)
+	if err := config.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDenomConfig, err.Error())
+	}
+
+	k.SetMultiStakingDenom(ctx, msg.Deonm)
+	k.SetMultiStakingDenomConfig(ctx, config)
+	k.DeleteMultiStakingDenomRemoval(ctx, msg.Deonm)
+	k.clearForceUnbondFailures(ctx, msg.Deonm)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Deonm),
+		),
+	)
+
+	return nil
+}
+
+// RemoveMultiStakingDenom removes the denom from white list by the authority. The denom can't be delegated
+// anymore, and the existing positions are force unbonded after the grace period.
+func (k Keeper) RemoveMultiStakingDenom(ctx sdk.Context, msg *types.MsgRemoveMultiStakingDenom) error {
+	if k.authority != msg.Authority {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if !k.removeMultiStakingDenomFromWhiteList(ctx, msg.Denom) {
+		return sdkerrors.Wrapf(types.ErrForbidStakingDenom, "denom: %s not in white list", msg.Denom)
+	}
+
+	removal := types.MultiStakingDenomRemoval{
+		Denom:      msg.Denom,
+		UnbondTime: ctx.BlockTime().Add(k.GetParams(ctx).DenomRemovalGracePeriod),
+	}
+	k.SetMultiStakingDenomRemoval(ctx, &removal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyUnbondTime, removal.UnbondTime.String()),
+		),
+	)
+
+	return nil
+}
+
+// ProcessDenomRemovals force unbonds the positions of the removed denoms whose grace period is ended. At most
+// MaxDenomRemovalUnbonds positions are processed in a block, the removal is kept until all positions are unwound.
+func (k Keeper) ProcessDenomRemovals(ctx sdk.Context) {
+	budget := k.GetParams(ctx).MaxDenomRemovalUnbonds
+
+	for _, removal := range k.GetAllMultiStakingDenomRemoval(ctx) {
+		if budget == 0 {
+			return
+		}
+
+		if ctx.BlockTime().Before(removal.UnbondTime) {
+			continue
+		}
+
+		removal := removal
+		processed, done := k.forceUnbondDenom(ctx, &removal, budget)
+		budget -= processed
+
+		if !done {
+			k.SetMultiStakingDenomRemoval(ctx, &removal)
+			continue
+		}
+
+		k.DeleteMultiStakingDenomRemoval(ctx, removal.Denom)
+		k.DeleteMultiStakingDenomConfig(ctx, removal.Denom)
+		skipped := k.clearForceUnbondFailures(ctx, removal.Denom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeForceUnbondDenom,
+				sdk.NewAttribute(types.AttributeKeyDenom, removal.Denom),
+				sdk.NewAttribute(types.AttributeKeySkipped, strings.Join(skipped, ",")),
+			),
+		)
+	}
+}
+
+// forceUnbondDenom unbonds at most limit positions of denom from the cursor of removal, the unbonding entries
+// are created as usual so the slash still applies to them. It returns the number of processed positions and
+// whether all positions of denom are unwound. The failed positions are retried in the next pass, and skipped
+// after maxForceUnbondAttempts failures, so a position which can't be unbonded doesn't block the removal.
+func (k Keeper) forceUnbondDenom(ctx sdk.Context, removal *types.MultiStakingDenomRemoval, limit uint32) (uint32, bool) {
+	positions := k.getDenomPositions(ctx, removal.Denom, removal.NextKey, int(limit)+1)
+
+	removal.NextKey = nil
+	if len(positions) > int(limit) {
+		removal.NextKey = positions[limit].key
+		positions = positions[:limit]
+	}
+
+	for _, position := range positions {
+		k.forceUnbondPosition(ctx, removal.Denom, position)
+	}
+
+	if removal.NextKey != nil {
+		return uint32(len(positions)), false
+	}
+
+	return uint32(len(positions)), len(k.getDenomPositions(ctx, removal.Denom, nil, 1)) == 0
+}
+
+func (k Keeper) forceUnbondPosition(ctx sdk.Context, denom string, position denomPosition) {
+	agent, found := k.GetMultiStakingAgentByID(ctx, position.agentID)
+	if !found {
+		return
+	}
+
+	amount := agent.StakedAmount
+	if !position.shares.Equal(agent.Shares) {
+		amount = agent.CalculateCoins(position.shares)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	failureKey := types.GetMultiStakingDenomRemovalFailureKey(denom, agent.Id, position.delegator)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	unbonding := k.GetOrCreateMultiStakingUnbonding(cacheCtx, agent.Id, position.delegator)
+	if err := k.unbond(cacheCtx, agent, unbonding, sdk.NewCoin(denom, amount), position.shares); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("force unbond failed, agentID %d, delegator %s, error: %s", agent.Id, position.delegator, err))
+		store.Set(failureKey, sdk.Uint64ToBigEndian(k.getForceUnbondAttempts(ctx, failureKey)+1))
+		return
+	}
+	writeCache()
+
+	store.Delete(failureKey)
+}
+
+func (k Keeper) getForceUnbondAttempts(ctx sdk.Context, failureKey []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(failureKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// clearForceUnbondFailures deletes the failure records of denom, it returns the skipped positions
+// as `agentID/delegator`.
+func (k Keeper) clearForceUnbondFailures(ctx sdk.Context, denom string) []string {
+	store := ctx.KVStore(k.storeKey)
+	failurePrefix := types.GetMultiStakingDenomRemovalFailurePrefix(denom)
+	iterator := sdk.KVStorePrefixIterator(store, failurePrefix)
+
+	var (
+		keys    [][]byte
+		skipped []string
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		if sdk.BigEndianToUint64(iterator.Value()) < maxForceUnbondAttempts {
+			continue
+		}
+
+		// skip the length prefix of delegator
+		agentID := sdk.BigEndianToUint64(iterator.Key()[len(failurePrefix) : len(failurePrefix)+8])
+		delegator := string(iterator.Key()[len(failurePrefix)+9:])
+		skipped = append(skipped, fmt.Sprintf("%d/%s", agentID, delegator))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return skipped
+}
+
+type denomPosition struct {
+	key       []byte
+	agentID   uint64
+	delegator string
+	shares    math.Int
+}
+
+// getDenomPositions returns at most limit non-zero shares of the agents of denom in the order of the shares key,
+// starting from the key start. The positions which failed maxForceUnbondAttempts times are skipped.
+func (k Keeper) getDenomPositions(ctx sdk.Context, denom string, start []byte, limit int) []denomPosition {
+	store := ctx.KVStore(k.storeKey)
+
+	positions := []denomPosition{}
+	for _, agentID := range k.getAgentIDsByDenom(ctx, denom) {
+		agentPrefix := types.GetMultiStakingSharesAgentPrefix(agentID)
+		end := sdk.PrefixEndBytes(agentPrefix)
+		if bytes.Compare(start, end) >= 0 {
+			continue
+		}
+
+		from := agentPrefix
+		if bytes.Compare(start, agentPrefix) > 0 {
+			from = start
+		}
+
+		iterator := store.Iterator(from, end)
+		for ; iterator.Valid() && len(positions) < limit; iterator.Next() {
+			shares := math.ZeroInt()
+			if err := shares.Unmarshal(iterator.Value()); err != nil || shares.IsZero() {
+				continue
+			}
+
+			// skip the length prefix of delegator
+			delegator := string(iterator.Key()[len(agentPrefix)+1:])
+			failureKey := types.GetMultiStakingDenomRemovalFailureKey(denom, agentID, delegator)
+			if k.getForceUnbondAttempts(ctx, failureKey) >= maxForceUnbondAttempts {
+				continue
+			}
+
+			positions = append(positions, denomPosition{
+				key:       iterator.Key(),
+				agentID:   agentID,
+				delegator: delegator,
+				shares:    shares,
+			})
+		}
+		iterator.Close()
+
+		if len(positions) >= limit {
+			break
+		}
+	}
+
+	return positions
+}
+
+// getAgentIDsByDenom returns the IDs of the agents of denom in ascending order.
+func (k Keeper) getAgentIDsByDenom(ctx sdk.Context, denom string) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetMultiStakingAgentIDsByDenomPrefix(denom))
+	defer iterator.Close()
+
+	ids := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Value()))
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// checkDenomCaps checks the delegation of amount to the agent against the caps of denom.
+func (k Keeper) checkDenomCaps(ctx sdk.Context, agent *types.MultiStakingAgent, amount sdk.Coin) error {
+	config := k.GetMultiStakingDenomConfig(ctx, amount.Denom)
+
+	if config.MinDelegation.IsPositive() && amount.Amount.LT(config.MinDelegation) {
+		return sdkerrors.Wrapf(types.ErrBelowMinDelegation, "min delegation %s, got %s", config.MinDelegation, amount.Amount)
+	}
+
+	if config.MaxTotalAmount.IsPositive() {
+		total := k.GetMultiStakingDenomTotal(ctx, amount.Denom).Add(amount.Amount)
+
+		if total.GT(config.MaxTotalAmount) {
+			return sdkerrors.Wrapf(types.ErrExceedDenomCap, "max total amount %s, got %s", config.MaxTotalAmount, total)
+		}
+	}
+
+	return k.checkDenomValidatorCap(ctx, agent, amount)
+}
+
+// checkDenomValidatorCap checks the restaked share of the agent's validator after the delegation of amount
+// against the max validator power share of denom.
+func (k Keeper) checkDenomValidatorCap(ctx sdk.Context, agent *types.MultiStakingAgent, amount sdk.Coin) error {
+	config := k.GetMultiStakingDenomConfig(ctx, amount.Denom)
+	if !config.MaxValidatorPowerShare.IsPositive() {
+		return nil
+	}
+
+	validator, err := k.agentValidator(ctx, agent)
+	if err != nil {
+		return err
+	}
+
+	restakedAmount, err := k.GetExpectedDelegationAmount(ctx, sdk.NewCoin(amount.Denom, agent.StakedAmount.Add(amount.Amount)))
+	if err != nil {
+		return err
+	}
+
+	delegateAmount, err := k.GetExpectedDelegationAmount(ctx, amount)
+	if err != nil {
+		return err
+	}
+
+	share := sdk.NewDecFromInt(restakedAmount.Amount).QuoInt(validator.Tokens.Add(delegateAmount.Amount))
+	if share.GT(config.MaxValidatorPowerShare) {
+		return sdkerrors.Wrapf(types.ErrExceedDenomCap, "max validator power share %s, got %s", config.MaxValidatorPowerShare, share)
+	}
+
+	return nil
+}
+
+// GetMultiStakingDenomConfig returns the config of denom, there is no cap if it's not set.
+func (k Keeper) GetMultiStakingDenomConfig(ctx sdk.Context, denom string) types.MultiStakingDenomConfig {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMultiStakingDenomConfigKey(denom))
+	if bz == nil {
+		return types.NewMultiStakingDenomConfig(denom, math.ZeroInt(), sdk.ZeroDec(), math.ZeroInt())
+	}
+
+	config := types.MultiStakingDenomConfig{}
+	k.cdc.MustUnmarshal(bz, &config)
+	return config
+}
+
+// GetMultiStakingDenomTotal returns the total staked amount of the agents of denom.
+func (k Keeper) GetMultiStakingDenomTotal(ctx sdk.Context, denom string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMultiStakingDenomTotalKey(denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	total := math.ZeroInt()
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+func (k Keeper) setMultiStakingDenomTotal(ctx sdk.Context, denom string, total math.Int) {
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMultiStakingDenomTotalKey(denom), bz)
+}
+
+func (k Keeper) SetMultiStakingDenomConfig(ctx sdk.Context, config types.MultiStakingDenomConfig) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMultiStakingDenomConfigKey(config.Denom), k.cdc.MustMarshal(&config))
+}
+
+func (k Keeper) DeleteMultiStakingDenomConfig(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMultiStakingDenomConfigKey(denom))
+}
+
+func (k Keeper) GetMultiStakingDenomRemoval(ctx sdk.Context, denom string) (types.MultiStakingDenomRemoval, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMultiStakingDenomRemovalKey(denom))
+	if bz == nil {
+		return types.MultiStakingDenomRemoval{}, false
+	}
+
+	removal := types.MultiStakingDenomRemoval{}
+	k.cdc.MustUnmarshal(bz, &removal)
+	return removal, true
+}
+
+func (k Keeper) GetAllMultiStakingDenomRemoval(ctx sdk.Context) []types.MultiStakingDenomRemoval {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MultiStakingDenomRemovalPrefix)
+	defer iterator.Close()
+
+	removals := []types.MultiStakingDenomRemoval{}
+	for ; iterator.Valid(); iterator.Next() {
+		removal := types.MultiStakingDenomRemoval{}
+		k.cdc.MustUnmarshal(iterator.Value(), &removal)
+		removals = append(removals, removal)
+	}
+
+	return removals
+}
+
+func (k Keeper) SetMultiStakingDenomRemoval(ctx sdk.Context, removal *types.MultiStakingDenomRemoval) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMultiStakingDenomRemovalKey(removal.Denom), k.cdc.MustMarshal(removal))
+}
+
+func (k Keeper) DeleteMultiStakingDenomRemoval(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMultiStakingDenomRemovalKey(denom))
+}
+
+func (k Keeper) removeMultiStakingDenomFromWhiteList(ctx sdk.Context, denom string) bool {
+	whiteList, found := k.GetMultiStakingDenomWhiteList(ctx)
+	if !found {
+		return false
+	}
+
+	for i, existedDenom := range whiteList.DenomList {
+		if existedDenom == denom {
+			whiteList.DenomList = append(whiteList.DenomList[:i], whiteList.DenomList[i+1:]...)
+
+			store := ctx.KVStore(k.storeKey)
+			store.Set(types.MultiStakingDenomWhiteListKey, k.cdc.MustMarshal(whiteList))
+			return true
+		}
+	}
+
+	return false
+}
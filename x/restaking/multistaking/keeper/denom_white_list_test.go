@@ -0,0 +1,238 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/keeper"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) TestDenomWhiteListCaps() {
+	delegatorAddrs, _ := createValAddrs(1)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	authority := suite.app.MultiStakingKeeper.GetAuthority()
+
+	msg := types.MsgAddMultiStakingDenom{
+		Authority:      delegatorAddrs[0].String(),
+		Deonm:          mockMultiRestakingDenom,
+		MaxTotalAmount: sdk.NewInt(1000),
+		MinDelegation:  sdk.NewInt(100),
+	}
+	err := suite.app.MultiStakingKeeper.AddMultiStakingDenom(suite.ctx, &msg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	msg.Authority = authority
+	msg.Deonm = suite.app.StakingKeeper.BondDenom(suite.ctx)
+	err = suite.app.MultiStakingKeeper.AddMultiStakingDenom(suite.ctx, &msg)
+	suite.Require().ErrorIs(err, types.ErrForbidStakingDenom)
+
+	msg.Deonm = mockMultiRestakingDenom
+	msgServer := keeper.NewMsgServerImpl(suite.app.MultiStakingKeeper)
+	_, err = msgServer.AddMultiStakingDenom(sdk.WrapSDKContext(suite.ctx), &msg)
+	suite.Require().NoError(err)
+
+	suite.mintCoin(sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000)), delegatorAddrs[0])
+	delegate := func(amount int64) error {
+		return suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+			DelegatorAddress: delegatorAddrs[0].String(),
+			ValidatorAddress: validator.OperatorAddress,
+			Amount:           sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(amount)),
+		})
+	}
+
+	suite.Require().ErrorIs(delegate(50), types.ErrBelowMinDelegation)
+	suite.Require().NoError(delegate(600))
+	suite.Require().ErrorIs(delegate(600), types.ErrExceedDenomCap)
+
+	// the restaked share of validator can't exceed the cap.
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, validator.GetOperator())
+	msg.MaxValidatorPowerShare = sdk.NewDec(700).QuoInt(validator.Tokens)
+	err = suite.app.MultiStakingKeeper.AddMultiStakingDenom(suite.ctx, &msg)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(delegate(200), types.ErrExceedDenomCap)
+
+	// remove the denom, the positions are force unbonded after the grace period.
+	err = suite.app.MultiStakingKeeper.RemoveMultiStakingDenom(suite.ctx, &types.MsgRemoveMultiStakingDenom{
+		Authority: delegatorAddrs[0].String(),
+		Denom:     mockMultiRestakingDenom,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	err = suite.app.MultiStakingKeeper.RemoveMultiStakingDenom(suite.ctx, &types.MsgRemoveMultiStakingDenom{
+		Authority: authority,
+		Denom:     mockMultiRestakingDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(delegate(100), types.ErrForbidStakingDenom)
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	suite.app.MultiStakingKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(600), suite.app.MultiStakingKeeper.GetMultiStakingShares(suite.ctx, agentID, delegatorAddrs[0].String()))
+
+	gracePeriod := suite.app.MultiStakingKeeper.GetParams(suite.ctx).DenomRemovalGracePeriod
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(gracePeriod))
+	suite.app.MultiStakingKeeper.EndBlocker(suite.ctx)
+
+	suite.Require().True(suite.app.MultiStakingKeeper.GetMultiStakingShares(suite.ctx, agentID, delegatorAddrs[0].String()).IsZero())
+	agent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	suite.Require().True(agent.StakedAmount.IsZero())
+
+	unbonding, found := suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegatorAddrs[0].String())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(600), unbonding.Entries[0].Balance.Amount)
+
+	_, found = suite.app.MultiStakingKeeper.GetMultiStakingDenomRemoval(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().False(found)
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestDenomRemovalInBatches() {
+	delegatorAddrs, _ := createValAddrs(3)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+
+	params := suite.app.MultiStakingKeeper.GetParams(suite.ctx)
+	params.MaxDenomRemovalUnbonds = 1
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+
+	suite.delegateForDenomRemoval(delegatorAddrs, validator.OperatorAddress)
+	suite.Require().Equal(sdk.NewInt(3000), suite.app.MultiStakingKeeper.GetMultiStakingDenomTotal(suite.ctx, mockMultiRestakingDenom))
+
+	suite.removeDenomAfterGracePeriod()
+
+	// one position is force unbonded in a block, the removal is kept until all positions are unwound.
+	for i := 1; i <= len(delegatorAddrs); i++ {
+		_, found := suite.app.MultiStakingKeeper.GetMultiStakingDenomRemoval(suite.ctx, mockMultiRestakingDenom)
+		suite.Require().True(found)
+
+		suite.app.MultiStakingKeeper.EndBlocker(suite.ctx)
+		total := suite.app.MultiStakingKeeper.GetMultiStakingDenomTotal(suite.ctx, mockMultiRestakingDenom)
+		suite.Require().Equal(sdk.NewInt(int64(1000*(len(delegatorAddrs)-i))), total)
+	}
+
+	_, found := suite.app.MultiStakingKeeper.GetMultiStakingDenomRemoval(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().False(found)
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestDenomRemovalRetryFailedPositions() {
+	delegatorAddrs, _ := createValAddrs(2)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+
+	suite.delegateForDenomRemoval(delegatorAddrs, validator.OperatorAddress)
+	suite.removeDenomAfterGracePeriod()
+
+	// half of the agent's delegation is removed, so only one position can be unbonded.
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	agent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	agentAddr := sdk.MustAccAddressFromBech32(agent.DelegateAddress)
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, agentAddr, validator.GetOperator())
+	suite.Require().True(found)
+	removed, err := suite.app.StakingKeeper.Unbond(suite.ctx, agentAddr, validator.GetOperator(), delegation.Shares.QuoInt64(2))
+	suite.Require().NoError(err)
+
+	suite.app.MultiStakingKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(1000), suite.app.MultiStakingKeeper.GetMultiStakingDenomTotal(suite.ctx, mockMultiRestakingDenom))
+	_, found = suite.app.MultiStakingKeeper.GetMultiStakingDenomRemoval(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().True(found)
+
+	// the failed position is retried in the next pass.
+	bondCoin := sdk.NewCoin(suite.app.StakingKeeper.BondDenom(suite.ctx), removed)
+	suite.mintCoin(bondCoin, agentAddr)
+	validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, validator.GetOperator())
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, agentAddr, removed, stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	suite.app.MultiStakingKeeper.EndBlocker(suite.ctx)
+	suite.Require().True(suite.app.MultiStakingKeeper.GetMultiStakingDenomTotal(suite.ctx, mockMultiRestakingDenom).IsZero())
+	_, found = suite.app.MultiStakingKeeper.GetMultiStakingDenomRemoval(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) delegateForDenomRemoval(delegators []sdk.AccAddress, validator string) {
+	for _, delegator := range delegators {
+		coin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(1000))
+		suite.mintCoin(coin, delegator)
+
+		err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validator,
+			Amount:           coin,
+		})
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) removeDenomAfterGracePeriod() {
+	err := suite.app.MultiStakingKeeper.RemoveMultiStakingDenom(suite.ctx, &types.MsgRemoveMultiStakingDenom{
+		Authority: suite.app.MultiStakingKeeper.GetAuthority(),
+		Denom:     mockMultiRestakingDenom,
+	})
+	suite.Require().NoError(err)
+
+	gracePeriod := suite.app.MultiStakingKeeper.GetParams(suite.ctx).DenomRemovalGracePeriod
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(gracePeriod))
+}
+
+func (suite *KeeperTestSuite) TestDenomRemovalSkipFailedPositions() {
+	delegatorAddrs, _ := createValAddrs(2)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+
+	suite.delegateForDenomRemoval(delegatorAddrs, validator.OperatorAddress)
+	suite.removeDenomAfterGracePeriod()
+
+	// half of the agent's delegation is removed, so one of the positions always fails.
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	agent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	agentAddr := sdk.MustAccAddressFromBech32(agent.DelegateAddress)
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, agentAddr, validator.GetOperator())
+	suite.Require().True(found)
+	_, err := suite.app.StakingKeeper.Unbond(suite.ctx, agentAddr, validator.GetOperator(), delegation.Shares.QuoInt64(2))
+	suite.Require().NoError(err)
+
+	for i := 0; i < 2; i++ {
+		suite.app.MultiStakingKeeper.EndBlocker(suite.ctx)
+		_, found = suite.app.MultiStakingKeeper.GetMultiStakingDenomRemoval(suite.ctx, mockMultiRestakingDenom)
+		suite.Require().True(found)
+	}
+
+	// the position is skipped after the max attempts, and the removal is done.
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.app.MultiStakingKeeper.EndBlocker(suite.ctx)
+	_, found = suite.app.MultiStakingKeeper.GetMultiStakingDenomRemoval(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().False(found)
+	var failed sdk.AccAddress
+	for _, delegator := range delegatorAddrs {
+		if suite.app.MultiStakingKeeper.GetMultiStakingShares(suite.ctx, agentID, delegator.String()).Equal(sdk.NewInt(1000)) {
+			failed = delegator
+		}
+	}
+	suite.Require().NotNil(failed)
+
+	skipped := ""
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeForceUnbondDenom {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeySkipped {
+				skipped = string(attr.Value)
+			}
+		}
+	}
+	suite.Require().Equal(fmt.Sprintf("%d/%s", agentID, failed.String()), skipped)
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.GetMultiStakingDenomRemovalFailurePrefix(mockMultiRestakingDenom))
+	suite.Require().False(iterator.Valid())
+	iterator.Close()
+}
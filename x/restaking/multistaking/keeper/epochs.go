@@ -1,21 +1,45 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	epochstypes "github.com/celinium-network/celinium/x/epochs/types"
 	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
 )
 
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) {
-}
+var (
+	_ epochstypes.EpochSubscriber = Hooks{}
+	_ epochstypes.EpochsRequirer  = Hooks{}
+)
 
-// BeforeEpochStart implements types.EpochHooks
-func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	switch epochIdentifier {
-	case types.RefreshAgentDelegationEpochID:
-		h.k.RefreshAgentDelegationAmount(ctx)
-	case types.CollectAgentStakingRewardEpochID:
+// EpochSubscriptions implements epochstypes.EpochSubscriber
+func (h Hooks) EpochSubscriptions() []epochstypes.EpochSubscription {
+	return []epochstypes.EpochSubscription{
+		{Identifier: types.RefreshAgentDelegationEpochID, BeforeEpochStart: h.beforeRefreshAgentDelegationEpochStart},
 		// TODO remove it from epoch ?
-		h.k.CollectAgentsReward(ctx)
+		{Identifier: types.CollectAgentStakingRewardEpochID, BeforeEpochStart: h.beforeCollectAgentStakingRewardEpochStart},
+	}
+}
+
+func (h Hooks) beforeRefreshAgentDelegationEpochStart(ctx sdk.Context, _ int64) {
+	h.k.RefreshAgentDelegationAmount(ctx)
+}
+
+func (h Hooks) beforeCollectAgentStakingRewardEpochStart(ctx sdk.Context, _ int64) {
+	h.k.CollectAgentsReward(ctx)
+}
+
+// ModuleName implements epochstypes.EpochSubscriber
+func (Hooks) ModuleName() string {
+	return types.ModuleName
+}
+
+// RequiredEpochs implements epochstypes.EpochsRequirer
+func (Hooks) RequiredEpochs() []epochstypes.RequiredEpoch {
+	return []epochstypes.RequiredEpoch{
+		{Identifier: types.RefreshAgentDelegationEpochID, DefaultDuration: time.Hour},
+		{Identifier: types.CollectAgentStakingRewardEpochID, DefaultDuration: time.Hour * 2},
 	}
 }
@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genState := types.DefaultGenesisState()
+	genState.Params.MaxUnbondingEntries = 3
+	genState.Params.MaxRestakedPowerShare = sdk.NewDecWithPrec(3, 1)
+	genState.Params.SlashRecipient = types.SlashRecipientCommunityPool
+	suite.Require().NoError(genState.Validate())
+
+	multistaking.InitGenesis(suite.ctx, suite.app.MultiStakingKeeper, *genState)
+	suite.Require().Equal(genState, multistaking.ExportGenesis(suite.ctx, suite.app.MultiStakingKeeper))
+
+	genState.Params.MaxUnbondingEntries = 0
+	suite.Require().Error(genState.Validate())
+	suite.Require().Panics(func() {
+		multistaking.InitGenesis(suite.ctx, suite.app.MultiStakingKeeper, *genState)
+	})
+}
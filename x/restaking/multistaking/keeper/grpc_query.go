@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// ValidatorRestakedPower implements types.QueryServer
+func (k Querier) ValidatorRestakedPower(goCtx context.Context, req *types.QueryValidatorRestakedPowerRequest) (*types.QueryValidatorRestakedPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address %s", req.ValidatorAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, found := k.stakingkeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	}
+
+	return &types.QueryValidatorRestakedPowerResponse{
+		Power: k.GetValidatorRestakedPower(ctx, validator),
+	}, nil
+}
+
+// ValidatorsRestakedPower implements types.QueryServer
+func (k Querier) ValidatorsRestakedPower(goCtx context.Context, req *types.QueryValidatorsRestakedPowerRequest) (*types.QueryValidatorsRestakedPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var powers []types.ValidatorRestakedPower
+	for _, validator := range k.stakingkeeper.GetAllValidators(ctx) {
+		powers = append(powers, k.GetValidatorRestakedPower(ctx, validator))
+	}
+
+	return &types.QueryValidatorsRestakedPowerResponse{Powers: powers}, nil
+}
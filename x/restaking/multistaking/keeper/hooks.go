@@ -1,14 +1,10 @@
 package keeper
 
 import (
-	epochstypes "github.com/celinium-network/celinium/x/epochs/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-var _ epochstypes.EpochHooks = Hooks{}
-
 var _ stakingtypes.StakingHooks = Hooks{}
 
 type Hooks struct {
@@ -25,12 +21,16 @@ func (Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, va
 }
 
 // AfterValidatorBeginUnbonding implements types.StakingHooks
-func (Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.addBondedRestakedTotal(ctx, h.k.getValidatorDelegatedAmount(ctx, valAddr).Neg())
+
 	return nil
 }
 
 // AfterValidatorBonded implements types.StakingHooks
-func (Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.addBondedRestakedTotal(ctx, h.k.getValidatorDelegatedAmount(ctx, valAddr))
+
 	return nil
 }
 
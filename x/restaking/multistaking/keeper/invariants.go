@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+// RegisterInvariants registers all multistaking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "agent-shares", AgentSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "agent-delegation", AgentDelegationInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "agent-balance", AgentBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unbonding-queue", UnbondingQueueInvariant(k))
+}
+
+// AllInvariants runs all invariants of the multistaking module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			AgentSharesInvariant(k),
+			AgentDelegationInvariant(k),
+			AgentBalanceInvariant(k),
+			UnbondingQueueInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// AgentSharesInvariant checks that the shares of all delegators sum to the shares of each agent.
+func AgentSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		sharesSum := make(map[uint64]math.Int)
+
+		store := ctx.KVStore(k.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, types.MultiStakingSharesPrefix)
+		defer iterator.Close()
+
+		prefixLen := len(types.MultiStakingSharesPrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			agentID := sdk.BigEndianToUint64(iterator.Key()[prefixLen : prefixLen+8])
+
+			shares := math.ZeroInt()
+			if err := shares.Unmarshal(iterator.Value()); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tagent %d delegator shares can't be unmarshaled: %s\n", agentID, err)
+				continue
+			}
+
+			if exist, found := sharesSum[agentID]; found {
+				shares = shares.Add(exist)
+			}
+			sharesSum[agentID] = shares
+		}
+
+		for _, agent := range k.GetAllAgent(ctx) {
+			sum, found := sharesSum[agent.Id]
+			if !found {
+				sum = math.ZeroInt()
+			}
+
+			if !agent.Shares.Equal(sum) {
+				broken = true
+				msg += fmt.Sprintf("\tagent %d shares %s, sum of delegator shares %s\n", agent.Id, agent.Shares, sum)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "agent shares",
+			fmt.Sprintf("agent shares not equal the sum of delegator shares:\n%s", msg)), broken
+	}
+}
+
+// AgentDelegationInvariant checks that the delegation of each agent in x/staking matches the recorded
+// delegated amount of the agent, only the rounding of delegation shares in x/staking is allowed.
+func AgentDelegationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, agent := range k.GetAllAgent(ctx) {
+			valAddr, err := sdk.ValAddressFromBech32(agent.ValidatorAddress)
+			if err != nil {
+				continue
+			}
+
+			currentAmount := math.ZeroInt()
+			validator, found := k.stakingkeeper.GetValidator(ctx, valAddr)
+			if found {
+				delegation, found := k.stakingkeeper.GetDelegation(ctx, sdk.MustAccAddressFromBech32(agent.DelegateAddress), valAddr)
+				if found {
+					currentAmount = validator.TokensFromShares(delegation.Shares).TruncateInt()
+				}
+			}
+
+			recorded := agent.DelegatedAmount
+			if recorded.IsNil() {
+				recorded = math.ZeroInt()
+			}
+
+			if currentAmount.Sub(recorded).Abs().GT(math.OneInt()) {
+				broken = true
+				msg += fmt.Sprintf("\tagent %d delegation %s, recorded %s\n", agent.Id, currentAmount, recorded)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "agent delegation",
+			fmt.Sprintf("agent delegation not match the recorded amount:\n%s", msg)), broken
+	}
+}
+
+// AgentBalanceInvariant checks that the deposited balance of each agent covers its staked amount
+// and the balance of the pending unbondings.
+func AgentBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		unbondingAmts := make(map[uint64]math.Int)
+
+		store := ctx.KVStore(k.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, types.MultiStakingUnbondingPrefix)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			ubd := types.MultiStakingUnbonding{}
+			k.cdc.MustUnmarshal(iterator.Value(), &ubd)
+
+			amt, found := unbondingAmts[ubd.AgentId]
+			if !found {
+				amt = math.ZeroInt()
+			}
+			for _, entry := range ubd.Entries {
+				amt = amt.Add(entry.Balance.Amount)
+			}
+			unbondingAmts[ubd.AgentId] = amt
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, agent := range k.GetAllAgent(ctx) {
+			required := agent.StakedAmount
+			if amt, found := unbondingAmts[agent.Id]; found {
+				required = required.Add(amt)
+			}
+
+			balance := k.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(agent.DelegateAddress), agent.StakeDenom)
+			if balance.Amount.LT(required) {
+				broken = true
+				msg += fmt.Sprintf("\tagent %d balance %s, staked and unbonding %s\n", agent.Id, balance.Amount, required)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "agent balance",
+			fmt.Sprintf("agent balance less than staked and unbonding amount:\n%s", msg)), broken
+	}
+}
+
+// UnbondingQueueInvariant checks that every entry of the stored unbondings has a pair in the
+// unbonding queue at its completion time.
+func UnbondingQueueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, types.MultiStakingUnbondingPrefix)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			ubd := types.MultiStakingUnbonding{}
+			k.cdc.MustUnmarshal(iterator.Value(), &ubd)
+
+			for _, entry := range ubd.Entries {
+				inQueue := false
+				for _, pair := range k.GetUBDQueueTimeSlice(ctx, entry.CompletionTime) {
+					if pair.AgentId == ubd.AgentId && pair.DelegatorAddress == ubd.DelegatorAddress {
+						inQueue = true
+						break
+					}
+				}
+
+				if !inQueue {
+					broken = true
+					msg += fmt.Sprintf("\tagent %d delegator %s completion time %s\n",
+						ubd.AgentId, ubd.DelegatorAddress, entry.CompletionTime)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "unbonding queue",
+			fmt.Sprintf("unbonding entry not in the queue:\n%s", msg)), broken
+	}
+}
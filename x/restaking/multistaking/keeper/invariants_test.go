@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/keeper"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	delegatorAddrs, _ := createValAddrs(2)
+	validators := suite.app.StakingKeeper.GetAllValidators(suite.ctx)
+
+	multiRestakingCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000000))
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+
+	for _, delegator := range delegatorAddrs {
+		suite.mintCoin(multiRestakingCoin, delegator)
+		err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validators[0].OperatorAddress,
+			Amount:           multiRestakingCoin,
+		})
+		suite.Require().NoError(err)
+	}
+
+	err := suite.app.MultiStakingKeeper.MultiStakingUndelegate(suite.ctx, &types.MsgMultiStakingUndelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           sdk.NewCoin(mockMultiRestakingDenom, multiRestakingCoin.Amount.QuoRaw(2)),
+	})
+	suite.Require().NoError(err)
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+
+	// the unbonding is not in the queue.
+	unbonding, found := suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegatorAddrs[0].String())
+	suite.Require().True(found)
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.app.MultiStakingKeeper.SetUBDQueueTimeSlice(cacheCtx, unbonding.Entries[0].CompletionTime, []types.DAPair{})
+	_, broken = keeper.UnbondingQueueInvariant(suite.app.MultiStakingKeeper)(cacheCtx)
+	suite.Require().True(broken)
+
+	// shares of delegators are more than the agent.
+	cacheCtx, _ = suite.ctx.CacheContext()
+	suite.app.MultiStakingKeeper.IncreaseMultiStakingShares(cacheCtx, sdk.NewInt(1), agentID, delegatorAddrs[1].String())
+	_, broken = keeper.AgentSharesInvariant(suite.app.MultiStakingKeeper)(cacheCtx)
+	suite.Require().True(broken)
+
+	// the shares of delegator can't be unmarshaled.
+	cacheCtx, _ = suite.ctx.CacheContext()
+	cacheCtx.KVStore(suite.app.GetKey(types.StoreKey)).Set(
+		types.GetMultiStakingSharesKey(agentID, delegatorAddrs[1].String()), []byte{0xff})
+	_, broken = keeper.AgentSharesInvariant(suite.app.MultiStakingKeeper)(cacheCtx)
+	suite.Require().True(broken)
+
+	// staked amount of agent is more than the balance, the delegation is not affected.
+	cacheCtx, _ = suite.ctx.CacheContext()
+	agent, found := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(cacheCtx, agentID)
+	suite.Require().True(found)
+	agent.StakedAmount = agent.StakedAmount.Add(multiRestakingCoin.Amount)
+	suite.app.MultiStakingKeeper.SetMultiStakingAgent(cacheCtx, agent)
+	_, broken = keeper.AgentDelegationInvariant(suite.app.MultiStakingKeeper)(cacheCtx)
+	suite.Require().False(broken)
+	_, broken = keeper.AgentBalanceInvariant(suite.app.MultiStakingKeeper)(cacheCtx)
+	suite.Require().True(broken)
+
+	// the recorded delegated amount is more than the delegation.
+	cacheCtx, _ = suite.ctx.CacheContext()
+	agent, _ = suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(cacheCtx, agentID)
+	agent.DelegatedAmount = agent.DelegatedAmount.AddRaw(2)
+	suite.app.MultiStakingKeeper.SetMultiStakingAgent(cacheCtx, agent)
+	_, broken = keeper.AgentDelegationInvariant(suite.app.MultiStakingKeeper)(cacheCtx)
+	suite.Require().True(broken)
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"fmt"
 	"strings"
 	"time"
 
@@ -27,6 +26,9 @@ type Keeper struct {
 	stakingkeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
 
+	// the address which is able to execute the governance messages, it's the gov module account.
+	authority string
+
 	EquivalentCoinCalculator CalculateEquivalentCoin
 }
 
@@ -38,6 +40,7 @@ func NewKeeper(
 	epochKeeper types.EpochKeeper,
 	stakingKeeper types.StakingKeeper,
 	distributionKeeper types.DistributionKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		storeKey:                 storeKey,
@@ -47,6 +50,7 @@ func NewKeeper(
 		epochKeeper:              epochKeeper,
 		stakingkeeper:            stakingKeeper,
 		distributionKeeper:       distributionKeeper,
+		authority:                authority,
 		EquivalentCoinCalculator: defaultCalculateEquivalentCoin,
 	}
 }
@@ -58,6 +62,11 @@ func defaultCalculateEquivalentCoin(ctx sdk.Context, coin sdk.Coin, targetDenom
 	return sdk.NewCoin(targetDenom, coin.Amount), nil
 }
 
+// GetAuthority returns the authority of multistaking module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -130,14 +139,26 @@ func (k Keeper) GetMultiStakingAgent(ctx sdk.Context, denom string, valAddr stri
 	return k.GetMultiStakingAgentByID(ctx, agentID)
 }
 
+// SetMultiStakingAgent stores the agent and keeps the total staked amount of its denom and the bonded restaked
+// tokens in sync.
 func (k Keeper) SetMultiStakingAgent(ctx sdk.Context, agent *types.MultiStakingAgent) {
+	total := k.GetMultiStakingDenomTotal(ctx, agent.StakeDenom).Add(agent.StakedAmount)
+	delegated := agentDelegatedAmount(agent)
+	if prev, found := k.GetMultiStakingAgentByID(ctx, agent.Id); found {
+		total = total.Sub(prev.StakedAmount)
+		delegated = delegated.Sub(agentDelegatedAmount(prev))
+	}
+	k.setMultiStakingDenomTotal(ctx, agent.StakeDenom, total)
+	if !delegated.IsZero() && k.agentValidatorBonded(ctx, agent) {
+		k.addBondedRestakedTotal(ctx, delegated)
+	}
+
 	bz := k.cdc.MustMarshal(agent)
 	store := ctx.KVStore(k.storeKey)
 
 	store.Set(types.GetMultiStakingAgentKey(agent.Id), bz)
 
 	k.SetMultiStakingAgentIDByDenomAndVal(ctx, agent.Id, agent.StakeDenom, agent.ValidatorAddress)
-	k.SetLatestMultiStakingAgentID(ctx, agent.Id)
 }
 
 func (k Keeper) GetMultiStakingAgentIDByDenomAndVal(ctx sdk.Context, denom string, valAddr string) (uint64, bool) {
@@ -215,6 +236,39 @@ func (k Keeper) RemoveMultiStakingUnbonding(ctx sdk.Context, agentID uint64, del
 	store.Delete(types.GetMultiStakingUnbondingKey(agentID, delegatorAddr))
 }
 
+func (k Keeper) GetMultiStakingUnbondingsByAgent(ctx sdk.Context, agentID uint64) []types.MultiStakingUnbonding {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetMultiStakingUnbondingAgentPrefix(agentID))
+	defer iterator.Close()
+
+	unbondings := []types.MultiStakingUnbonding{}
+	for ; iterator.Valid(); iterator.Next() {
+		unbonding := types.MultiStakingUnbonding{}
+		k.cdc.MustUnmarshal(iterator.Value(), &unbonding)
+		unbondings = append(unbondings, unbonding)
+	}
+
+	return unbondings
+}
+
+func (k Keeper) GetMultiStakingSlashRecord(ctx sdk.Context, denom string) (types.MultiStakingSlashRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMultiStakingSlashRecordKey(denom))
+	if bz == nil {
+		return types.MultiStakingSlashRecord{}, false
+	}
+
+	record := types.MultiStakingSlashRecord{}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+func (k Keeper) SetMultiStakingSlashRecord(ctx sdk.Context, record *types.MultiStakingSlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(record)
+	store.Set(types.GetMultiStakingSlashRecordKey(record.Denom), bz)
+}
+
 func (k Keeper) GetMultiStakingShares(ctx sdk.Context, agentID uint64, delegator string) math.Int {
 	amount := math.ZeroInt()
 	store := ctx.KVStore(k.storeKey)
@@ -309,9 +363,36 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd *types.MultiStakingUnbonding
 	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
 	if len(timeSlice) == 0 {
 		k.SetUBDQueueTimeSlice(ctx, completionTime, []types.DAPair{daPair})
+		return
+	}
+
+	// all the mature entries of the pair are completed together, so the pair is only needed once.
+	for _, pair := range timeSlice {
+		if pair.AgentId == daPair.AgentId && pair.DelegatorAddress == daPair.DelegatorAddress {
+			return
+		}
+	}
+
+	timeSlice = append(timeSlice, daPair)
+	k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+}
+
+// RemoveFromUBDQueue removes the pair of unbonding from the time slice of completionTime.
+func (k Keeper) RemoveFromUBDQueue(ctx sdk.Context, ubd *types.MultiStakingUnbonding, completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+
+	pairs := make([]types.DAPair, 0, len(timeSlice))
+	for _, pair := range timeSlice {
+		if pair.AgentId == ubd.AgentId && pair.DelegatorAddress == ubd.DelegatorAddress {
+			continue
+		}
+		pairs = append(pairs, pair)
+	}
+
+	if len(pairs) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetMultiStakingUnbondingDelegationTimeKey(completionTime))
 	} else {
-		timeSlice = append(timeSlice, daPair)
-		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+		k.SetUBDQueueTimeSlice(ctx, completionTime, pairs)
 	}
 }
 
@@ -346,7 +427,6 @@ func (k Keeper) GetAllAgent(ctx sdk.Context) []types.MultiStakingAgent {
 	for ; iterator.Valid(); iterator.Next() {
 		agent := types.MultiStakingAgent{}
 
-		fmt.Println(iterator.Value())
 		err := proto.Unmarshal(iterator.Value(), &agent)
 		if err != nil {
 			panic(err)
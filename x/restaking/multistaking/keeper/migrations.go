@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2:
+//   - the total staked amount of each denom is backfilled from the agents.
+//   - the total bond tokens delegated by the agents to the bonded validators is backfilled.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.migrateDenomTotals(ctx)
+	m.migrateBondedRestakedTotal(ctx)
+
+	return nil
+}
+
+func (m Migrator) migrateDenomTotals(ctx sdk.Context) {
+	var denoms []string
+	totals := make(map[string]math.Int)
+	for _, agent := range m.keeper.GetAllAgent(ctx) {
+		total, found := totals[agent.StakeDenom]
+		if !found {
+			denoms = append(denoms, agent.StakeDenom)
+			total = math.ZeroInt()
+		}
+		totals[agent.StakeDenom] = total.Add(agent.StakedAmount)
+	}
+
+	for _, denom := range denoms {
+		m.keeper.setMultiStakingDenomTotal(ctx, denom, totals[denom])
+	}
+}
+
+func (m Migrator) migrateBondedRestakedTotal(ctx sdk.Context) {
+	total := math.ZeroInt()
+	for _, agent := range m.keeper.GetAllAgent(ctx) {
+		agent := agent
+		if m.keeper.agentValidatorBonded(ctx, &agent) {
+			total = total.Add(agentDelegatedAmount(&agent))
+		}
+	}
+
+	m.keeper.setBondedRestakedTotal(ctx, total)
+}
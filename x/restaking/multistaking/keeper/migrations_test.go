@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/keeper"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	delegatorAddrs, _ := createValAddrs(2)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+
+	suite.delegateForDenomRemoval(delegatorAddrs, validator.OperatorAddress)
+
+	bondedTotal := suite.app.MultiStakingKeeper.GetBondedRestakedTotal(suite.ctx)
+	suite.Require().True(bondedTotal.IsPositive())
+
+	// the totals don't exist in version 1.
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(types.GetMultiStakingDenomTotalKey(mockMultiRestakingDenom))
+	store.Delete(types.MultiStakingBondedRestakedTotalKey)
+	suite.Require().True(suite.app.MultiStakingKeeper.GetMultiStakingDenomTotal(suite.ctx, mockMultiRestakingDenom).IsZero())
+
+	suite.Require().NoError(keeper.NewMigrator(suite.app.MultiStakingKeeper).Migrate1to2(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(2000), suite.app.MultiStakingKeeper.GetMultiStakingDenomTotal(suite.ctx, mockMultiRestakingDenom))
+	suite.Require().Equal(bondedTotal, suite.app.MultiStakingKeeper.GetBondedRestakedTotal(suite.ctx))
+}
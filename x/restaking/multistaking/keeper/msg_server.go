@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the multistaking MsgServer interface
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AddMultiStakingDenom implements types.MsgServer
+func (ms msgServer) AddMultiStakingDenom(goCtx context.Context, msg *types.MsgAddMultiStakingDenom) (*types.MsgAddMultiStakingDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.AddMultiStakingDenom(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddMultiStakingDenomResponse{}, nil
+}
+
+// RemoveMultiStakingDenom implements types.MsgServer
+func (ms msgServer) RemoveMultiStakingDenom(goCtx context.Context, msg *types.MsgRemoveMultiStakingDenom) (*types.MsgRemoveMultiStakingDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.RemoveMultiStakingDenom(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveMultiStakingDenomResponse{}, nil
+}
+
+// MultiStakingDelegate implements types.MsgServer
+func (ms msgServer) MultiStakingDelegate(goCtx context.Context, msg *types.MsgMultiStakingDelegate) (*types.MsgMultiStakingDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.MultiStakingDelegate(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiStakingDelegateResponse{}, nil
+}
+
+// MultiStakingUndelegate implements types.MsgServer
+func (ms msgServer) MultiStakingUndelegate(goCtx context.Context, msg *types.MsgMultiStakingUndelegate) (*types.MsgMultiStakingDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.MultiStakingUndelegate(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiStakingDelegateResponse{}, nil
+}
+
+// MultiStakingBeginRedelegate implements types.MsgServer
+func (ms msgServer) MultiStakingBeginRedelegate(goCtx context.Context, msg *types.MsgMultiStakingBeginRedelegate) (*types.MsgMultiStakingBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	completionTime, err := ms.Keeper.MultiStakingBeginRedelegate(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiStakingBeginRedelegateResponse{CompletionTime: completionTime}, nil
+}
+
+// CancelMultiStakingUnbonding implements types.MsgServer
+func (ms msgServer) CancelMultiStakingUnbonding(goCtx context.Context, msg *types.MsgCancelMultiStakingUnbonding) (*types.MsgCancelMultiStakingUnbondingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.CancelMultiStakingUnbonding(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMultiStakingUnbondingResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := ms.Keeper.UpdateParams(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUpdateParams),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+// GetParams get the multistaking module parameters, the default parameters are returned if they are not set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	params := types.Params{}
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+// SetParams set the multistaking module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.validateSlashRecipient(ctx, params.SlashRecipient); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// UpdateParams updates the parameters by the authority.
+func (k Keeper) UpdateParams(ctx sdk.Context, msg *types.MsgUpdateParams) error {
+	if k.authority != msg.Authority {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// validateSlashRecipient checks the slash recipient is empty, the community pool or a module account.
+func (k Keeper) validateSlashRecipient(ctx sdk.Context, recipient string) error {
+	if recipient == "" || recipient == types.SlashRecipientCommunityPool {
+		return nil
+	}
+
+	if k.accountKeeper.GetModuleAccount(ctx, recipient) == nil {
+		return fmt.Errorf("slash recipient %s is not a module account", recipient)
+	}
+
+	return nil
+}
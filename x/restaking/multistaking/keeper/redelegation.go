@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+// MultiStakingBeginRedelegate moves the shares of delegator from the source agent to the destination agent.
+// The bond tokens of the source agent are redelegated to the destination validator directly, and the
+// redelegation entry is tracked until the unbonding time so the slash of source validator still applies.
+func (k Keeper) MultiStakingBeginRedelegate(ctx sdk.Context, msg *types.MsgMultiStakingBeginRedelegate) (time.Time, error) {
+	if msg.ValidatorSrcAddress == msg.ValidatorDstAddress {
+		return time.Time{}, types.ErrSelfRedelegation
+	}
+
+	if !k.denomInWhiteList(ctx, msg.Amount.Denom) {
+		return time.Time{}, sdkerrors.Wrapf(types.ErrForbidStakingDenom, "denom: %s not in white list", msg.Amount.Denom)
+	}
+
+	srcAgent, found := k.GetMultiStakingAgent(ctx, msg.Amount.Denom, msg.ValidatorSrcAddress)
+	if !found {
+		return time.Time{}, types.ErrNotExistedAgent
+	}
+
+	if k.HasReceivingMultiStakingRedelegation(ctx, msg.DelegatorAddress, srcAgent.Id) {
+		return time.Time{}, types.ErrTransitiveRedelegation
+	}
+
+	dstAgent := k.GetOrCreateMultiStakingAgent(ctx, msg.Amount.Denom, msg.ValidatorDstAddress)
+	completionTime := ctx.BlockTime().Add(k.stakingkeeper.GetParams(ctx).UnbondingTime)
+	red := k.GetOrCreateMultiStakingRedelegation(ctx, srcAgent.Id, dstAgent.Id, msg.DelegatorAddress)
+	if !red.HasEntry(ctx.BlockHeight(), completionTime) &&
+		uint32(len(red.Entries)) >= k.GetParams(ctx).MaxUnbondingEntries {
+		return time.Time{}, types.ErrMaxRedelegationEntries
+	}
+
+	// the total amount of denom is unchanged, only the caps of destination validator are checked.
+	if err := k.checkDenomValidatorCap(ctx, dstAgent, msg.Amount); err != nil {
+		return time.Time{}, err
+	}
+
+	removeShares := srcAgent.CalculateShares(msg.Amount.Amount)
+	if err := k.DecreaseMultiStakingShares(ctx, removeShares, srcAgent.Id, msg.DelegatorAddress); err != nil {
+		return time.Time{}, err
+	}
+
+	srcValAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	delegatorAccAddr := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
+	if err := k.withdrawDelegatorReward(ctx, srcAgent, srcValAddr, removeShares, delegatorAccAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	defaultBondDenom := k.stakingkeeper.BondDenom(ctx)
+	redelegateAmt := k.agentBondAmount(ctx, srcAgent, removeShares)
+
+	// the delegation of source agent may be scaled down to zero by the restaked power caps.
+	srcAgentAccAddr := sdk.MustAccAddressFromBech32(srcAgent.DelegateAddress)
+	bondCoins := sdk.NewCoins()
+	if redelegateAmt.IsPositive() {
+		stakedShares, err := k.stakingkeeper.ValidateUnbondAmount(ctx, srcAgentAccAddr, srcValAddr, redelegateAmt.Amount)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		bondCoins, err = k.instantUndelegate(ctx, srcAgentAccAddr, srcValAddr, stakedShares)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	dstValidator, err := k.agentValidator(ctx, dstAgent)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := k.checkRestakedPowerCaps(ctx, *dstValidator, bondCoins.AmountOf(defaultBondDenom)); err != nil {
+		return time.Time{}, err
+	}
+
+	dstAgentAccAddr := sdk.MustAccAddressFromBech32(dstAgent.DelegateAddress)
+	if err := k.sendCoinsFromAccountToAccount(
+		ctx, srcAgentAccAddr, dstAgentAccAddr, bondCoins.Add(msg.Amount),
+	); err != nil {
+		return time.Time{}, err
+	}
+
+	if bondCoins.AmountOf(defaultBondDenom).IsPositive() {
+		if _, err := k.stakingkeeper.Delegate(ctx,
+			dstAgentAccAddr, bondCoins.AmountOf(defaultBondDenom),
+			stakingtypes.Unbonded, *dstValidator, true,
+		); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	srcAgent.Shares = srcAgent.Shares.Sub(removeShares)
+	srcAgent.StakedAmount = srcAgent.StakedAmount.Sub(msg.Amount.Amount)
+	addAgentDelegatedAmount(srcAgent, bondCoins.AmountOf(defaultBondDenom).Neg())
+	k.SetMultiStakingAgent(ctx, srcAgent)
+
+	dstShares := dstAgent.CalculateShares(msg.Amount.Amount)
+	dstAgent.Shares = dstAgent.Shares.Add(dstShares)
+	dstAgent.StakedAmount = dstAgent.StakedAmount.Add(msg.Amount.Amount)
+	addAgentDelegatedAmount(dstAgent, bondCoins.AmountOf(defaultBondDenom))
+	k.SetMultiStakingAgent(ctx, dstAgent)
+	if err := k.IncreaseMultiStakingShares(ctx, dstShares, dstAgent.Id, msg.DelegatorAddress); err != nil {
+		return time.Time{}, err
+	}
+
+	red.AddEntry(ctx.BlockHeight(), completionTime, msg.Amount, dstShares)
+	k.SetMultiStakingRedelegation(ctx, red)
+	k.InsertRedelegationQueue(ctx, red, completionTime)
+
+	return completionTime, nil
+}
+
+func (k Keeper) ProcessCompletedRedelegation(ctx sdk.Context) {
+	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	for _, triplet := range matureRedelegations {
+		k.CompleteRedelegation(ctx, triplet.DelegatorAddress, triplet.SrcAgentId, triplet.DstAgentId)
+	}
+}
+
+// CompleteRedelegation removes the mature entries of redelegation, nothing need to be transferred.
+func (k Keeper) CompleteRedelegation(ctx sdk.Context, delegator string, srcAgentID, dstAgentID uint64) {
+	red, found := k.GetMultiStakingRedelegation(ctx, srcAgentID, dstAgentID, delegator)
+	if !found {
+		return
+	}
+
+	ctxTime := ctx.BlockHeader().Time
+	for i := 0; i < len(red.Entries); i++ {
+		if red.Entries[i].IsMature(ctxTime) {
+			red.RemoveEntry(int64(i))
+			i--
+		}
+	}
+
+	if len(red.Entries) == 0 {
+		k.RemoveMultiStakingRedelegation(ctx, red)
+	} else {
+		k.SetMultiStakingRedelegation(ctx, red)
+	}
+}
+
+// SlashRedelegations slashes the redelegations from the agents of the source validator which were created
+// at or after the infraction height. Like x/staking, the shares of delegator in the destination agent
+// are removed, and the corresponding tokens are slashed from the destination agent.
+func (k Keeper) SlashRedelegations(ctx sdk.Context, srcValAddr sdk.ValAddress, infractionHeight int64, slashFactor sdk.Dec) {
+	if infractionHeight >= ctx.BlockHeight() {
+		return
+	}
+
+	for _, srcAgent := range k.GetAllAgentsByVal(ctx, srcValAddr) {
+		for _, red := range k.GetMultiStakingRedelegationsFromSrc(ctx, srcAgent.Id) {
+			sharesToRemove := math.ZeroInt()
+			for _, entry := range red.Entries {
+				if entry.CreationHeight < infractionHeight || entry.IsMature(ctx.BlockTime()) {
+					continue
+				}
+				sharesToRemove = sharesToRemove.Add(sdk.NewDecFromInt(entry.SharesDst).Mul(slashFactor).TruncateInt())
+			}
+
+			if sharesToRemove.IsZero() {
+				continue
+			}
+
+			if err := k.slashRedelegation(ctx, &red, sharesToRemove); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("slash redelegation failed, src agentID %d, dst agentID %d, delegator %s, error: %s",
+					red.SrcAgentId, red.DstAgentId, red.DelegatorAddress, err))
+			}
+		}
+	}
+}
+
+func (k Keeper) slashRedelegation(ctx sdk.Context, red *types.MultiStakingRedelegation, sharesToRemove math.Int) error {
+	dstAgent, found := k.GetMultiStakingAgentByID(ctx, red.DstAgentId)
+	if !found {
+		return types.ErrNotExistedAgent
+	}
+
+	// the delegator may have undelegated a part of shares.
+	sharesToRemove = math.MinInt(sharesToRemove, k.GetMultiStakingShares(ctx, dstAgent.Id, red.DelegatorAddress))
+	slashAmt := dstAgent.CalculateCoins(sharesToRemove)
+	if slashAmt.IsZero() {
+		return nil
+	}
+
+	dstValAddr, err := sdk.ValAddressFromBech32(dstAgent.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	bondAmt := k.agentBondAmount(ctx, dstAgent, sharesToRemove)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.DecreaseMultiStakingShares(cacheCtx, sharesToRemove, dstAgent.Id, red.DelegatorAddress); err != nil {
+		return err
+	}
+
+	if err := k.undelegateAndBurn(cacheCtx, dstAgent, dstValAddr, bondAmt); err != nil {
+		return err
+	}
+
+	if err := k.slashAgentCoin(cacheCtx, dstAgent, slashAmt); err != nil {
+		return err
+	}
+
+	dstAgent.Shares = dstAgent.Shares.Sub(sharesToRemove)
+	dstAgent.StakedAmount = dstAgent.StakedAmount.Sub(slashAmt)
+	k.SetMultiStakingAgent(cacheCtx, dstAgent)
+
+	writeCache()
+
+	return nil
+}
+
+// HasReceivingMultiStakingRedelegation checks whether the delegator has an uncompleted redelegation to the agent.
+func (k Keeper) HasReceivingMultiStakingRedelegation(ctx sdk.Context, delegator string, dstAgentID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetMultiStakingRedelegationsToDstPrefix(delegator, dstAgentID))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+func (k Keeper) GetMultiStakingRedelegation(ctx sdk.Context, srcAgentID, dstAgentID uint64, delegator string) (*types.MultiStakingRedelegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetMultiStakingRedelegationKey(srcAgentID, dstAgentID, delegator))
+	if bz == nil {
+		return nil, false
+	}
+
+	red := &types.MultiStakingRedelegation{}
+	k.cdc.MustUnmarshal(bz, red)
+	return red, true
+}
+
+func (k Keeper) GetOrCreateMultiStakingRedelegation(ctx sdk.Context, srcAgentID, dstAgentID uint64, delegator string) *types.MultiStakingRedelegation {
+	red, found := k.GetMultiStakingRedelegation(ctx, srcAgentID, dstAgentID, delegator)
+	if found {
+		return red
+	}
+
+	return &types.MultiStakingRedelegation{
+		DelegatorAddress: delegator,
+		SrcAgentId:       srcAgentID,
+		DstAgentId:       dstAgentID,
+		Entries:          []types.MultiStakingRedelegationEntry{},
+	}
+}
+
+func (k Keeper) GetMultiStakingRedelegationsFromSrc(ctx sdk.Context, srcAgentID uint64) []types.MultiStakingRedelegation {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetMultiStakingRedelegationsFromSrcPrefix(srcAgentID))
+	defer iterator.Close()
+
+	redelegations := []types.MultiStakingRedelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		red := types.MultiStakingRedelegation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &red)
+		redelegations = append(redelegations, red)
+	}
+
+	return redelegations
+}
+
+func (k Keeper) SetMultiStakingRedelegation(ctx sdk.Context, red *types.MultiStakingRedelegation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(red)
+
+	store.Set(types.GetMultiStakingRedelegationKey(red.SrcAgentId, red.DstAgentId, red.DelegatorAddress), bz)
+	store.Set(types.GetMultiStakingRedelegationByDstIndexKey(red.DelegatorAddress, red.DstAgentId, red.SrcAgentId), []byte{})
+}
+
+func (k Keeper) RemoveMultiStakingRedelegation(ctx sdk.Context, red *types.MultiStakingRedelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMultiStakingRedelegationKey(red.SrcAgentId, red.DstAgentId, red.DelegatorAddress))
+	store.Delete(types.GetMultiStakingRedelegationByDstIndexKey(red.DelegatorAddress, red.DstAgentId, red.SrcAgentId))
+}
+
+// RedelegationQueueIterator returns all the redelegation queue timeslices from time 0 until endTime.
+func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.MultiStakingRedelegationQueueKey,
+		sdk.InclusiveEndBytes(types.GetMultiStakingRedelegationTimeKey(endTime)))
+}
+
+func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red *types.MultiStakingRedelegation, completionTime time.Time) {
+	triplet := types.DSDTriplet{
+		DelegatorAddress: red.DelegatorAddress,
+		SrcAgentId:       red.SrcAgentId,
+		DstAgentId:       red.DstAgentId,
+	}
+
+	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, completionTime)
+
+	// all the mature entries of the triplet are completed together, so the triplet is only needed once.
+	for _, t := range timeSlice {
+		if t.SrcAgentId == triplet.SrcAgentId && t.DstAgentId == triplet.DstAgentId && t.DelegatorAddress == triplet.DelegatorAddress {
+			return
+		}
+	}
+
+	k.SetRedelegationQueueTimeSlice(ctx, completionTime, append(timeSlice, triplet))
+}
+
+func (k Keeper) GetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []types.DSDTriplet {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetMultiStakingRedelegationTimeKey(timestamp))
+	if bz == nil {
+		return []types.DSDTriplet{}
+	}
+
+	triplets := types.DSDTriplets{}
+	k.cdc.MustUnmarshal(bz, &triplets)
+
+	return triplets.Triplets
+}
+
+func (k Keeper) SetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, triplets []types.DSDTriplet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.DSDTriplets{Triplets: triplets})
+	store.Set(types.GetMultiStakingRedelegationTimeKey(timestamp), bz)
+}
+
+func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time.Time) (matureRedelegations []types.DSDTriplet) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.RedelegationQueueIterator(ctx, currTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timeslice := types.DSDTriplets{}
+		k.cdc.MustUnmarshal(iterator.Value(), &timeslice)
+
+		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
+
+		store.Delete(iterator.Key())
+	}
+
+	return matureRedelegations
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/keeper"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) createValidator() stakingtypes.Validator {
+	addrs, valAddrs := createValAddrs(1)
+	bondCoin := sdk.NewCoin(suite.app.StakingKeeper.BondDenom(suite.ctx), sdk.NewInt(1000000))
+	suite.mintCoin(bondCoin, addrs[0])
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], PKs[0], bondCoin, stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	suite.Require().NoError(err)
+
+	msgServer := stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddrs[0])
+	suite.Require().True(found)
+	return validator
+}
+
+func (suite *KeeperTestSuite) TestBeginRedelegate() {
+	delegatorAddrs, _ := createValAddrs(1)
+	srcValidator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	dstValidator := suite.createValidator()
+
+	multiRestakingCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000000))
+	redelegateCoin := sdk.NewCoin(mockMultiRestakingDenom, multiRestakingCoin.Amount.QuoRaw(2))
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+	suite.mintCoin(multiRestakingCoin, delegatorAddrs[0])
+
+	err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: srcValidator.OperatorAddress,
+		Amount:           multiRestakingCoin,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.app.MultiStakingKeeper.MultiStakingBeginRedelegate(suite.ctx, &types.MsgMultiStakingBeginRedelegate{
+		DelegatorAddress:    delegatorAddrs[0].String(),
+		ValidatorSrcAddress: srcValidator.OperatorAddress,
+		ValidatorDstAddress: srcValidator.OperatorAddress,
+		Amount:              redelegateCoin,
+	})
+	suite.Require().ErrorIs(err, types.ErrSelfRedelegation)
+
+	infractionHeight := suite.ctx.BlockHeight()
+	suite.ctx = suite.ctx.WithBlockHeight(infractionHeight + 1)
+	completionTime, err := suite.app.MultiStakingKeeper.MultiStakingBeginRedelegate(suite.ctx, &types.MsgMultiStakingBeginRedelegate{
+		DelegatorAddress:    delegatorAddrs[0].String(),
+		ValidatorSrcAddress: srcValidator.OperatorAddress,
+		ValidatorDstAddress: dstValidator.OperatorAddress,
+		Amount:              redelegateCoin,
+	})
+	suite.Require().NoError(err)
+
+	srcAgent, found := suite.app.MultiStakingKeeper.GetMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, srcValidator.OperatorAddress)
+	suite.Require().True(found)
+	dstAgent, found := suite.app.MultiStakingKeeper.GetMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, dstValidator.OperatorAddress)
+	suite.Require().True(found)
+	suite.Require().Equal(multiRestakingCoin.Amount.Sub(redelegateCoin.Amount), srcAgent.StakedAmount)
+	suite.Require().Equal(redelegateCoin.Amount, dstAgent.StakedAmount)
+
+	dstShares := suite.app.MultiStakingKeeper.GetMultiStakingShares(suite.ctx, dstAgent.Id, delegatorAddrs[0].String())
+	suite.Require().Equal(redelegateCoin.Amount, dstShares)
+
+	dstDelegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx,
+		sdk.MustAccAddressFromBech32(dstAgent.DelegateAddress), dstValidator.GetOperator())
+	suite.Require().True(found)
+	dstValidator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, dstValidator.GetOperator())
+	suite.Require().Equal(redelegateCoin.Amount, dstValidator.TokensFromShares(dstDelegation.Shares).TruncateInt())
+
+	red, found := suite.app.MultiStakingKeeper.GetMultiStakingRedelegation(suite.ctx, srcAgent.Id, dstAgent.Id, delegatorAddrs[0].String())
+	suite.Require().True(found)
+	suite.Require().Equal(1, len(red.Entries))
+	suite.Require().Equal(completionTime, red.Entries[0].CompletionTime)
+	suite.Require().Equal(1, len(suite.app.MultiStakingKeeper.GetRedelegationQueueTimeSlice(suite.ctx, completionTime)))
+
+	// can't redelegate the redelegated shares again until the redelegation is completed.
+	_, err = suite.app.MultiStakingKeeper.MultiStakingBeginRedelegate(suite.ctx, &types.MsgMultiStakingBeginRedelegate{
+		DelegatorAddress:    delegatorAddrs[0].String(),
+		ValidatorSrcAddress: dstValidator.OperatorAddress,
+		ValidatorDstAddress: srcValidator.OperatorAddress,
+		Amount:              redelegateCoin,
+	})
+	suite.Require().ErrorIs(err, types.ErrTransitiveRedelegation)
+
+	// the slash of source validator applies to the redelegation.
+	suite.ctx = suite.ctx.WithBlockHeight(infractionHeight + 2)
+	slashFactor := sdk.NewDecWithPrec(1, 1)
+	suite.app.MultiStakingKeeper.SlashRedelegations(suite.ctx, srcValidator.GetOperator(), infractionHeight, slashFactor)
+
+	slashedAmt := sdk.NewDecFromInt(redelegateCoin.Amount).Mul(slashFactor).TruncateInt()
+	dstAgent, _ = suite.app.MultiStakingKeeper.GetMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, dstValidator.OperatorAddress)
+	suite.Require().Equal(redelegateCoin.Amount.Sub(slashedAmt), dstAgent.StakedAmount)
+	dstShares = suite.app.MultiStakingKeeper.GetMultiStakingShares(suite.ctx, dstAgent.Id, delegatorAddrs[0].String())
+	suite.Require().Equal(redelegateCoin.Amount.Sub(slashedAmt), dstShares)
+
+	suite.ctx = suite.ctx.WithBlockTime(completionTime)
+	suite.app.MultiStakingKeeper.ProcessCompletedRedelegation(suite.ctx)
+	_, found = suite.app.MultiStakingKeeper.GetMultiStakingRedelegation(suite.ctx, srcAgent.Id, dstAgent.Id, delegatorAddrs[0].String())
+	suite.Require().False(found)
+	suite.Require().False(suite.app.MultiStakingKeeper.HasReceivingMultiStakingRedelegation(suite.ctx, delegatorAddrs[0].String(), dstAgent.Id))
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestBeginRedelegateEntriesAndCaps() {
+	delegatorAddrs, _ := createValAddrs(1)
+	srcValidator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	dstValidator := suite.createValidator()
+
+	multiRestakingCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000))
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+	suite.mintCoin(multiRestakingCoin, delegatorAddrs[0])
+
+	params := suite.app.MultiStakingKeeper.GetParams(suite.ctx)
+	params.MaxUnbondingEntries = 2
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+
+	err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: srcValidator.OperatorAddress,
+		Amount:           multiRestakingCoin,
+	})
+	suite.Require().NoError(err)
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.MultiStakingKeeper)
+	redelegate := func(amount int64) (*types.MsgMultiStakingBeginRedelegateResponse, error) {
+		return msgServer.MultiStakingBeginRedelegate(sdk.WrapSDKContext(suite.ctx), &types.MsgMultiStakingBeginRedelegate{
+			DelegatorAddress:    delegatorAddrs[0].String(),
+			ValidatorSrcAddress: srcValidator.OperatorAddress,
+			ValidatorDstAddress: dstValidator.OperatorAddress,
+			Amount:              sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(amount)),
+		})
+	}
+
+	// the entries in the same block are merged.
+	height := suite.ctx.BlockHeight()
+	res, err := redelegate(1000)
+	suite.Require().NoError(err)
+	_, err = redelegate(1000)
+	suite.Require().NoError(err)
+
+	srcAgent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, srcValidator.OperatorAddress)
+	dstAgent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, dstValidator.OperatorAddress)
+	red, found := suite.app.MultiStakingKeeper.GetMultiStakingRedelegation(suite.ctx, srcAgent.Id, dstAgent.Id, delegatorAddrs[0].String())
+	suite.Require().True(found)
+	suite.Require().Equal(1, len(red.Entries))
+	suite.Require().Equal(sdk.NewInt(2000), red.Entries[0].InitialBalance.Amount)
+
+	suite.ctx = suite.ctx.WithBlockHeight(height + 1)
+	_, err = redelegate(1000)
+	suite.Require().NoError(err)
+
+	// the triplet is queued once for the same completion time.
+	suite.Require().Equal(1, len(suite.app.MultiStakingKeeper.GetRedelegationQueueTimeSlice(suite.ctx, res.CompletionTime)))
+
+	suite.ctx = suite.ctx.WithBlockHeight(height + 2)
+	_, err = redelegate(1000)
+	suite.Require().ErrorIs(err, types.ErrMaxRedelegationEntries)
+
+	// the total amount of denom is unchanged by the redelegation, but the validator cap of destination applies.
+	suite.ctx = suite.ctx.WithBlockHeight(height + 1)
+	dstValidator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, dstValidator.GetOperator())
+	suite.app.MultiStakingKeeper.SetMultiStakingDenomConfig(suite.ctx, types.NewMultiStakingDenomConfig(
+		mockMultiRestakingDenom, multiRestakingCoin.Amount, sdk.NewDec(3500).QuoInt(dstValidator.Tokens), sdk.ZeroInt(),
+	))
+
+	_, err = redelegate(1000)
+	suite.Require().ErrorIs(err, types.ErrExceedDenomCap)
+	_, err = redelegate(100)
+	suite.Require().NoError(err)
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
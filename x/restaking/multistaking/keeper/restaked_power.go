@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+// GetValidatorRestakedPower returns the tokens of validator which come from native delegations and
+// multistaking agents.
+func (k Keeper) GetValidatorRestakedPower(ctx sdk.Context, validator stakingtypes.Validator) types.ValidatorRestakedPower {
+	restaked := math.ZeroInt()
+	for _, agent := range k.GetAllAgentsByVal(ctx, validator.GetOperator()) {
+		agent := agent
+		restaked = restaked.Add(k.agentDelegatedTokens(ctx, &agent, validator))
+	}
+
+	return types.ValidatorRestakedPower{
+		ValidatorAddress: validator.OperatorAddress,
+		NativeTokens:     nonNegative(validator.Tokens.Sub(restaked)),
+		RestakedTokens:   restaked,
+	}
+}
+
+// agentDelegatedTokens returns the bond tokens which the agent delegated to validator in x/staking.
+func (k Keeper) agentDelegatedTokens(ctx sdk.Context, agent *types.MultiStakingAgent, validator stakingtypes.Validator) math.Int {
+	delegation, found := k.stakingkeeper.GetDelegation(ctx, sdk.MustAccAddressFromBech32(agent.DelegateAddress), validator.GetOperator())
+	if !found {
+		return math.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// GetBondedRestakedTotal returns the bond tokens which all agents delegated to the bonded validators. It's kept
+// when the agents are saved and when the validators are bonded or begin unbonding.
+func (k Keeper) GetBondedRestakedTotal(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MultiStakingBondedRestakedTotalKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	total := math.ZeroInt()
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+func (k Keeper) setBondedRestakedTotal(ctx sdk.Context, total math.Int) {
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MultiStakingBondedRestakedTotalKey, bz)
+}
+
+func (k Keeper) addBondedRestakedTotal(ctx sdk.Context, amount math.Int) {
+	k.setBondedRestakedTotal(ctx, nonNegative(k.GetBondedRestakedTotal(ctx).Add(amount)))
+}
+
+// getValidatorDelegatedAmount returns the recorded bond tokens which the agents delegated to the validator.
+func (k Keeper) getValidatorDelegatedAmount(ctx sdk.Context, valAddr sdk.ValAddress) math.Int {
+	delegated := math.ZeroInt()
+	for _, agent := range k.GetAllAgentsByVal(ctx, valAddr) {
+		agent := agent
+		delegated = delegated.Add(agentDelegatedAmount(&agent))
+	}
+
+	return delegated
+}
+
+func (k Keeper) agentValidatorBonded(ctx sdk.Context, agent *types.MultiStakingAgent) bool {
+	validator, err := k.agentValidator(ctx, agent)
+	return err == nil && validator.IsBonded()
+}
+
+func agentDelegatedAmount(agent *types.MultiStakingAgent) math.Int {
+	if agent.DelegatedAmount.IsNil() {
+		return math.ZeroInt()
+	}
+	return agent.DelegatedAmount
+}
+
+// checkRestakedPowerCaps checks the delegation of bond tokens amount to validator by an agent against
+// the global and per-validator caps of the restaked power.
+func (k Keeper) checkRestakedPowerCaps(ctx sdk.Context, validator stakingtypes.Validator, amount math.Int) error {
+	params := k.GetParams(ctx)
+
+	if types.PowerShareCapEnabled(params.MaxValidatorRestakedPowerShare) {
+		power := k.GetValidatorRestakedPower(ctx, validator)
+		share := powerShare(power.RestakedTokens.Add(amount), validator.Tokens.Add(amount))
+		if share.GT(params.MaxValidatorRestakedPowerShare) {
+			return sdkerrors.Wrapf(types.ErrExceedRestakedPowerCap, "max validator restaked power share %s, got %s",
+				params.MaxValidatorRestakedPowerShare, share)
+		}
+	}
+
+	if types.PowerShareCapEnabled(params.MaxRestakedPowerShare) {
+		restaked := k.GetBondedRestakedTotal(ctx).Add(amount)
+		share := powerShare(restaked, k.stakingkeeper.TotalBondedTokens(ctx).Add(amount))
+		if share.GT(params.MaxRestakedPowerShare) {
+			return sdkerrors.Wrapf(types.ErrExceedRestakedPowerCap, "max restaked power share %s, got %s",
+				params.MaxRestakedPowerShare, share)
+		}
+	}
+
+	return nil
+}
+
+// agentDelegationTarget is the current and the target delegation of an agent in x/staking.
+type agentDelegationTarget struct {
+	agent     *types.MultiStakingAgent
+	validator stakingtypes.Validator
+	current   math.Int
+	target    math.Int
+}
+
+// capAgentDelegationTargets scales down the targets so the restaked tokens of each validator and of
+// all bonded validators don't exceed the caps. The native tokens are the tokens which don't come
+// from agents, so the max restaked tokens r of native tokens n is r = cap * n / (1 - cap).
+func (k Keeper) capAgentDelegationTargets(ctx sdk.Context, targets []agentDelegationTarget) {
+	params := k.GetParams(ctx)
+
+	if types.PowerShareCapEnabled(params.MaxValidatorRestakedPowerShare) {
+		// keep the order of validators so the scaling is deterministic.
+		var valAddrs []string
+		groups := make(map[string][]int)
+		for i, t := range targets {
+			valAddr := t.validator.OperatorAddress
+			if _, found := groups[valAddr]; !found {
+				valAddrs = append(valAddrs, valAddr)
+			}
+			groups[valAddr] = append(groups[valAddr], i)
+		}
+
+		for _, valAddr := range valAddrs {
+			indexes := groups[valAddr]
+			native := targets[indexes[0]].validator.Tokens
+			for _, i := range indexes {
+				native = native.Sub(targets[i].current)
+			}
+
+			scaleTargets(targets, indexes, maxRestakedTokens(nonNegative(native), params.MaxValidatorRestakedPowerShare))
+		}
+	}
+
+	if types.PowerShareCapEnabled(params.MaxRestakedPowerShare) {
+		var indexes []int
+		native := k.stakingkeeper.TotalBondedTokens(ctx)
+		for i, t := range targets {
+			if !t.validator.IsBonded() {
+				continue
+			}
+			indexes = append(indexes, i)
+			native = native.Sub(t.current)
+		}
+
+		scaleTargets(targets, indexes, maxRestakedTokens(nonNegative(native), params.MaxRestakedPowerShare))
+	}
+}
+
+// scaleTargets scales down the targets of indexes proportionally if their sum exceeds limit.
+func scaleTargets(targets []agentDelegationTarget, indexes []int, limit math.Int) {
+	total := math.ZeroInt()
+	for _, i := range indexes {
+		total = total.Add(targets[i].target)
+	}
+
+	if total.LTE(limit) {
+		return
+	}
+
+	for _, i := range indexes {
+		targets[i].target = targets[i].target.Mul(limit).Quo(total)
+	}
+}
+
+func maxRestakedTokens(native math.Int, powerShareCap sdk.Dec) math.Int {
+	return powerShareCap.MulInt(native).Quo(sdk.OneDec().Sub(powerShareCap)).TruncateInt()
+}
+
+// powerShare returns restaked / total, it's zero if there are no tokens at all.
+func powerShare(restaked, total math.Int) sdk.Dec {
+	if !total.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(restaked).QuoInt(total)
+}
+
+func nonNegative(amount math.Int) math.Int {
+	if amount.IsNegative() {
+		return math.ZeroInt()
+	}
+	return amount
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/keeper"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) TestRestakedPowerCaps() {
+	delegatorAddrs, _ := createValAddrs(1)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	nativeTokens := validator.Tokens
+
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+	suite.mintCoin(sdk.NewCoin(mockMultiRestakingDenom, nativeTokens), delegatorAddrs[0])
+	delegate := func(amount sdk.Int) error {
+		return suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+			DelegatorAddress: delegatorAddrs[0].String(),
+			ValidatorAddress: validator.OperatorAddress,
+			Amount:           sdk.NewCoin(mockMultiRestakingDenom, amount),
+		})
+	}
+
+	params := suite.app.MultiStakingKeeper.GetParams(suite.ctx)
+	params.MaxValidatorRestakedPowerShare = sdk.NewDecWithPrec(25, 2)
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+
+	suite.Require().NoError(delegate(nativeTokens.QuoRaw(4)))
+	suite.Require().ErrorIs(delegate(nativeTokens.QuoRaw(4)), types.ErrExceedRestakedPowerCap)
+
+	// the global cap applies to the bonded tokens of all validators.
+	params.MaxValidatorRestakedPowerShare = sdk.ZeroDec()
+	params.MaxRestakedPowerShare = sdk.NewDecWithPrec(25, 2)
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+	suite.Require().ErrorIs(delegate(nativeTokens.QuoRaw(4)), types.ErrExceedRestakedPowerCap)
+
+	// the minted stake is scaled down to the cap when the rate rises.
+	suite.app.MultiStakingKeeper.EquivalentCoinCalculator = RiseRateCalculateEquivalentCoin
+	suite.app.MultiStakingKeeper.RefreshAgentDelegationAmount(suite.ctx)
+
+	querier := keeper.Querier{Keeper: suite.app.MultiStakingKeeper}
+	res, err := querier.ValidatorRestakedPower(sdk.WrapSDKContext(suite.ctx), &types.QueryValidatorRestakedPowerRequest{
+		ValidatorAddress: validator.OperatorAddress,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Power.NativeTokens.Equal(nativeTokens))
+	suite.Require().True(res.Power.RestakedTokens.Sub(nativeTokens.QuoRaw(3)).Abs().LTE(sdk.OneInt()))
+
+	allRes, err := querier.ValidatorsRestakedPower(sdk.WrapSDKContext(suite.ctx), &types.QueryValidatorsRestakedPowerRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ValidatorRestakedPower{res.Power}, allRes.Powers)
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestUndelegateCappedAgent() {
+	delegatorAddrs, _ := createValAddrs(1)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	delegateCoin := sdk.NewCoin(mockMultiRestakingDenom, validator.Tokens.QuoRaw(4))
+
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+	suite.mintCoin(delegateCoin, delegatorAddrs[0])
+	err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           delegateCoin,
+	})
+	suite.Require().NoError(err)
+
+	// the delegation of agent is scaled down to the cap, so it's less than the equivalent of the staked coins.
+	params := suite.app.MultiStakingKeeper.GetParams(suite.ctx)
+	params.MaxValidatorRestakedPowerShare = sdk.NewDecWithPrec(25, 2)
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+	suite.app.MultiStakingKeeper.EquivalentCoinCalculator = RiseRateCalculateEquivalentCoin
+	suite.app.MultiStakingKeeper.RefreshAgentDelegationAmount(suite.ctx)
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	agent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	equivalent, err := suite.app.MultiStakingKeeper.EquivalentCoinCalculator(suite.ctx, delegateCoin, suite.app.StakingKeeper.BondDenom(suite.ctx))
+	suite.Require().NoError(err)
+	suite.Require().True(agent.DelegatedAmount.LT(equivalent.Amount))
+
+	err = suite.app.MultiStakingKeeper.MultiStakingUndelegate(suite.ctx, &types.MsgMultiStakingUndelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           delegateCoin,
+	})
+	suite.Require().NoError(err)
+
+	agent, _ = suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	suite.Require().True(agent.Shares.IsZero())
+	suite.Require().True(agent.DelegatedAmount.IsZero())
+	_, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, sdk.MustAccAddressFromBech32(agent.DelegateAddress), validator.GetOperator())
+	suite.Require().False(found)
+
+	unbonding, found := suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegatorAddrs[0].String())
+	suite.Require().True(found)
+	suite.Require().Equal(delegateCoin, unbonding.Entries[0].Balance)
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestBondedRestakedTotal() {
+	delegatorAddrs, _ := createValAddrs(1)
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	delegateCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000))
+
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+	suite.mintCoin(delegateCoin, delegatorAddrs[0])
+	err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           delegateCoin,
+	})
+	suite.Require().NoError(err)
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	agent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	suite.Require().True(agent.DelegatedAmount.IsPositive())
+	suite.Require().Equal(agent.DelegatedAmount, suite.app.MultiStakingKeeper.GetBondedRestakedTotal(suite.ctx))
+
+	// the delegation of the validator is moved out of the total when it begins unbonding, and back when it's bonded.
+	hooks := suite.app.MultiStakingKeeper.Hooks()
+	suite.Require().NoError(hooks.AfterValidatorBeginUnbonding(suite.ctx, sdk.ConsAddress{}, validator.GetOperator()))
+	suite.Require().True(suite.app.MultiStakingKeeper.GetBondedRestakedTotal(suite.ctx).IsZero())
+	suite.Require().NoError(hooks.AfterValidatorBonded(suite.ctx, sdk.ConsAddress{}, validator.GetOperator()))
+	suite.Require().Equal(agent.DelegatedAmount, suite.app.MultiStakingKeeper.GetBondedRestakedTotal(suite.ctx))
+
+	err = suite.app.MultiStakingKeeper.MultiStakingUndelegate(suite.ctx, &types.MsgMultiStakingUndelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validator.OperatorAddress,
+		Amount:           delegateCoin,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.MultiStakingKeeper.GetBondedRestakedTotal(suite.ctx).IsZero())
+}
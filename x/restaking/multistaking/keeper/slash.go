@@ -2,39 +2,194 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
-	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
 )
 
-// TODO more detailed research about slash
+// SlashingStakingKeeper wraps the staking keeper used by x/slashing. The staking hooks don't provide
+// the infraction height, so the unbonding and redelegation entries of multistaking are slashed here.
+type SlashingStakingKeeper struct {
+	slashingtypes.StakingKeeper
+
+	multiStakingKeeper *Keeper
+}
+
+func NewSlashingStakingKeeper(stakingKeeper slashingtypes.StakingKeeper, multiStakingKeeper *Keeper) SlashingStakingKeeper {
+	return SlashingStakingKeeper{
+		StakingKeeper:      stakingKeeper,
+		multiStakingKeeper: multiStakingKeeper,
+	}
+}
+
+// Slash implements slashingtypes.StakingKeeper
+func (s SlashingStakingKeeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) math.Int {
+	burnedAmount := s.StakingKeeper.Slash(ctx, consAddr, infractionHeight, power, slashFactor)
+
+	validator := s.StakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || slashFactor.IsZero() {
+		return burnedAmount
+	}
+
+	s.multiStakingKeeper.SyncAgentDelegatedAmount(ctx, validator.GetOperator())
+	s.multiStakingKeeper.SlashUnbondingEntries(ctx, validator.GetOperator(), infractionHeight, slashFactor)
+	s.multiStakingKeeper.SlashRedelegations(ctx, validator.GetOperator(), infractionHeight, slashFactor)
+
+	return burnedAmount
+}
+
+// SlashAgentByValidatorSlash slashes the deposited StakeDenom of the agents of the validator in proportion
+// to the slash fraction.
 func (k Keeper) SlashAgentByValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, slashFactor sdk.Dec) {
 	agents := k.GetAllAgentsByVal(ctx, valAddr)
 
-	slashDenom := k.stakingkeeper.GetParams(ctx).BondDenom
 	for i := 0; i < len(agents); i++ {
-		slashAmt := sdk.NewDecFromInt(agents[i].StakedAmount).Mul(slashFactor)
-		slashCoin := sdk.NewCoin(slashDenom, slashAmt.TruncateInt())
+		slashAmt := sdk.NewDecFromInt(agents[i].StakedAmount).Mul(slashFactor).TruncateInt()
+		if slashAmt.IsZero() {
+			continue
+		}
+
+		if err := k.slashAgentCoin(ctx, &agents[i], slashAmt); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("slash agent failed, agentID %d, error: %s", agents[i].Id, err))
+			continue
+		}
+
+		agents[i].StakedAmount = agents[i].StakedAmount.Sub(slashAmt)
+		k.SetMultiStakingAgent(ctx, &agents[i])
+	}
+}
+
+// SyncAgentDelegatedAmount records the delegation of the agents of the validator after it's slashed by x/staking.
+func (k Keeper) SyncAgentDelegatedAmount(ctx sdk.Context, valAddr sdk.ValAddress) {
+	validator, found := k.stakingkeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	agents := k.GetAllAgentsByVal(ctx, valAddr)
+	for i := 0; i < len(agents); i++ {
+		agents[i].DelegatedAmount = math.ZeroInt()
+		delegation, found := k.stakingkeeper.GetDelegation(ctx, sdk.MustAccAddressFromBech32(agents[i].DelegateAddress), valAddr)
+		if found {
+			agents[i].DelegatedAmount = validator.TokensFromShares(delegation.Shares).TruncateInt()
+		}
+		k.SetMultiStakingAgent(ctx, &agents[i])
+	}
+}
+
+// SlashUnbondingEntries slashes the unbonding entries of the agents of the validator which were created
+// at or after the infraction height and are not mature yet. Like x/staking, the slashed amount is based
+// on the initial balance and the balance of entry is reduced.
+func (k Keeper) SlashUnbondingEntries(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64, slashFactor sdk.Dec) {
+	if infractionHeight >= ctx.BlockHeight() {
+		return
+	}
+
+	agents := k.GetAllAgentsByVal(ctx, valAddr)
+	for i := 0; i < len(agents); i++ {
+		unbondings := k.GetMultiStakingUnbondingsByAgent(ctx, agents[i].Id)
+
+		totalSlashAmt := math.ZeroInt()
+		for j := 0; j < len(unbondings); j++ {
+			totalSlashAmt = totalSlashAmt.Add(slashUnbondingEntries(&unbondings[j], ctx.BlockTime(), infractionHeight, slashFactor))
+		}
 
-		agentDelegatorAddr, err := sdk.AccAddressFromBech32(agents[i].DelegateAddress)
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("agent't delegator is invalid: %s", err))
+		if totalSlashAmt.IsZero() {
 			continue
 		}
 
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, agentDelegatorAddr, types.ModuleName, sdk.Coins{slashCoin},
-		); err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("send agent coins to module failed, agentID %d,error: %s", agents[i].Id, err))
+		if err := k.slashAgentCoin(ctx, &agents[i], totalSlashAmt); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("slash agent unbonding failed, agentID %d, error: %s", agents[i].Id, err))
 			continue
 		}
 
-		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{slashCoin}); err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("burn agent cons failed: %s", err))
+		for j := 0; j < len(unbondings); j++ {
+			k.SetMultiStakingUnbonding(ctx, unbondings[j].AgentId, unbondings[j].DelegatorAddress, &unbondings[j])
+		}
+	}
+}
+
+// slashUnbondingEntries reduces the balance of the slashable entries and returns the slashed amount.
+func slashUnbondingEntries(ubd *types.MultiStakingUnbonding, currentTime time.Time, infractionHeight int64, slashFactor sdk.Dec) math.Int {
+	slashedAmt := math.ZeroInt()
+	for i := 0; i < len(ubd.Entries); i++ {
+		entry := &ubd.Entries[i]
+		if entry.CreationHeight < infractionHeight || entry.IsMature(currentTime) {
 			continue
 		}
 
-		agents[i].StakedAmount = agents[i].StakedAmount.Sub(slashCoin.Amount)
-		k.SetMultiStakingAgent(ctx, &agents[i])
+		slashAmt := sdk.NewDecFromInt(entry.InitialBalance.Amount).Mul(slashFactor).TruncateInt()
+		slashAmt = math.MinInt(slashAmt, entry.Balance.Amount)
+		if slashAmt.IsZero() {
+			continue
+		}
+
+		entry.Balance = entry.Balance.SubAmount(slashAmt)
+		slashedAmt = slashedAmt.Add(slashAmt)
 	}
+
+	return slashedAmt
+}
+
+// slashAgentCoin takes the StakeDenom from the agent's account, then burns it or sends it to the slash
+// recipient. The slashed amount is accumulated in the slash record of the denom.
+func (k Keeper) slashAgentCoin(ctx sdk.Context, agent *types.MultiStakingAgent, amount math.Int) error {
+	agentDelegatorAddr, err := sdk.AccAddressFromBech32(agent.DelegateAddress)
+	if err != nil {
+		return err
+	}
+
+	slashCoins := sdk.Coins{sdk.NewCoin(agent.StakeDenom, amount)}
+
+	// all transfers are reverted if any of them failed.
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, agentDelegatorAddr, types.ModuleName, slashCoins); err != nil {
+		return err
+	}
+
+	recipient := types.AttributeValueBurned
+	slashRecipient := k.GetParams(ctx).SlashRecipient
+	switch slashRecipient {
+	case "":
+		err = k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, slashCoins)
+	case types.SlashRecipientCommunityPool:
+		recipient = slashRecipient
+		err = k.distributionKeeper.FundCommunityPool(cacheCtx, slashCoins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	default:
+		recipient = slashRecipient
+		err = k.bankKeeper.SendCoinsFromModuleToModule(cacheCtx, types.ModuleName, slashRecipient, slashCoins)
+	}
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+
+	record, found := k.GetMultiStakingSlashRecord(ctx, agent.StakeDenom)
+	if !found {
+		record = types.MultiStakingSlashRecord{
+			Denom:         agent.StakeDenom,
+			SlashedAmount: math.ZeroInt(),
+		}
+	}
+	record.SlashedAmount = record.SlashedAmount.Add(amount)
+	record.LastSlashHeight = ctx.BlockHeight()
+	k.SetMultiStakingSlashRecord(ctx, &record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAgentID, strconv.FormatUint(agent.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidator, agent.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeySlashAmount, slashCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		),
+	)
+
+	return nil
 }
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) TestSlashUnbondingEntries() {
+	delegatorAddrs, _ := createValAddrs(2)
+	validators := suite.app.StakingKeeper.GetAllValidators(suite.ctx)
+
+	multiRestakingCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000000))
+	undelegateCoin := sdk.NewCoin(mockMultiRestakingDenom, multiRestakingCoin.Amount.QuoRaw(2))
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+
+	for _, delegator := range delegatorAddrs {
+		suite.mintCoin(multiRestakingCoin, delegator)
+		err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+			DelegatorAddress: delegator.String(),
+			ValidatorAddress: validators[0].OperatorAddress,
+			Amount:           multiRestakingCoin,
+		})
+		suite.Require().NoError(err)
+	}
+
+	// undelegate before the infraction.
+	err := suite.app.MultiStakingKeeper.MultiStakingUndelegate(suite.ctx, &types.MsgMultiStakingUndelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           undelegateCoin,
+	})
+	suite.Require().NoError(err)
+
+	infractionHeight := suite.ctx.BlockHeight() + 1
+
+	// undelegate after the infraction.
+	suite.ctx = suite.ctx.WithBlockHeight(infractionHeight + 1)
+	err = suite.app.MultiStakingKeeper.MultiStakingUndelegate(suite.ctx, &types.MsgMultiStakingUndelegate{
+		DelegatorAddress: delegatorAddrs[1].String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           undelegateCoin,
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(infractionHeight + 2)
+	consAddr, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	slashFactor := sdk.NewDecWithPrec(1, 1)
+	suite.app.SlashingKeeper.Slash(suite.ctx, consAddr, slashFactor,
+		validators[0].GetConsensusPower(sdk.DefaultPowerReduction), infractionHeight)
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+
+	unbonding, found := suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegatorAddrs[0].String())
+	suite.Require().True(found)
+	suite.Require().Equal(undelegateCoin.Amount, unbonding.Entries[0].Balance.Amount)
+	suite.Require().Equal(undelegateCoin.Amount, unbonding.Entries[0].InitialBalance.Amount)
+
+	unbonding, found = suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegatorAddrs[1].String())
+	suite.Require().True(found)
+	suite.Require().Equal(infractionHeight+1, unbonding.Entries[0].CreationHeight)
+	slashedAmt := sdk.NewDecFromInt(undelegateCoin.Amount).Mul(slashFactor).TruncateInt()
+	suite.Require().Equal(undelegateCoin.Amount.Sub(slashedAmt), unbonding.Entries[0].Balance.Amount)
+	suite.Require().Equal(undelegateCoin.Amount, unbonding.Entries[0].InitialBalance.Amount)
+}
+
+func (suite *KeeperTestSuite) TestSlashAgentByValidatorSlash() {
+	delegatorAddrs, _ := createValAddrs(1)
+	validators := suite.app.StakingKeeper.GetAllValidators(suite.ctx)
+
+	multiRestakingCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000000))
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+	suite.mintCoin(multiRestakingCoin, delegatorAddrs[0])
+
+	err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           multiRestakingCoin,
+	})
+	suite.Require().NoError(err)
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	agent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	agentAddr := sdk.MustAccAddressFromBech32(agent.DelegateAddress)
+
+	slashFactor := sdk.NewDecWithPrec(1, 1)
+	slashedAmt := sdk.NewDecFromInt(multiRestakingCoin.Amount).Mul(slashFactor).TruncateInt()
+	suite.app.MultiStakingKeeper.SlashAgentByValidatorSlash(suite.ctx, validators[0].GetOperator(), slashFactor)
+
+	agent, _ = suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	suite.Require().Equal(multiRestakingCoin.Amount.Sub(slashedAmt), agent.StakedAmount)
+
+	// the slashed restaked denom is burned.
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, agentAddr, mockMultiRestakingDenom)
+	suite.Require().Equal(multiRestakingCoin.Amount.Sub(slashedAmt), balance.Amount)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().Equal(multiRestakingCoin.Amount.Sub(slashedAmt), supply.Amount)
+
+	record, found := suite.app.MultiStakingKeeper.GetMultiStakingSlashRecord(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(slashedAmt, record.SlashedAmount)
+	suite.Require().Equal(suite.ctx.BlockHeight(), record.LastSlashHeight)
+
+	found = false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlash {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestSlashRecipient() {
+	params := suite.app.MultiStakingKeeper.GetParams(suite.ctx)
+	params.SlashRecipient = "unknown"
+	suite.Require().Error(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+
+	params.SlashRecipient = types.SlashRecipientCommunityPool
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+
+	delegatorAddrs, _ := createValAddrs(1)
+	validators := suite.app.StakingKeeper.GetAllValidators(suite.ctx)
+
+	multiRestakingCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000000))
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+	suite.mintCoin(multiRestakingCoin, delegatorAddrs[0])
+
+	err := suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           multiRestakingCoin,
+	})
+	suite.Require().NoError(err)
+
+	slashFactor := sdk.NewDecWithPrec(1, 1)
+	slashedAmt := sdk.NewDecFromInt(multiRestakingCoin.Amount).Mul(slashFactor).TruncateInt()
+	suite.app.MultiStakingKeeper.SlashAgentByValidatorSlash(suite.ctx, validators[0].GetOperator(), slashFactor)
+
+	// the slashed restaked denom is funded to the community pool.
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.Require().Equal(slashedAmt, communityPool.AmountOf(mockMultiRestakingDenom).TruncateInt())
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, mockMultiRestakingDenom)
+	suite.Require().Equal(multiRestakingCoin.Amount, supply.Amount)
+}
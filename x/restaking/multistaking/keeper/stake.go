@@ -24,6 +24,24 @@ func (k Keeper) MultiStakingDelegate(ctx sdk.Context, msg types.MsgMultiStakingD
 	}
 
 	agent := k.GetOrCreateMultiStakingAgent(ctx, msg.Amount.Denom, msg.ValidatorAddress)
+	if err := k.checkDenomCaps(ctx, agent, msg.Amount); err != nil {
+		return err
+	}
+
+	validator, err := k.agentValidator(ctx, agent)
+	if err != nil {
+		return err
+	}
+
+	bondAmt, err := k.GetExpectedDelegationAmount(ctx, msg.Amount)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkRestakedPowerCaps(ctx, *validator, bondAmt.Amount); err != nil {
+		return err
+	}
+
 	delegatorAccAddr := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
 
 	if err := k.depositAndDelegate(ctx, agent, msg.Amount, delegatorAccAddr); err != nil {
@@ -78,30 +96,89 @@ func (k Keeper) mintAndDelegate(ctx sdk.Context, agent *types.MultiStakingAgent,
 	); err != nil {
 		return err
 	}
+
+	addAgentDelegatedAmount(agent, amount.Amount)
+
 	return nil
 }
 
+// agentBondAmount returns the part of the bond tokens delegated by the agent which belongs to the shares. The
+// delegation may be scaled down by the restaked power caps, so it's not the equivalent of the staked coins.
+func (k Keeper) agentBondAmount(ctx sdk.Context, agent *types.MultiStakingAgent, shares math.Int) sdk.Coin {
+	defaultBondDenom := k.stakingkeeper.BondDenom(ctx)
+	if agent.Shares.IsZero() || agent.DelegatedAmount.IsNil() {
+		return sdk.NewCoin(defaultBondDenom, math.ZeroInt())
+	}
+
+	return sdk.NewCoin(defaultBondDenom, agent.DelegatedAmount.Mul(shares).Quo(agent.Shares))
+}
+
+// addAgentDelegatedAmount records the change of the bond tokens delegated by the agent.
+func addAgentDelegatedAmount(agent *types.MultiStakingAgent, amount math.Int) {
+	if agent.DelegatedAmount.IsNil() {
+		agent.DelegatedAmount = math.ZeroInt()
+	}
+	agent.DelegatedAmount = agent.DelegatedAmount.Add(amount)
+}
+
 func (k Keeper) MultiStakingUndelegate(ctx sdk.Context, msg *types.MsgMultiStakingUndelegate) error {
 	agent, found := k.GetMultiStakingAgent(ctx, msg.Amount.Denom, msg.ValidatorAddress)
 	if !found {
 		return types.ErrNotExistedAgent
 	}
+
+	unbonding := k.GetOrCreateMultiStakingUnbonding(ctx, agent.Id, msg.DelegatorAddress)
+	undelegateCompleteTime := ctx.BlockTime().Add(k.stakingkeeper.GetParams(ctx).UnbondingTime)
+	if !unbonding.HasEntry(ctx.BlockHeight(), undelegateCompleteTime) &&
+		uint32(len(unbonding.Entries)) >= k.GetParams(ctx).MaxUnbondingEntries {
+		return types.ErrMaxUnbondingEntries
+	}
+
 	removeShares := agent.CalculateShares(msg.Amount.Amount)
-	if err := k.DecreaseMultiStakingShares(ctx, removeShares, agent.Id, msg.DelegatorAddress); err != nil {
+
+	return k.unbond(ctx, agent, unbonding, msg.Amount, removeShares)
+}
+
+// unbond removes the shares of delegator, then undelegates the bond tokens and adds the unbonding entry.
+func (k Keeper) unbond(ctx sdk.Context, agent *types.MultiStakingAgent, unbonding *types.MultiStakingUnbonding,
+	amount sdk.Coin, removeShares math.Int,
+) error {
+	if err := k.DecreaseMultiStakingShares(ctx, removeShares, agent.Id, unbonding.DelegatorAddress); err != nil {
 		return err
 	}
 
-	defaultBondDenom := k.stakingkeeper.BondDenom(ctx)
-	undelegateAmt, err := k.EquivalentCoinCalculator(ctx, msg.Amount, defaultBondDenom)
+	undelegateAmt := k.agentBondAmount(ctx, agent, removeShares)
+
+	valAddr, err := sdk.ValAddressFromBech32(agent.ValidatorAddress)
 	if err != nil {
 		return err
 	}
 
-	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
-	if err != nil {
+	delegatorAccAddr := sdk.MustAccAddressFromBech32(unbonding.DelegatorAddress)
+	if err := k.withdrawDelegatorReward(ctx, agent, valAddr, removeShares, delegatorAccAddr); err != nil {
+		return err
+	}
+
+	if err := k.undelegateAndBurn(ctx, agent, valAddr, undelegateAmt); err != nil {
 		return err
 	}
 
+	undelegateCompleteTime := ctx.BlockTime().Add(k.stakingkeeper.GetParams(ctx).UnbondingTime)
+	unbonding.AddEntry(ctx.BlockHeight(), undelegateCompleteTime, amount)
+	k.SetMultiStakingUnbonding(ctx, agent.Id, unbonding.DelegatorAddress, unbonding)
+
+	agent.Shares = agent.Shares.Sub(removeShares)
+	agent.StakedAmount = agent.StakedAmount.Sub(amount.Amount)
+
+	k.SetMultiStakingAgent(ctx, agent)
+	k.InsertUBDQueue(ctx, unbonding, undelegateCompleteTime)
+
+	return nil
+}
+
+// withdrawDelegatorReward withdraws the rewards of agent, then sends the part of the removed shares to delegator.
+func (k Keeper) withdrawDelegatorReward(ctx sdk.Context, agent *types.MultiStakingAgent, valAddr sdk.ValAddress, removeShares math.Int, delegator sdk.AccAddress) error {
+	defaultBondDenom := k.stakingkeeper.BondDenom(ctx)
 	agentDelegatorAccAddr := sdk.MustAccAddressFromBech32(agent.DelegateAddress)
 	rewards, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, agentDelegatorAccAddr, valAddr)
 	if err != nil {
@@ -109,48 +186,31 @@ func (k Keeper) MultiStakingUndelegate(ctx sdk.Context, msg *types.MsgMultiStaki
 	}
 	agent.RewardAmount = agent.RewardAmount.Add(rewards.AmountOf(defaultBondDenom))
 
-	if !agent.RewardAmount.IsZero() {
-		rewardAmount := agent.RewardAmount.Mul(removeShares).Quo(agent.Shares)
-		if !rewardAmount.IsZero() {
-			delegatorAccAddr := sdk.MustAccAddressFromBech32(msg.DelegatorAddress)
+	if agent.RewardAmount.IsZero() {
+		return nil
+	}
 
-			if err := k.sendCoinsFromAccountToAccount(
-				ctx, agentDelegatorAccAddr, delegatorAccAddr,
-				sdk.Coins{sdk.NewCoin(defaultBondDenom, rewardAmount)},
-			); err != nil {
-				return err
-			}
-			agent.RewardAmount.Sub(rewardAmount)
-		}
+	rewardAmount := agent.RewardAmount.Mul(removeShares).Quo(agent.Shares)
+	if rewardAmount.IsZero() {
+		return nil
 	}
 
-	if err := k.undelegateAndBurn(ctx, agent, valAddr, undelegateAmt); err != nil {
+	if err := k.sendCoinsFromAccountToAccount(
+		ctx, agentDelegatorAccAddr, delegator,
+		sdk.Coins{sdk.NewCoin(defaultBondDenom, rewardAmount)},
+	); err != nil {
 		return err
 	}
-
-	unbonding := k.GetOrCreateMultiStakingUnbonding(ctx, agent.Id, msg.DelegatorAddress)
-	unbondingTime := k.stakingkeeper.GetParams(ctx).UnbondingTime
-
-	// TODO Whether the length of the entries should be limited ?
-	undelegateCompleteTime := ctx.BlockTime().Add(unbondingTime)
-	unbonding.Entries = append(unbonding.Entries, types.MultiStakingUnbondingEntry{
-		CompletionTime: undelegateCompleteTime,
-		InitialBalance: msg.Amount,
-		Balance:        msg.Amount,
-	})
-
-	k.SetMultiStakingUnbonding(ctx, agent.Id, msg.DelegatorAddress, unbonding)
-
-	agent.Shares = agent.Shares.Sub(removeShares)
-	agent.StakedAmount = agent.StakedAmount.Sub(msg.Amount.Amount)
-
-	k.SetMultiStakingAgent(ctx, agent)
-	k.InsertUBDQueue(ctx, unbonding, undelegateCompleteTime)
+	agent.RewardAmount = agent.RewardAmount.Sub(rewardAmount)
 
 	return nil
 }
 
 func (k Keeper) undelegateAndBurn(ctx sdk.Context, agent *types.MultiStakingAgent, valAddr sdk.ValAddress, undelegateAmt sdk.Coin) error {
+	if !undelegateAmt.IsPositive() {
+		return nil
+	}
+
 	agentDelegateAccAddr := sdk.MustAccAddressFromBech32(agent.DelegateAddress)
 
 	stakedShares, err := k.stakingkeeper.ValidateUnbondAmount(ctx, agentDelegateAccAddr, valAddr, undelegateAmt.Amount)
@@ -173,6 +233,8 @@ func (k Keeper) undelegateAndBurn(ctx sdk.Context, agent *types.MultiStakingAgen
 		return err
 	}
 
+	addAgentDelegatedAmount(agent, undelegationCoins.AmountOf(undelegateAmt.Denom).Neg())
+
 	return nil
 }
 
@@ -208,11 +270,14 @@ func (k Keeper) GetOrCreateMultiStakingAgent(ctx sdk.Context, denom, valAddr str
 		return agent
 	}
 
-	newAgentID := k.GetLatestMultiStakingAgentID(ctx)
+	newAgentID := k.GetLatestMultiStakingAgentID(ctx) + 1
 	newAccount := k.GenerateAccount(ctx, denom, valAddr)
 
+	// the latest id is only bumped here, re-saving an existing agent must not move it.
+	k.SetLatestMultiStakingAgentID(ctx, newAgentID)
+
 	agent = &types.MultiStakingAgent{
-		Id:               newAgentID + 1,
+		Id:               newAgentID,
 		StakeDenom:       denom,
 		DelegateAddress:  newAccount.Address,
 		ValidatorAddress: valAddr,
@@ -220,6 +285,7 @@ func (k Keeper) GetOrCreateMultiStakingAgent(ctx sdk.Context, denom, valAddr str
 		StakedAmount:     math.ZeroInt(),
 		Shares:           math.ZeroInt(),
 		RewardAmount:     math.ZeroInt(),
+		DelegatedAmount:  math.ZeroInt(),
 	}
 
 	return agent
@@ -266,9 +332,12 @@ func (k Keeper) instantUndelegate(
 	return res, nil
 }
 
+// RefreshAgentDelegationAmount adjusts the delegation of agents to the equivalent bond tokens of their
+// staked amount, the minted stake is scaled down if it exceeds the caps of the restaked power.
 func (k Keeper) RefreshAgentDelegationAmount(ctx sdk.Context) {
 	agents := k.GetAllAgent(ctx)
 
+	targets := make([]agentDelegationTarget, 0, len(agents))
 	for i := 0; i < len(agents); i++ {
 		valAddress, err := sdk.ValAddressFromBech32(agents[i].ValidatorAddress)
 		if err != nil {
@@ -280,22 +349,46 @@ func (k Keeper) RefreshAgentDelegationAmount(ctx sdk.Context) {
 			continue
 		}
 
-		var currentAmount math.Int
 		delegator := sdk.MustAccAddressFromBech32(agents[i].DelegateAddress)
 		delegation, found := k.stakingkeeper.GetDelegation(ctx, delegator, valAddress)
 		if !found {
 			continue
-		} else {
-			currentAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
 		}
-		refreshedAmount, _ := k.GetExpectedDelegationAmount(ctx, sdk.NewCoin(agents[i].StakeDenom, agents[i].StakedAmount))
-
-		if refreshedAmount.Amount.GT(currentAmount) {
-			adjustment := refreshedAmount.Amount.Sub(currentAmount)
-			k.mintAndDelegate(ctx, &agents[i], validator, sdk.NewCoin(refreshedAmount.Denom, adjustment))
-		} else if refreshedAmount.Amount.LT(currentAmount) {
-			adjustment := currentAmount.Sub(refreshedAmount.Amount)
-			k.undelegateAndBurn(ctx, &agents[i], valAddress, sdk.NewCoin(refreshedAmount.Denom, adjustment))
+
+		refreshedAmount, err := k.GetExpectedDelegationAmount(ctx, sdk.NewCoin(agents[i].StakeDenom, agents[i].StakedAmount))
+		if err != nil {
+			continue
+		}
+
+		targets = append(targets, agentDelegationTarget{
+			agent:     &agents[i],
+			validator: validator,
+			current:   validator.TokensFromShares(delegation.Shares).RoundInt(),
+			target:    refreshedAmount.Amount,
+		})
+	}
+
+	k.capAgentDelegationTargets(ctx, targets)
+
+	defaultBondDenom := k.stakingkeeper.BondDenom(ctx)
+	for _, t := range targets {
+		if t.target.GT(t.current) {
+			adjustment := t.target.Sub(t.current)
+			// reload the validator, its tokens may be changed by the adjustment of other agents.
+			validator, found := k.stakingkeeper.GetValidator(ctx, t.validator.GetOperator())
+			if !found {
+				continue
+			}
+			if err := k.mintAndDelegate(ctx, t.agent, validator, sdk.NewCoin(defaultBondDenom, adjustment)); err != nil {
+				continue
+			}
+			k.SetMultiStakingAgent(ctx, t.agent)
+		} else if t.target.LT(t.current) {
+			adjustment := t.current.Sub(t.target)
+			if err := k.undelegateAndBurn(ctx, t.agent, t.validator.GetOperator(), sdk.NewCoin(defaultBondDenom, adjustment)); err != nil {
+				continue
+			}
+			k.SetMultiStakingAgent(ctx, t.agent)
 		}
 	}
 }
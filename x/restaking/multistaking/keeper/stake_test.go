@@ -10,6 +10,7 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/celinium-network/celinium/app"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/keeper"
 	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
 )
 
@@ -159,3 +160,92 @@ func (suite *KeeperTestSuite) TestUndelegateReward() {
 
 	// TODO check reward amount
 }
+
+func (suite *KeeperTestSuite) TestUndelegateEntriesLimit() {
+	delegatorAddrs, _ := createValAddrs(1)
+	validators := suite.app.StakingKeeper.GetAllValidators(suite.ctx)
+
+	multiRestakingCoin := sdk.NewCoin(mockMultiRestakingDenom, sdk.NewInt(10000000))
+	suite.mintCoin(multiRestakingCoin, delegatorAddrs[0])
+	suite.app.MultiStakingKeeper.SetMultiStakingDenom(suite.ctx, mockMultiRestakingDenom)
+
+	params := types.DefaultParams()
+	params.MaxUnbondingEntries = 2
+	err := suite.app.MultiStakingKeeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: delegatorAddrs[0].String(),
+		Params:    params,
+	})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.MultiStakingKeeper)
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{
+		Authority: suite.app.MultiStakingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.app.MultiStakingKeeper.GetParams(suite.ctx))
+
+	err = suite.app.MultiStakingKeeper.MultiStakingDelegate(suite.ctx, types.MsgMultiStakingDelegate{
+		DelegatorAddress: delegatorAddrs[0].String(),
+		ValidatorAddress: validators[0].OperatorAddress,
+		Amount:           multiRestakingCoin,
+	})
+	suite.Require().NoError(err)
+
+	undelegateCoin := sdk.NewCoin(mockMultiRestakingDenom, multiRestakingCoin.Amount.QuoRaw(10))
+	undelegate := func() error {
+		return suite.app.MultiStakingKeeper.MultiStakingUndelegate(suite.ctx, &types.MsgMultiStakingUndelegate{
+			DelegatorAddress: delegatorAddrs[0].String(),
+			ValidatorAddress: validators[0].OperatorAddress,
+			Amount:           undelegateCoin,
+		})
+	}
+
+	// the entries in the same block are merged.
+	suite.Require().NoError(undelegate())
+	suite.Require().NoError(undelegate())
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	unbonding, found := suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegatorAddrs[0].String())
+	suite.Require().True(found)
+	suite.Require().Equal(1, len(unbonding.Entries))
+	suite.Require().Equal(undelegateCoin.Amount.MulRaw(2), unbonding.Entries[0].Balance.Amount)
+	suite.Require().Equal(1, len(suite.app.MultiStakingKeeper.GetUBDQueueTimeSlice(suite.ctx, unbonding.Entries[0].CompletionTime)))
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	suite.Require().NoError(undelegate())
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	suite.Require().ErrorIs(undelegate(), types.ErrMaxUnbondingEntries)
+
+	unbonding, _ = suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegatorAddrs[0].String())
+	suite.Require().Equal(2, len(unbonding.Entries))
+}
+
+func (suite *KeeperTestSuite) TestResaveAgentKeepLatestAgentID() {
+	_, valAddrs := createValAddrs(3)
+	k := suite.app.MultiStakingKeeper
+
+	agentA := k.GetOrCreateMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, valAddrs[0].String())
+	k.SetMultiStakingAgent(suite.ctx, agentA)
+	agentB := k.GetOrCreateMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, valAddrs[1].String())
+	k.SetMultiStakingAgent(suite.ctx, agentB)
+	suite.Require().Equal(agentA.Id+1, agentB.Id)
+
+	// re-saving the old agent must not move the latest id backwards.
+	agentA.StakedAmount = agentA.StakedAmount.AddRaw(100)
+	k.SetMultiStakingAgent(suite.ctx, agentA)
+	suite.Require().Equal(agentB.Id, k.GetLatestMultiStakingAgentID(suite.ctx))
+
+	agentC := k.GetOrCreateMultiStakingAgent(suite.ctx, mockMultiRestakingDenom, valAddrs[2].String())
+	k.SetMultiStakingAgent(suite.ctx, agentC)
+	suite.Require().Equal(agentB.Id+1, agentC.Id)
+
+	savedA, found := k.GetMultiStakingAgentByID(suite.ctx, agentA.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(valAddrs[0].String(), savedA.ValidatorAddress)
+	suite.Require().True(savedA.StakedAmount.Equal(math.NewInt(100)))
+	savedB, found := k.GetMultiStakingAgentByID(suite.ctx, agentB.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(valAddrs[1].String(), savedB.ValidatorAddress)
+}
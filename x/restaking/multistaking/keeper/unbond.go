@@ -3,7 +3,9 @@ package keeper
 import (
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
 )
@@ -79,3 +81,99 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delegator string, agentID uin
 
 	return balances, nil
 }
+
+// CancelMultiStakingUnbonding cancels the unbonding entry created at the height, then delegates the cancel
+// amount to the agent again and credits the shares back to delegator.
+func (k Keeper) CancelMultiStakingUnbonding(ctx sdk.Context, msg *types.MsgCancelMultiStakingUnbonding) error {
+	if !k.denomInWhiteList(ctx, msg.Amount.Denom) {
+		return sdkerrors.Wrapf(types.ErrForbidStakingDenom, "denom: %s not in white list", msg.Amount.Denom)
+	}
+
+	agent, found := k.GetMultiStakingAgent(ctx, msg.Amount.Denom, msg.ValidatorAddress)
+	if !found {
+		return types.ErrNotExistedAgent
+	}
+
+	validator, err := k.agentValidator(ctx, agent)
+	if err != nil {
+		return err
+	}
+
+	if validator.IsJailed() {
+		return stakingtypes.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetMultiStakingUnbonding(ctx, agent.Id, msg.DelegatorAddress)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == msg.CreationHeight && !entry.IsMature(ctx.BlockTime()) {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingEntry, "creation height %d", msg.CreationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if msg.Amount.Amount.GT(entry.Balance.Amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidCancelAmount, "amount %s is greater than the unbonding balance %s", msg.Amount, entry.Balance)
+	}
+
+	if err := k.checkDenomCaps(ctx, agent, msg.Amount); err != nil {
+		return err
+	}
+
+	bondTokenAmt, err := k.GetExpectedDelegationAmount(ctx, msg.Amount)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkRestakedPowerCaps(ctx, *validator, bondTokenAmt.Amount); err != nil {
+		return err
+	}
+
+	// the restaked coins are still in the agent's account, only the bond tokens need to be delegated.
+	if err := k.mintAndDelegate(ctx, agent, *validator, bondTokenAmt); err != nil {
+		return err
+	}
+
+	shares := agent.CalculateShares(msg.Amount.Amount)
+	agent.Shares = agent.Shares.Add(shares)
+	agent.StakedAmount = agent.StakedAmount.Add(msg.Amount.Amount)
+	k.SetMultiStakingAgent(ctx, agent)
+	if err := k.IncreaseMultiStakingShares(ctx, shares, agent.Id, msg.DelegatorAddress); err != nil {
+		return err
+	}
+
+	if entry.Balance.Amount.Equal(msg.Amount.Amount) {
+		ubd.RemoveEntry(int64(entryIndex))
+
+		// other entries of the unbonding may complete at the same time.
+		inQueue := false
+		for _, e := range ubd.Entries {
+			if e.CompletionTime.Equal(entry.CompletionTime) {
+				inQueue = true
+				break
+			}
+		}
+		if !inQueue {
+			k.RemoveFromUBDQueue(ctx, ubd, entry.CompletionTime)
+		}
+	} else {
+		ubd.Entries[entryIndex].Balance = entry.Balance.Sub(msg.Amount)
+		ubd.Entries[entryIndex].InitialBalance = entry.InitialBalance.Sub(msg.Amount)
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveMultiStakingUnbonding(ctx, agent.Id, msg.DelegatorAddress)
+	} else {
+		k.SetMultiStakingUnbonding(ctx, agent.Id, msg.DelegatorAddress, ubd)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celinium-network/celinium/x/restaking/multistaking/keeper"
+	"github.com/celinium-network/celinium/x/restaking/multistaking/types"
+)
+
+func (suite *KeeperTestSuite) TestCancelUnbonding() {
+	delegator, validator, unbondingCoin := suite.bootstrapABCITest()
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	unbonding, found := suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegator)
+	suite.Require().True(found)
+	entry := unbonding.Entries[0]
+
+	cancelCoin := sdk.NewCoin(unbondingCoin.Denom, unbondingCoin.Amount.QuoRaw(2))
+	msg := types.MsgCancelMultiStakingUnbonding{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           cancelCoin,
+		CreationHeight:   entry.CreationHeight + 1,
+	}
+	err := suite.app.MultiStakingKeeper.CancelMultiStakingUnbonding(suite.ctx, &msg)
+	suite.Require().ErrorIs(err, types.ErrNoUnbondingEntry)
+
+	msg.CreationHeight = entry.CreationHeight
+	msg.Amount = unbondingCoin.AddAmount(sdk.OneInt())
+	err = suite.app.MultiStakingKeeper.CancelMultiStakingUnbonding(suite.ctx, &msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidCancelAmount)
+
+	msg.Amount = cancelCoin
+	err = suite.app.MultiStakingKeeper.CancelMultiStakingUnbonding(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	agent, _ := suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	suite.Require().Equal(cancelCoin.Amount, agent.StakedAmount)
+	suite.Require().Equal(cancelCoin.Amount, suite.app.MultiStakingKeeper.GetMultiStakingShares(suite.ctx, agentID, delegator))
+
+	unbonding, found = suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegator)
+	suite.Require().True(found)
+	suite.Require().Equal(unbondingCoin.Sub(cancelCoin), unbonding.Entries[0].Balance)
+	suite.Require().Equal(1, len(suite.app.MultiStakingKeeper.GetUBDQueueTimeSlice(suite.ctx, entry.CompletionTime)))
+
+	_, broken := keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// cancel the remaining balance of the entry.
+	err = suite.app.MultiStakingKeeper.CancelMultiStakingUnbonding(suite.ctx, &msg)
+	suite.Require().NoError(err)
+
+	_, found = suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegator)
+	suite.Require().False(found)
+	suite.Require().Equal(0, len(suite.app.MultiStakingKeeper.GetUBDQueueTimeSlice(suite.ctx, entry.CompletionTime)))
+
+	agent, _ = suite.app.MultiStakingKeeper.GetMultiStakingAgentByID(suite.ctx, agentID)
+	suite.Require().Equal(unbondingCoin.Amount, agent.StakedAmount)
+
+	_, broken = keeper.AllInvariants(suite.app.MultiStakingKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestCancelUnbondingCaps() {
+	delegator, validator, unbondingCoin := suite.bootstrapABCITest()
+
+	agentID := suite.app.MultiStakingKeeper.GetLatestMultiStakingAgentID(suite.ctx)
+	unbonding, found := suite.app.MultiStakingKeeper.GetMultiStakingUnbonding(suite.ctx, agentID, delegator)
+	suite.Require().True(found)
+
+	cancelCoin := sdk.NewCoin(unbondingCoin.Denom, unbondingCoin.Amount.QuoRaw(2))
+	msgServer := keeper.NewMsgServerImpl(suite.app.MultiStakingKeeper)
+	cancel := func() error {
+		_, err := msgServer.CancelMultiStakingUnbonding(sdk.WrapSDKContext(suite.ctx), &types.MsgCancelMultiStakingUnbonding{
+			DelegatorAddress: delegator,
+			ValidatorAddress: validator,
+			Amount:           cancelCoin,
+			CreationHeight:   unbonding.Entries[0].CreationHeight,
+		})
+		return err
+	}
+
+	// the canceled amount is delegated again, so the caps apply.
+	suite.app.MultiStakingKeeper.SetMultiStakingDenomConfig(suite.ctx, types.NewMultiStakingDenomConfig(
+		mockMultiRestakingDenom, cancelCoin.Amount.SubRaw(1), sdk.ZeroDec(), sdk.ZeroInt(),
+	))
+	suite.Require().ErrorIs(cancel(), types.ErrExceedDenomCap)
+	suite.app.MultiStakingKeeper.DeleteMultiStakingDenomConfig(suite.ctx, mockMultiRestakingDenom)
+
+	params := suite.app.MultiStakingKeeper.GetParams(suite.ctx)
+	params.MaxValidatorRestakedPowerShare = sdk.NewDecWithPrec(1, 6)
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+	suite.Require().ErrorIs(cancel(), types.ErrExceedRestakedPowerCap)
+
+	params.MaxValidatorRestakedPowerShare = sdk.ZeroDec()
+	suite.Require().NoError(suite.app.MultiStakingKeeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(cancel())
+
+	// the unbonding of a removed denom can't be canceled.
+	err := suite.app.MultiStakingKeeper.RemoveMultiStakingDenom(suite.ctx, &types.MsgRemoveMultiStakingDenom{
+		Authority: suite.app.MultiStakingKeeper.GetAuthority(),
+		Denom:     mockMultiRestakingDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(cancel(), types.ErrForbidStakingDenom)
+}
@@ -1,7 +1,9 @@
 package multistaking
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -33,8 +35,8 @@ func NewAppModuleBasic(cdc codec.Codec) AppModuleBasic {
 }
 
 // DefaultGenesis implements module.AppModuleBasic
-func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
-	return nil
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
 // GetQueryCmd implements module.AppModuleBasic
@@ -54,19 +56,29 @@ func (AppModuleBasic) Name() string {
 
 // RegisterGRPCGatewayRoutes implements module.AppModuleBasic
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInterfaces implements module.AppModuleBasic
 func (AppModuleBasic) RegisterInterfaces(reg codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
 }
 
 // RegisterLegacyAminoCodec implements module.AppModuleBasic
-func (AppModuleBasic) RegisterLegacyAminoCodec(*codec.LegacyAmino) {
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
 }
 
 // ValidateGenesis implements module.AppModuleBasic
-func (AppModuleBasic) ValidateGenesis(codec.JSONCodec, client.TxEncodingConfig, json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return genState.Validate()
 }
 
 type AppModule struct {
@@ -82,8 +94,9 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper) AppModule {
 }
 
 // EndBlock implements module.EndBlockAppModule
-func (AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return nil
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	validatorUpdates, _ := am.keeper.EndBlocker(ctx)
+	return validatorUpdates
 }
 
 // BeginBlock implements module.BeginBlockAppModule
@@ -91,18 +104,23 @@ func (AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {
 }
 
 // ExportGenesis implements module.AppModule
-func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return nil
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(genState)
 }
 
 // InitGenesis implements module.AppModule
-func (AppModule) InitGenesis(sdk.Context, codec.JSONCodec, json.RawMessage) []abci.ValidatorUpdate {
-	return nil
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+
+	InitGenesis(ctx, am.keeper, genState)
+	return []abci.ValidatorUpdate{}
 }
 
 // ConsensusVersion implements module.AppModule
 func (AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 2
 }
 
 // LegacyQuerierHandler implements module.AppModule
@@ -116,11 +134,19 @@ func (AppModule) QuerierRoute() string {
 }
 
 // RegisterInvariants implements module.AppModule
-func (AppModule) RegisterInvariants(sdk.InvariantRegistry) {
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices implements module.AppModule
-func (AppModule) RegisterServices(module.Configurator) {
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.Querier{Keeper: am.keeper})
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // Route implements module.AppModule
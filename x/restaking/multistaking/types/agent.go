@@ -18,5 +18,5 @@ func (ma MultiStakingAgent) CalculateCoins(shareAmt math.Int) math.Int {
 		return math.ZeroInt()
 	}
 
-	return sdk.NewDecFromInt(shareAmt).QuoInt(ma.Shares).MulInt(ma.StakedAmount).TruncateInt()
+	return shareAmt.Mul(ma.StakedAmount).Quo(ma.Shares)
 }
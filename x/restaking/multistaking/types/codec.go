@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// RegisterLegacyAminoCodec registers the multistaking messages on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddMultiStakingDenom{}, "multistaking/MsgAddMultiStakingDenom", nil)
+	cdc.RegisterConcrete(&MsgRemoveMultiStakingDenom{}, "multistaking/MsgRemoveMultiStakingDenom", nil)
+	cdc.RegisterConcrete(&MsgMultiStakingDelegate{}, "multistaking/MsgMultiStakingDelegate", nil)
+	cdc.RegisterConcrete(&MsgMultiStakingUndelegate{}, "multistaking/MsgMultiStakingUndelegate", nil)
+	cdc.RegisterConcrete(&MsgMultiStakingBeginRedelegate{}, "multistaking/MsgMultiStakingBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgCancelMultiStakingUnbonding{}, "multistaking/MsgCancelMultiStakingUnbonding", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "multistaking/MsgUpdateParams", nil)
+}
+
+// RegisterInterfaces registers the multistaking messages on the provided InterfaceRegistry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgAddMultiStakingDenom{},
+		&MsgRemoveMultiStakingDenom{},
+		&MsgMultiStakingDelegate{},
+		&MsgMultiStakingUndelegate{},
+		&MsgMultiStakingBeginRedelegate{},
+		&MsgCancelMultiStakingUnbonding{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
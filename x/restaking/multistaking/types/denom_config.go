@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMultiStakingDenomConfig creates the config of denom, the nil cap is regarded as no cap.
+func NewMultiStakingDenomConfig(denom string, maxTotalAmount math.Int, maxValidatorPowerShare sdk.Dec, minDelegation math.Int) MultiStakingDenomConfig {
+	if maxTotalAmount.IsNil() {
+		maxTotalAmount = math.ZeroInt()
+	}
+
+	if maxValidatorPowerShare.IsNil() {
+		maxValidatorPowerShare = sdk.ZeroDec()
+	}
+
+	if minDelegation.IsNil() {
+		minDelegation = math.ZeroInt()
+	}
+
+	return MultiStakingDenomConfig{
+		Denom:                  denom,
+		MaxTotalAmount:         maxTotalAmount,
+		MaxValidatorPowerShare: maxValidatorPowerShare,
+		MinDelegation:          minDelegation,
+	}
+}
+
+// Validate validates the config of denom
+func (c MultiStakingDenomConfig) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+
+	if c.MaxTotalAmount.IsNegative() {
+		return fmt.Errorf("max total amount must be non-negative")
+	}
+
+	if c.MaxValidatorPowerShare.IsNegative() || c.MaxValidatorPowerShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("max validator power share must be in [0, 1]")
+	}
+
+	if c.MinDelegation.IsNegative() {
+		return fmt.Errorf("min delegation must be non-negative")
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
+import appparams "github.com/celinium-network/celinium/app/params"
+
 const (
-	RefreshAgentDelegationEpochID    = "MultiStakingRefreshAgentDelegation"
-	CollectAgentStakingRewardEpochID = "MultiStakingCollectReward"
+	RefreshAgentDelegationEpochID    = appparams.RefreshAgentDelegationEpochIdentifier
+	CollectAgentStakingRewardEpochID = appparams.CollectAgentRewardEpochIdentifier
 )
@@ -5,10 +5,22 @@ import (
 )
 
 var (
-	ErrForbidStakingDenom    = sdkioerrors.Register(ModuleName, 1, "The denom is forbidden in multistaking module")
-	ErrNotExistedValidator   = sdkioerrors.Register(ModuleName, 2, "The validator is not exist")
-	ErrInsufficientShares    = sdkioerrors.Register(ModuleName, 3, "The shares is insufficient")
-	ErrNotExistedAgent       = sdkioerrors.Register(ModuleName, 4, "The validator has't multistaking agent")
-	ErrNoUnbondingDelegation = sdkioerrors.Register(ModuleName, 5, "The unbonding delegation is not existed")
-	ErrNoShares              = sdkioerrors.Register(ModuleName, 6, "The user has't shares in this agent")
+	ErrForbidStakingDenom     = sdkioerrors.Register(ModuleName, 1, "The denom is forbidden in multistaking module")
+	ErrNotExistedValidator    = sdkioerrors.Register(ModuleName, 2, "The validator is not exist")
+	ErrInsufficientShares     = sdkioerrors.Register(ModuleName, 3, "The shares is insufficient")
+	ErrNotExistedAgent        = sdkioerrors.Register(ModuleName, 4, "The validator has't multistaking agent")
+	ErrNoUnbondingDelegation  = sdkioerrors.Register(ModuleName, 5, "The unbonding delegation is not existed")
+	ErrNoShares               = sdkioerrors.Register(ModuleName, 6, "The user has't shares in this agent")
+	ErrSelfRedelegation       = sdkioerrors.Register(ModuleName, 7, "The source and destination validator of redelegation are the same")
+	ErrTransitiveRedelegation = sdkioerrors.Register(ModuleName, 8, "The redelegation to the source agent is not completed")
+	ErrNoUnbondingEntry       = sdkioerrors.Register(ModuleName, 9, "The unbonding entry is not existed")
+	ErrInvalidCancelAmount    = sdkioerrors.Register(ModuleName, 10, "The cancel amount is invalid")
+	ErrUnauthorized           = sdkioerrors.Register(ModuleName, 11, "The signer is not the authority")
+	ErrInvalidParams          = sdkioerrors.Register(ModuleName, 12, "The params are invalid")
+	ErrMaxUnbondingEntries    = sdkioerrors.Register(ModuleName, 13, "Too many unbonding entries for (agent, delegator) pair")
+	ErrBelowMinDelegation     = sdkioerrors.Register(ModuleName, 14, "The delegation is less than the min delegation of denom")
+	ErrExceedDenomCap         = sdkioerrors.Register(ModuleName, 15, "The delegation exceeds the cap of denom")
+	ErrInvalidDenomConfig     = sdkioerrors.Register(ModuleName, 16, "The config of denom is invalid")
+	ErrExceedRestakedPowerCap = sdkioerrors.Register(ModuleName, 17, "The delegation exceeds the cap of restaked power")
+	ErrMaxRedelegationEntries = sdkioerrors.Register(ModuleName, 18, "Too many redelegation entries for (src agent, dst agent, delegator) triplet")
 )
@@ -0,0 +1,21 @@
+package types
+
+// multistaking module event types
+const (
+	EventTypeSlash            = "multistaking_slash"
+	EventTypeAddDenom         = "add_multistaking_denom"
+	EventTypeRemoveDenom      = "remove_multistaking_denom"
+	EventTypeForceUnbondDenom = "force_unbond_multistaking_denom"
+	EventTypeUpdateParams     = "update_multistaking_params"
+
+	AttributeKeyAgentID     = "agent_id"
+	AttributeKeyValidator   = "validator"
+	AttributeKeySlashAmount = "slash_amount"
+	AttributeKeyRecipient   = "recipient"
+	AttributeKeyDenom       = "denom"
+	AttributeKeyUnbondTime  = "unbond_time"
+	AttributeKeySkipped     = "skipped_positions"
+
+	// AttributeValueBurned is the recipient of slashed coins when they are burned.
+	AttributeValueBurned = "burned"
+)
@@ -3,6 +3,7 @@ package types
 import (
 	time "time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/celinium-network/celinium/utils"
@@ -22,9 +23,27 @@ const (
 )
 
 var (
+	// Key for the params of multistaking module
+	ParamsKey = []byte{0x01}
+
 	// Key for the denom white list which allow used for multistaking
 	MultiStakingDenomWhiteListKey = []byte{0x11}
 
+	// Prefix for key which used in `denom => MultiStakingDenomConfig`
+	MultiStakingDenomConfigPrefix = []byte{0x12}
+
+	// Prefix for key which used in `denom => MultiStakingDenomRemoval`
+	MultiStakingDenomRemovalPrefix = []byte{0x13}
+
+	// Prefix for key which used in `denom => total staked amount of the agents of denom`
+	MultiStakingDenomTotalPrefix = []byte{0x14}
+
+	// Prefix for key which used in `{denom + agent_id + delegator_address} => failed attempts of force unbond`
+	MultiStakingDenomRemovalFailurePrefix = []byte{0x15}
+
+	// Key for the total bond tokens which the agents delegated to the bonded validators
+	MultiStakingBondedRestakedTotalKey = []byte{0x16}
+
 	// Prefix for key which used in `{denom + validator_address} => MultiStakingAgent's ID`
 	MultiStakingAgentIDPrefix = []byte{0x21}
 
@@ -38,10 +57,24 @@ var (
 
 	MultiStakingUnbondingQueueKey = []byte{0x32}
 
+	// Prefix for key which used in `{src_agent_id + dst_agent_id + delegator_address} => MultiStakingRedelegation`
+	MultiStakingRedelegationPrefix = []byte{0x33}
+
+	// Prefix for key which used in `{delegator_address + dst_agent_id + src_agent_id} => nil`
+	MultiStakingRedelegationByDstIndexPrefix = []byte{0x34}
+
+	MultiStakingRedelegationQueueKey = []byte{0x35}
+
 	// Prefix for key which used in `{agent_id + delegator_address} => shares_amount`
 	MultiStakingSharesPrefix = []byte{0x41}
+
+	// Prefix for key which used in `denom => MultiStakingSlashRecord`
+	MultiStakingSlashRecordPrefix = []byte{0x51}
 )
 
+// SlashRecipientCommunityPool is the slash recipient which funds the slashed coins to the community pool.
+const SlashRecipientCommunityPool = "community_pool"
+
 func GetMultiStakingAgentIDKey(denom, valAddr string) []byte {
 	denomBz := utils.BytesLengthPrefix([]byte(denom))
 	valAddrBz := utils.BytesLengthPrefix([]byte(valAddr))
@@ -59,6 +92,32 @@ func GetMultiStakingAgentIDKey(denom, valAddr string) []byte {
 	return bz
 }
 
+func GetMultiStakingDenomConfigKey(denom string) []byte {
+	return append(MultiStakingDenomConfigPrefix, []byte(denom)...)
+}
+
+func GetMultiStakingDenomRemovalKey(denom string) []byte {
+	return append(MultiStakingDenomRemovalPrefix, []byte(denom)...)
+}
+
+func GetMultiStakingDenomTotalKey(denom string) []byte {
+	return append(MultiStakingDenomTotalPrefix, []byte(denom)...)
+}
+
+func GetMultiStakingDenomRemovalFailureKey(denom string, agentID uint64, delegator string) []byte {
+	bz := GetMultiStakingDenomRemovalFailurePrefix(denom)
+	bz = append(bz, sdk.Uint64ToBigEndian(agentID)...)
+	return append(bz, utils.BytesLengthPrefix([]byte(delegator))...)
+}
+
+func GetMultiStakingDenomRemovalFailurePrefix(denom string) []byte {
+	return append(MultiStakingDenomRemovalFailurePrefix, utils.BytesLengthPrefix([]byte(denom))...)
+}
+
+func GetMultiStakingAgentIDsByDenomPrefix(denom string) []byte {
+	return append(MultiStakingAgentIDPrefix, utils.BytesLengthPrefix([]byte(denom))...)
+}
+
 func GetMultiStakingAgentKey(agentID uint64) []byte {
 	idBz := sdk.Uint64ToBigEndian(agentID)
 	return append(MultiStakingAgentPrefix, idBz...)
@@ -77,6 +136,10 @@ func GetMultiStakingSharesKey(agentID uint64, delegator string) []byte {
 	return bz
 }
 
+func GetMultiStakingSharesAgentPrefix(agentID uint64) []byte {
+	return append(MultiStakingSharesPrefix, sdk.Uint64ToBigEndian(agentID)...)
+}
+
 func GetMultiStakingUnbondingKey(agentID uint64, delegator string) []byte {
 	idBz := sdk.Uint64ToBigEndian(agentID)
 	delegatorBz := utils.BytesLengthPrefix([]byte(delegator))
@@ -91,11 +154,76 @@ func GetMultiStakingUnbondingKey(agentID uint64, delegator string) []byte {
 	return bz
 }
 
+func GetMultiStakingUnbondingAgentPrefix(agentID uint64) []byte {
+	idBz := sdk.Uint64ToBigEndian(agentID)
+	return append(MultiStakingUnbondingPrefix, idBz...)
+}
+
+func GetMultiStakingRedelegationKey(srcAgentID, dstAgentID uint64, delegator string) []byte {
+	bz := GetMultiStakingRedelegationsFromSrcPrefix(srcAgentID)
+	bz = append(bz, sdk.Uint64ToBigEndian(dstAgentID)...)
+	return append(bz, utils.BytesLengthPrefix([]byte(delegator))...)
+}
+
+func GetMultiStakingRedelegationsFromSrcPrefix(srcAgentID uint64) []byte {
+	return append(MultiStakingRedelegationPrefix, sdk.Uint64ToBigEndian(srcAgentID)...)
+}
+
+func GetMultiStakingRedelegationByDstIndexKey(delegator string, dstAgentID, srcAgentID uint64) []byte {
+	bz := GetMultiStakingRedelegationsToDstPrefix(delegator, dstAgentID)
+	return append(bz, sdk.Uint64ToBigEndian(srcAgentID)...)
+}
+
+func GetMultiStakingRedelegationsToDstPrefix(delegator string, dstAgentID uint64) []byte {
+	bz := append(MultiStakingRedelegationByDstIndexPrefix, utils.BytesLengthPrefix([]byte(delegator))...)
+	return append(bz, sdk.Uint64ToBigEndian(dstAgentID)...)
+}
+
+func GetMultiStakingRedelegationTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(MultiStakingRedelegationQueueKey, bz...)
+}
+
+func GetMultiStakingSlashRecordKey(denom string) []byte {
+	return append(MultiStakingSlashRecordPrefix, []byte(denom)...)
+}
+
 func GetMultiStakingUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 	bz := sdk.FormatTimeBytes(timestamp)
 	return append(MultiStakingUnbondingQueueKey, bz...)
 }
 
+// AddEntry appends a new entry, or merges the amount into the entry created at the same height
+// which has the same completion time. Return true if it's merged.
+func (ubd *MultiStakingUnbonding) AddEntry(creationHeight int64, completionTime time.Time, balance sdk.Coin) bool {
+	for i := 0; i < len(ubd.Entries); i++ {
+		entry := &ubd.Entries[i]
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(completionTime) {
+			entry.InitialBalance = entry.InitialBalance.Add(balance)
+			entry.Balance = entry.Balance.Add(balance)
+			return true
+		}
+	}
+
+	ubd.Entries = append(ubd.Entries, MultiStakingUnbondingEntry{
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+		Balance:        balance,
+		CreationHeight: creationHeight,
+	})
+	return false
+}
+
+// HasEntry returns true if there is an entry which the new entry can be merged into.
+func (ubd *MultiStakingUnbonding) HasEntry(creationHeight int64, completionTime time.Time) bool {
+	for _, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(completionTime) {
+			return true
+		}
+	}
+	return false
+}
+
 func (ubd *MultiStakingUnbonding) RemoveEntry(i int64) {
 	ubd.Entries = append(ubd.Entries[:i], ubd.Entries[i+1:]...)
 }
@@ -103,3 +231,42 @@ func (ubd *MultiStakingUnbonding) RemoveEntry(i int64) {
 func (e MultiStakingUnbondingEntry) IsMature(currentTime time.Time) bool {
 	return !e.CompletionTime.After(currentTime)
 }
+
+// AddEntry appends a new entry, or merges the balance and shares into the entry created at the same height
+// which has the same completion time. Return true if it's merged.
+func (red *MultiStakingRedelegation) AddEntry(creationHeight int64, completionTime time.Time, balance sdk.Coin, sharesDst math.Int) bool {
+	for i := 0; i < len(red.Entries); i++ {
+		entry := &red.Entries[i]
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(completionTime) {
+			entry.InitialBalance = entry.InitialBalance.Add(balance)
+			entry.SharesDst = entry.SharesDst.Add(sharesDst)
+			return true
+		}
+	}
+
+	red.Entries = append(red.Entries, MultiStakingRedelegationEntry{
+		CreationHeight: creationHeight,
+		CompletionTime: completionTime,
+		InitialBalance: balance,
+		SharesDst:      sharesDst,
+	})
+	return false
+}
+
+// HasEntry returns true if there is an entry which the new entry can be merged into.
+func (red *MultiStakingRedelegation) HasEntry(creationHeight int64, completionTime time.Time) bool {
+	for _, entry := range red.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(completionTime) {
+			return true
+		}
+	}
+	return false
+}
+
+func (red *MultiStakingRedelegation) RemoveEntry(i int64) {
+	red.Entries = append(red.Entries[:i], red.Entries[i+1:]...)
+}
+
+func (e MultiStakingRedelegationEntry) IsMature(currentTime time.Time) bool {
+	return !e.CompletionTime.After(currentTime)
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAddMultiStakingDenom{}
+	_ sdk.Msg = &MsgRemoveMultiStakingDenom{}
+	_ sdk.Msg = &MsgMultiStakingDelegate{}
+	_ sdk.Msg = &MsgMultiStakingUndelegate{}
+	_ sdk.Msg = &MsgMultiStakingBeginRedelegate{}
+	_ sdk.Msg = &MsgCancelMultiStakingUnbonding{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// GetSigners implements types.Msg
+func (msg *MsgAddMultiStakingDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgAddMultiStakingDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return NewMultiStakingDenomConfig(msg.Deonm, msg.MaxTotalAmount, msg.MaxValidatorPowerShare, msg.MinDelegation).Validate()
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgRemoveMultiStakingDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgRemoveMultiStakingDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgMultiStakingDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.DelegatorAddress)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgMultiStakingDelegate) ValidateBasic() error {
+	return validateDelegation(msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount)
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgMultiStakingUndelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.DelegatorAddress)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgMultiStakingUndelegate) ValidateBasic() error {
+	return validateDelegation(msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount)
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgMultiStakingBeginRedelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.DelegatorAddress)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgMultiStakingBeginRedelegate) ValidateBasic() error {
+	if err := validateDelegation(msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress); err != nil {
+		return err
+	}
+
+	if msg.ValidatorSrcAddress == msg.ValidatorDstAddress {
+		return ErrSelfRedelegation
+	}
+
+	return nil
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgCancelMultiStakingUnbonding) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.DelegatorAddress)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgCancelMultiStakingUnbonding) ValidateBasic() error {
+	if err := validateDelegation(msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount); err != nil {
+		return err
+	}
+
+	if msg.CreationHeight <= 0 {
+		return fmt.Errorf("creation height must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners implements types.Msg
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+// ValidateBasic implements types.Msg
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Params.Validate()
+}
+
+func validateDelegation(delegator, validator string, amount sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return fmt.Errorf("invalid amount: %s", amount)
+	}
+
+	return nil
+}
@@ -73,6 +73,119 @@ func (m *MultiStakingDenomWhiteList) GetDenomList() []string {
 	return nil
 }
 
+// MultiStakingDenomConfig defines the caps of a whitelisted denom, zero means no cap.
+type MultiStakingDenomConfig struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the max total restaked amount of the denom.
+	MaxTotalAmount Int `protobuf:"bytes,2,opt,name=max_total_amount,json=maxTotalAmount,proto3,customtype=Int" json:"max_total_amount"`
+	// the max share of a validator's voting power which comes from the denom.
+	MaxValidatorPowerShare Dec `protobuf:"bytes,3,opt,name=max_validator_power_share,json=maxValidatorPowerShare,proto3,customtype=Dec" json:"max_validator_power_share"`
+	// the min amount of each delegation.
+	MinDelegation Int `protobuf:"bytes,4,opt,name=min_delegation,json=minDelegation,proto3,customtype=Int" json:"min_delegation"`
+}
+
+func (m *MultiStakingDenomConfig) Reset()         { *m = MultiStakingDenomConfig{} }
+func (m *MultiStakingDenomConfig) String() string { return proto.CompactTextString(m) }
+func (*MultiStakingDenomConfig) ProtoMessage()    {}
+func (*MultiStakingDenomConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1f1a8026a27605f, []int{1}
+}
+func (m *MultiStakingDenomConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakingDenomConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakingDenomConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStakingDenomConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakingDenomConfig.Merge(m, src)
+}
+func (m *MultiStakingDenomConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakingDenomConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakingDenomConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakingDenomConfig proto.InternalMessageInfo
+
+func (m *MultiStakingDenomConfig) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MultiStakingDenomRemoval records a removed denom whose positions are force unbonded at unbond_time.
+type MultiStakingDenomRemoval struct {
+	Denom      string    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	UnbondTime time.Time `protobuf:"bytes,2,opt,name=unbond_time,json=unbondTime,proto3,stdtime" json:"unbond_time"`
+	// next_key is the shares key where the force unbonding continues in the next block.
+	NextKey []byte `protobuf:"bytes,3,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *MultiStakingDenomRemoval) Reset()         { *m = MultiStakingDenomRemoval{} }
+func (m *MultiStakingDenomRemoval) String() string { return proto.CompactTextString(m) }
+func (*MultiStakingDenomRemoval) ProtoMessage()    {}
+func (*MultiStakingDenomRemoval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1f1a8026a27605f, []int{2}
+}
+func (m *MultiStakingDenomRemoval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakingDenomRemoval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakingDenomRemoval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStakingDenomRemoval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakingDenomRemoval.Merge(m, src)
+}
+func (m *MultiStakingDenomRemoval) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakingDenomRemoval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakingDenomRemoval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakingDenomRemoval proto.InternalMessageInfo
+
+func (m *MultiStakingDenomRemoval) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MultiStakingDenomRemoval) GetUnbondTime() time.Time {
+	if m != nil {
+		return m.UnbondTime
+	}
+	return time.Time{}
+}
+
+func (m *MultiStakingDenomRemoval) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
 type EquivalentMultiplierRecord struct {
 	EpochNumber int64  `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -83,7 +196,7 @@ func (m *EquivalentMultiplierRecord) Reset()         { *m = EquivalentMultiplier
 func (m *EquivalentMultiplierRecord) String() string { return proto.CompactTextString(m) }
 func (*EquivalentMultiplierRecord) ProtoMessage()    {}
 func (*EquivalentMultiplierRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1f1a8026a27605f, []int{1}
+	return fileDescriptor_d1f1a8026a27605f, []int{3}
 }
 func (m *EquivalentMultiplierRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +250,7 @@ func (m *MultiStakingDelegation) Reset()         { *m = MultiStakingDelegation{}
 func (m *MultiStakingDelegation) String() string { return proto.CompactTextString(m) }
 func (*MultiStakingDelegation) ProtoMessage()    {}
 func (*MultiStakingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1f1a8026a27605f, []int{2}
+	return fileDescriptor_d1f1a8026a27605f, []int{4}
 }
 func (m *MultiStakingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,13 +316,15 @@ type MultiStakingAgent struct {
 	StakedAmount     Int    `protobuf:"bytes,6,opt,name=staked_amount,json=stakedAmount,proto3,customtype=Int" json:"staked_amount"`
 	Shares           Int    `protobuf:"bytes,7,opt,name=shares,proto3,customtype=Int" json:"shares"`
 	RewardAmount     Int    `protobuf:"bytes,8,opt,name=reward_amount,json=rewardAmount,proto3,customtype=Int" json:"reward_amount"`
+	// the bond tokens delegated to the validator by the agent.
+	DelegatedAmount Int `protobuf:"bytes,9,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=Int" json:"delegated_amount"`
 }
 
 func (m *MultiStakingAgent) Reset()         { *m = MultiStakingAgent{} }
 func (m *MultiStakingAgent) String() string { return proto.CompactTextString(m) }
 func (*MultiStakingAgent) ProtoMessage()    {}
 func (*MultiStakingAgent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1f1a8026a27605f, []int{3}
+	return fileDescriptor_d1f1a8026a27605f, []int{5}
 }
 func (m *MultiStakingAgent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +398,7 @@ func (m *MultiStakingUnbonding) Reset()         { *m = MultiStakingUnbonding{} }
 func (m *MultiStakingUnbonding) String() string { return proto.CompactTextString(m) }
 func (*MultiStakingUnbonding) ProtoMessage()    {}
 func (*MultiStakingUnbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1f1a8026a27605f, []int{4}
+	return fileDescriptor_d1f1a8026a27605f, []int{6}
 }
 func (m *MultiStakingUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,13 +452,14 @@ type MultiStakingUnbondingEntry struct {
 	CompletionTime time.Time  `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 	InitialBalance types.Coin `protobuf:"bytes,2,opt,name=initial_balance,json=initialBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"initial_balance"`
 	Balance        types.Coin `protobuf:"bytes,3,opt,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
+	CreationHeight int64      `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 }
 
 func (m *MultiStakingUnbondingEntry) Reset()         { *m = MultiStakingUnbondingEntry{} }
 func (m *MultiStakingUnbondingEntry) String() string { return proto.CompactTextString(m) }
 func (*MultiStakingUnbondingEntry) ProtoMessage()    {}
 func (*MultiStakingUnbondingEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1f1a8026a27605f, []int{5}
+	return fileDescriptor_d1f1a8026a27605f, []int{7}
 }
 func (m *MultiStakingUnbondingEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,6 +509,13 @@ func (m *MultiStakingUnbondingEntry) GetBalance() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MultiStakingUnbondingEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
 type DAPair struct {
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	AgentId          uint64 `protobuf:"varint,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
@@ -402,7 +525,7 @@ func (m *DAPair) Reset()         { *m = DAPair{} }
 func (m *DAPair) String() string { return proto.CompactTextString(m) }
 func (*DAPair) ProtoMessage()    {}
 func (*DAPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1f1a8026a27605f, []int{6}
+	return fileDescriptor_d1f1a8026a27605f, []int{8}
 }
 func (m *DAPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +576,7 @@ func (m *DAPairs) Reset()         { *m = DAPairs{} }
 func (m *DAPairs) String() string { return proto.CompactTextString(m) }
 func (*DAPairs) ProtoMessage()    {}
 func (*DAPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1f1a8026a27605f, []int{7}
+	return fileDescriptor_d1f1a8026a27605f, []int{9}
 }
 func (m *DAPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -489,300 +612,394 @@ func (m *DAPairs) GetPairs() []DAPair {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MultiStakingDenomWhiteList)(nil), "celinium.restaking.multistake.v1.MultiStakingDenomWhiteList")
-	proto.RegisterType((*EquivalentMultiplierRecord)(nil), "celinium.restaking.multistake.v1.EquivalentMultiplierRecord")
-	proto.RegisterType((*MultiStakingDelegation)(nil), "celinium.restaking.multistake.v1.MultiStakingDelegation")
-	proto.RegisterType((*MultiStakingAgent)(nil), "celinium.restaking.multistake.v1.MultiStakingAgent")
-	proto.RegisterType((*MultiStakingUnbonding)(nil), "celinium.restaking.multistake.v1.MultiStakingUnbonding")
-	proto.RegisterType((*MultiStakingUnbondingEntry)(nil), "celinium.restaking.multistake.v1.MultiStakingUnbondingEntry")
-	proto.RegisterType((*DAPair)(nil), "celinium.restaking.multistake.v1.DAPair")
-	proto.RegisterType((*DAPairs)(nil), "celinium.restaking.multistake.v1.DAPairs")
+type MultiStakingRedelegation struct {
+	DelegatorAddress string                          `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SrcAgentId       uint64                          `protobuf:"varint,2,opt,name=src_agent_id,json=srcAgentId,proto3" json:"src_agent_id,omitempty"`
+	DstAgentId       uint64                          `protobuf:"varint,3,opt,name=dst_agent_id,json=dstAgentId,proto3" json:"dst_agent_id,omitempty"`
+	Entries          []MultiStakingRedelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
 }
 
-func init() {
-	proto.RegisterFile("celinium/restaking/multistake/v1/multistake.proto", fileDescriptor_d1f1a8026a27605f)
+func (m *MultiStakingRedelegation) Reset()         { *m = MultiStakingRedelegation{} }
+func (m *MultiStakingRedelegation) String() string { return proto.CompactTextString(m) }
+func (*MultiStakingRedelegation) ProtoMessage()    {}
+func (*MultiStakingRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1f1a8026a27605f, []int{10}
 }
-
-var fileDescriptor_d1f1a8026a27605f = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6a, 0xfb, 0x46,
-	0x10, 0xb7, 0x6c, 0xc7, 0x8e, 0xd7, 0x69, 0x3e, 0x96, 0x34, 0xd8, 0x86, 0xda, 0xae, 0x2f, 0x31,
-	0x84, 0x48, 0xd8, 0x3d, 0x95, 0x96, 0x82, 0x1d, 0xe7, 0x10, 0x68, 0xda, 0xa2, 0xb4, 0x14, 0x4a,
-	0x41, 0xac, 0xa4, 0xad, 0xbc, 0x8d, 0xb4, 0xeb, 0x4a, 0x6b, 0xbb, 0xb9, 0xf5, 0x11, 0xf2, 0x1c,
-	0x3d, 0xe7, 0x19, 0x4a, 0x8e, 0x21, 0x97, 0x96, 0x1e, 0x92, 0x92, 0xdc, 0xfb, 0x0c, 0x7f, 0x76,
-	0x57, 0x52, 0x64, 0x62, 0x10, 0x81, 0x9c, 0xa4, 0xf9, 0xfe, 0xcd, 0xfc, 0x46, 0x23, 0x30, 0x70,
-	0xb0, 0x4f, 0x28, 0x99, 0x07, 0x46, 0x88, 0x23, 0x8e, 0x2e, 0x09, 0xf5, 0x8c, 0x60, 0xee, 0x73,
-	0x22, 0x04, 0x6c, 0x2c, 0x06, 0x19, 0x49, 0x9f, 0x85, 0x8c, 0x33, 0xd8, 0x4d, 0x42, 0xf4, 0x34,
-	0x44, 0xcf, 0x38, 0x2d, 0x06, 0xad, 0x8e, 0xc7, 0x98, 0xe7, 0x63, 0x43, 0xfa, 0xdb, 0xf3, 0x5f,
-	0x0c, 0x4e, 0x02, 0xe1, 0x1a, 0xcc, 0x54, 0x8a, 0xd6, 0xbe, 0xc7, 0x3c, 0x26, 0x5f, 0x0d, 0xf1,
-	0x16, 0x6b, 0x9b, 0x0e, 0x8b, 0x02, 0x16, 0x59, 0xca, 0xa0, 0x84, 0xd8, 0xd4, 0x56, 0x92, 0x61,
-	0xa3, 0x48, 0x80, 0xb2, 0x31, 0x47, 0x03, 0xc3, 0x61, 0x84, 0x2a, 0x7b, 0xef, 0x0b, 0xd0, 0x3a,
-	0x17, 0x10, 0x2e, 0x14, 0x9e, 0x09, 0xa6, 0x2c, 0xf8, 0x71, 0x4a, 0x38, 0xfe, 0x9a, 0x44, 0x1c,
-	0x7e, 0x02, 0x80, 0x2b, 0x34, 0x96, 0x4f, 0x22, 0xde, 0xd0, 0xba, 0xa5, 0x7e, 0xcd, 0xac, 0x49,
-	0x8d, 0x30, 0xf7, 0xae, 0x35, 0xd0, 0x3a, 0xfd, 0x6d, 0x4e, 0x16, 0xc8, 0xc7, 0x94, 0xcb, 0x3c,
-	0x33, 0x9f, 0xe0, 0xd0, 0xc4, 0x0e, 0x0b, 0x5d, 0xf8, 0x29, 0xd8, 0xc2, 0x33, 0xe6, 0x4c, 0x2d,
-	0x3a, 0x0f, 0x6c, 0x1c, 0x36, 0xb4, 0xae, 0xd6, 0x2f, 0x99, 0x75, 0xa9, 0xfb, 0x46, 0xaa, 0xe0,
-	0x3e, 0xd8, 0x90, 0xe9, 0x1a, 0xc5, 0xae, 0xd6, 0xaf, 0x99, 0x4a, 0x80, 0x9f, 0x03, 0x10, 0xa4,
-	0xc9, 0x1a, 0x25, 0x61, 0x1a, 0x37, 0x6f, 0x1f, 0x3a, 0x85, 0x7f, 0x1f, 0x3a, 0xa5, 0x09, 0x76,
-	0xee, 0x6f, 0x8e, 0x41, 0xdc, 0xe5, 0x04, 0x3b, 0x66, 0xc6, 0xb9, 0xf7, 0x7f, 0x11, 0x1c, 0xac,
-	0x36, 0xe4, 0x63, 0x0f, 0x71, 0xc2, 0x28, 0x3c, 0x02, 0x7b, 0xae, 0x92, 0x58, 0x68, 0x21, 0xd7,
-	0x0d, 0x71, 0x14, 0x49, 0x4c, 0x35, 0x73, 0x37, 0x35, 0x8c, 0x94, 0x5e, 0x38, 0x2f, 0x90, 0x4f,
-	0xdc, 0x15, 0x67, 0x05, 0x72, 0x37, 0x35, 0x24, 0xce, 0xcb, 0x34, 0x33, 0x61, 0xd4, 0x42, 0x01,
-	0x9b, 0x53, 0x2e, 0x61, 0xd7, 0x87, 0x4d, 0x3d, 0x06, 0x2a, 0x08, 0xd0, 0x63, 0x02, 0xf4, 0x13,
-	0x46, 0xe8, 0xd8, 0x10, 0x1d, 0xfd, 0xf9, 0xd8, 0x39, 0xf4, 0x08, 0x9f, 0xce, 0x6d, 0xdd, 0x61,
-	0x41, 0xcc, 0x5d, 0xfc, 0x38, 0x8e, 0xdc, 0x4b, 0x83, 0x5f, 0xcd, 0x70, 0x24, 0x03, 0x52, 0x94,
-	0x84, 0xd1, 0x91, 0xac, 0x01, 0xff, 0xd0, 0x40, 0x03, 0xa7, 0x04, 0x58, 0x72, 0x8f, 0xdc, 0x04,
-	0x40, 0x39, 0x0f, 0xc0, 0xd1, 0x5b, 0x8a, 0x1f, 0xbc, 0xd4, 0xb9, 0x90, 0x65, 0x14, 0x84, 0xde,
-	0x6d, 0x09, 0xec, 0x65, 0x07, 0x3e, 0xf2, 0x30, 0xe5, 0x70, 0x1b, 0x14, 0x89, 0x2b, 0x87, 0x5b,
-	0x36, 0x8b, 0xc4, 0x85, 0x1d, 0x50, 0x97, 0xe0, 0xac, 0x2c, 0xdb, 0x40, 0xaa, 0xe4, 0xca, 0xc1,
-	0x13, 0x90, 0x74, 0x87, 0xd3, 0x71, 0x2b, 0xe2, 0x1b, 0xf7, 0x37, 0xc7, 0xfb, 0x71, 0x0f, 0xf1,
-	0xc0, 0x2f, 0x78, 0x48, 0xa8, 0x67, 0xee, 0x24, 0x11, 0x09, 0x0f, 0xa7, 0xeb, 0x48, 0x2b, 0xe7,
-	0x64, 0x79, 0x4d, 0xe7, 0x09, 0xd8, 0x5d, 0x12, 0x3e, 0x75, 0x43, 0xb4, 0x4c, 0xb3, 0x6c, 0xe4,
-	0x61, 0x49, 0x22, 0x92, 0x24, 0x5f, 0x81, 0x8f, 0x56, 0xe9, 0xa8, 0xac, 0xae, 0xf1, 0x19, 0xe5,
-	0x99, 0x35, 0x3e, 0xa3, 0xdc, 0xdc, 0x8a, 0x32, 0x73, 0x85, 0x03, 0x50, 0x89, 0xa6, 0x28, 0xc4,
-	0x51, 0xa3, 0x9a, 0x17, 0x18, 0x3b, 0x8a, 0x92, 0x21, 0x5e, 0xa2, 0x30, 0x2d, 0xb9, 0x99, 0x5b,
-	0x52, 0xf9, 0xc7, 0x54, 0xfe, 0xad, 0x81, 0x8f, 0xb3, 0x54, 0xfe, 0x40, 0x6d, 0x46, 0x5d, 0x42,
-	0x3d, 0xd8, 0x04, 0x9b, 0x48, 0xf0, 0x6a, 0xa5, 0xa4, 0x56, 0xa5, 0x7c, 0xe6, 0x8a, 0x99, 0xbf,
-	0xfe, 0xaa, 0x8a, 0x79, 0x33, 0x7f, 0xf5, 0xbd, 0xfd, 0x0c, 0xaa, 0x98, 0xf2, 0x90, 0x60, 0x41,
-	0x7b, 0xa9, 0x5f, 0x1f, 0x7e, 0xa9, 0xe7, 0x5d, 0x4b, 0x7d, 0x2d, 0xd6, 0x53, 0xca, 0xc3, 0xab,
-	0x71, 0x59, 0xf4, 0x6c, 0x26, 0x29, 0x7b, 0x7f, 0x15, 0x57, 0xcf, 0xdc, 0xaa, 0x37, 0x3c, 0x07,
-	0x3b, 0x0e, 0x0b, 0x66, 0x3e, 0x96, 0xdf, 0xaf, 0xb8, 0xb9, 0xb2, 0xcb, 0xfa, 0xb0, 0xa5, 0xab,
-	0x83, 0xac, 0x27, 0x07, 0x59, 0xff, 0x3e, 0x39, 0xc8, 0xe3, 0x4d, 0x51, 0xe2, 0xfa, 0xb1, 0xa3,
-	0x99, 0xdb, 0x2f, 0xc1, 0xc2, 0x0c, 0x23, 0xb0, 0x43, 0x28, 0xe1, 0x04, 0xf9, 0x96, 0x8d, 0x7c,
-	0x44, 0x1d, 0x2c, 0x07, 0xf2, 0xbe, 0xc7, 0x60, 0x3b, 0x2e, 0x31, 0x56, 0x15, 0xa0, 0x0b, 0xaa,
-	0x49, 0xb1, 0xf7, 0xbf, 0x3c, 0x49, 0xea, 0xde, 0xaf, 0xa0, 0x32, 0x19, 0x7d, 0x87, 0x48, 0xb8,
-	0x9e, 0x77, 0xed, 0xcd, 0xbc, 0x67, 0x37, 0xab, 0xb8, 0xb2, 0x59, 0xbd, 0x6f, 0x41, 0x55, 0xd5,
-	0x8a, 0xe0, 0x04, 0x6c, 0xcc, 0xc4, 0x8b, 0xfc, 0x05, 0xd5, 0x87, 0xfd, 0xfc, 0xdd, 0x50, 0x91,
-	0xf1, 0x1e, 0xa8, 0xe0, 0xf1, 0xe8, 0xf6, 0xa9, 0xad, 0xdd, 0x3d, 0xb5, 0xb5, 0xff, 0x9e, 0xda,
-	0xda, 0xf5, 0x73, 0xbb, 0x70, 0xf7, 0xdc, 0x2e, 0xfc, 0xf3, 0xdc, 0x2e, 0xfc, 0x74, 0x98, 0xfe,
-	0xcc, 0x7f, 0x5f, 0xf7, 0x3b, 0x17, 0x82, 0x9c, 0x86, 0x5d, 0x91, 0x8b, 0xf0, 0xd9, 0x87, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x32, 0xd1, 0xe3, 0x8d, 0xfe, 0x07, 0x00, 0x00,
+func (m *MultiStakingRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakingRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakingRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStakingRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakingRedelegation.Merge(m, src)
+}
+func (m *MultiStakingRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakingRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakingRedelegation.DiscardUnknown(m)
 }
 
-func (m *MultiStakingDenomWhiteList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MultiStakingRedelegation proto.InternalMessageInfo
+
+func (m *MultiStakingRedelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MultiStakingDenomWhiteList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MultiStakingRedelegation) GetSrcAgentId() uint64 {
+	if m != nil {
+		return m.SrcAgentId
+	}
+	return 0
 }
 
-func (m *MultiStakingDenomWhiteList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenomList) > 0 {
-		for iNdEx := len(m.DenomList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DenomList[iNdEx])
-			copy(dAtA[i:], m.DenomList[iNdEx])
-			i = encodeVarintMultistake(dAtA, i, uint64(len(m.DenomList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *MultiStakingRedelegation) GetDstAgentId() uint64 {
+	if m != nil {
+		return m.DstAgentId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EquivalentMultiplierRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiStakingRedelegation) GetEntries() []MultiStakingRedelegationEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EquivalentMultiplierRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MultiStakingRedelegationEntry struct {
+	CreationHeight int64      `protobuf:"varint,1,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	CompletionTime time.Time  `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	InitialBalance types.Coin `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3" json:"initial_balance"`
+	SharesDst      Int        `protobuf:"bytes,4,opt,name=shares_dst,json=sharesDst,proto3,customtype=Int" json:"shares_dst"`
 }
 
-func (m *EquivalentMultiplierRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Multiplier.Size()
-		i -= size
-		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MultiStakingRedelegationEntry) Reset()         { *m = MultiStakingRedelegationEntry{} }
+func (m *MultiStakingRedelegationEntry) String() string { return proto.CompactTextString(m) }
+func (*MultiStakingRedelegationEntry) ProtoMessage()    {}
+func (*MultiStakingRedelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1f1a8026a27605f, []int{11}
+}
+func (m *MultiStakingRedelegationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakingRedelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakingRedelegationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMultistake(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MultiStakingRedelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakingRedelegationEntry.Merge(m, src)
+}
+func (m *MultiStakingRedelegationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakingRedelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakingRedelegationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakingRedelegationEntry proto.InternalMessageInfo
+
+func (m *MultiStakingRedelegationEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintMultistake(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MultiStakingRedelegationEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *MultiStakingDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiStakingRedelegationEntry) GetInitialBalance() types.Coin {
+	if m != nil {
+		return m.InitialBalance
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MultiStakingDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DSDTriplet struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SrcAgentId       uint64 `protobuf:"varint,2,opt,name=src_agent_id,json=srcAgentId,proto3" json:"src_agent_id,omitempty"`
+	DstAgentId       uint64 `protobuf:"varint,3,opt,name=dst_agent_id,json=dstAgentId,proto3" json:"dst_agent_id,omitempty"`
 }
 
-func (m *MultiStakingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EquivalentStakedAmount != nil {
-		{
-			size, err := m.EquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMultistake(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.DelegationAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *DSDTriplet) Reset()         { *m = DSDTriplet{} }
+func (m *DSDTriplet) String() string { return proto.CompactTextString(m) }
+func (*DSDTriplet) ProtoMessage()    {}
+func (*DSDTriplet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1f1a8026a27605f, []int{12}
+}
+func (m *DSDTriplet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DSDTriplet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DSDTriplet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMultistake(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *DSDTriplet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DSDTriplet.Merge(m, src)
+}
+func (m *DSDTriplet) XXX_Size() int {
+	return m.Size()
+}
+func (m *DSDTriplet) XXX_DiscardUnknown() {
+	xxx_messageInfo_DSDTriplet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DSDTriplet proto.InternalMessageInfo
+
+func (m *DSDTriplet) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *DSDTriplet) GetSrcAgentId() uint64 {
+	if m != nil {
+		return m.SrcAgentId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MultiStakingAgent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DSDTriplet) GetDstAgentId() uint64 {
+	if m != nil {
+		return m.DstAgentId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MultiStakingAgent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DSDTriplets struct {
+	Triplets []DSDTriplet `protobuf:"bytes,1,rep,name=triplets,proto3" json:"triplets"`
 }
 
-func (m *MultiStakingAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardAmount.Size()
-		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *DSDTriplets) Reset()         { *m = DSDTriplets{} }
+func (m *DSDTriplets) String() string { return proto.CompactTextString(m) }
+func (*DSDTriplets) ProtoMessage()    {}
+func (*DSDTriplets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1f1a8026a27605f, []int{13}
+}
+func (m *DSDTriplets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DSDTriplets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DSDTriplets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMultistake(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.Shares.Size()
-		i -= size
-		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMultistake(dAtA, i, uint64(size))
+}
+func (m *DSDTriplets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DSDTriplets.Merge(m, src)
+}
+func (m *DSDTriplets) XXX_Size() int {
+	return m.Size()
+}
+func (m *DSDTriplets) XXX_DiscardUnknown() {
+	xxx_messageInfo_DSDTriplets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DSDTriplets proto.InternalMessageInfo
+
+func (m *DSDTriplets) GetTriplets() []DSDTriplet {
+	if m != nil {
+		return m.Triplets
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.StakedAmount.Size()
-		i -= size
-		if _, err := m.StakedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+type MultiStakingSlashRecord struct {
+	Denom           string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	SlashedAmount   Int    `protobuf:"bytes,2,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=Int" json:"slashed_amount"`
+	LastSlashHeight int64  `protobuf:"varint,3,opt,name=last_slash_height,json=lastSlashHeight,proto3" json:"last_slash_height,omitempty"`
+}
+
+func (m *MultiStakingSlashRecord) Reset()         { *m = MultiStakingSlashRecord{} }
+func (m *MultiStakingSlashRecord) String() string { return proto.CompactTextString(m) }
+func (*MultiStakingSlashRecord) ProtoMessage()    {}
+func (*MultiStakingSlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1f1a8026a27605f, []int{14}
+}
+func (m *MultiStakingSlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStakingSlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStakingSlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMultistake(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.WithdrawAddress) > 0 {
-		i -= len(m.WithdrawAddress)
-		copy(dAtA[i:], m.WithdrawAddress)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.WithdrawAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.DelegateAddress) > 0 {
-		i -= len(m.DelegateAddress)
-		copy(dAtA[i:], m.DelegateAddress)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegateAddress)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.StakeDenom) > 0 {
-		i -= len(m.StakeDenom)
-		copy(dAtA[i:], m.StakeDenom)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.StakeDenom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MultiStakingSlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStakingSlashRecord.Merge(m, src)
+}
+func (m *MultiStakingSlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStakingSlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStakingSlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStakingSlashRecord proto.InternalMessageInfo
+
+func (m *MultiStakingSlashRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.Id != 0 {
-		i = encodeVarintMultistake(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MultiStakingSlashRecord) GetLastSlashHeight() int64 {
+	if m != nil {
+		return m.LastSlashHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MultiStakingUnbonding) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterType((*MultiStakingDenomWhiteList)(nil), "celinium.restaking.multistake.v1.MultiStakingDenomWhiteList")
+	proto.RegisterType((*MultiStakingDenomConfig)(nil), "celinium.restaking.multistake.v1.MultiStakingDenomConfig")
+	proto.RegisterType((*MultiStakingDenomRemoval)(nil), "celinium.restaking.multistake.v1.MultiStakingDenomRemoval")
+	proto.RegisterType((*EquivalentMultiplierRecord)(nil), "celinium.restaking.multistake.v1.EquivalentMultiplierRecord")
+	proto.RegisterType((*MultiStakingDelegation)(nil), "celinium.restaking.multistake.v1.MultiStakingDelegation")
+	proto.RegisterType((*MultiStakingAgent)(nil), "celinium.restaking.multistake.v1.MultiStakingAgent")
+	proto.RegisterType((*MultiStakingUnbonding)(nil), "celinium.restaking.multistake.v1.MultiStakingUnbonding")
+	proto.RegisterType((*MultiStakingUnbondingEntry)(nil), "celinium.restaking.multistake.v1.MultiStakingUnbondingEntry")
+	proto.RegisterType((*DAPair)(nil), "celinium.restaking.multistake.v1.DAPair")
+	proto.RegisterType((*DAPairs)(nil), "celinium.restaking.multistake.v1.DAPairs")
+	proto.RegisterType((*MultiStakingRedelegation)(nil), "celinium.restaking.multistake.v1.MultiStakingRedelegation")
+	proto.RegisterType((*MultiStakingRedelegationEntry)(nil), "celinium.restaking.multistake.v1.MultiStakingRedelegationEntry")
+	proto.RegisterType((*DSDTriplet)(nil), "celinium.restaking.multistake.v1.DSDTriplet")
+	proto.RegisterType((*DSDTriplets)(nil), "celinium.restaking.multistake.v1.DSDTriplets")
+	proto.RegisterType((*MultiStakingSlashRecord)(nil), "celinium.restaking.multistake.v1.MultiStakingSlashRecord")
+}
+
+func init() {
+	proto.RegisterFile("celinium/restaking/multistake/v1/multistake.proto", fileDescriptor_d1f1a8026a27605f)
+}
+
+var fileDescriptor_d1f1a8026a27605f = []byte{
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x8f, 0xdb, 0xd4,
+	0x17, 0x1f, 0x3b, 0xe9, 0x3c, 0x4e, 0xa6, 0x99, 0xa9, 0xd5, 0x7f, 0xff, 0x99, 0x91, 0x9a, 0x19,
+	0xbc, 0xe9, 0x88, 0x52, 0x47, 0x53, 0x36, 0x20, 0x10, 0x34, 0x99, 0x8c, 0xd4, 0x0a, 0x5a, 0x2a,
+	0x67, 0x00, 0x09, 0x81, 0xac, 0x1b, 0xfb, 0xd6, 0xb9, 0xd4, 0xbe, 0x37, 0xf8, 0xde, 0xcc, 0x63,
+	0xc7, 0x8a, 0x15, 0x42, 0x15, 0x1b, 0x16, 0x88, 0x2f, 0xc0, 0xba, 0x1f, 0xa2, 0xcb, 0xaa, 0x1b,
+	0x10, 0x8b, 0x16, 0xb5, 0x7b, 0xf8, 0x0a, 0xe8, 0x3e, 0xec, 0x24, 0x4d, 0x8a, 0x19, 0x31, 0x0b,
+	0x56, 0xf1, 0x3d, 0xf7, 0x3c, 0x7e, 0xe7, 0x9c, 0xdf, 0x39, 0x76, 0x60, 0x37, 0xc4, 0x09, 0xa1,
+	0x64, 0x94, 0xb6, 0x32, 0xcc, 0x05, 0xba, 0x4f, 0x68, 0xdc, 0x4a, 0x47, 0x89, 0x20, 0xf2, 0x80,
+	0x5b, 0x87, 0xbb, 0x13, 0x27, 0x6f, 0x98, 0x31, 0xc1, 0x9c, 0xed, 0xdc, 0xc4, 0x2b, 0x4c, 0xbc,
+	0x09, 0xa5, 0xc3, 0xdd, 0xcd, 0xad, 0x98, 0xb1, 0x38, 0xc1, 0x2d, 0xa5, 0xdf, 0x1f, 0xdd, 0x6b,
+	0x09, 0x92, 0x4a, 0xd5, 0x74, 0xa8, 0x5d, 0x6c, 0x5e, 0x8c, 0x59, 0xcc, 0xd4, 0x63, 0x4b, 0x3e,
+	0x19, 0xe9, 0x46, 0xc8, 0x78, 0xca, 0x78, 0xa0, 0x2f, 0xf4, 0xc1, 0x5c, 0x35, 0xf5, 0xa9, 0xd5,
+	0x47, 0x5c, 0x82, 0xea, 0x63, 0x81, 0x76, 0x5b, 0x21, 0x23, 0x54, 0xdf, 0xbb, 0xef, 0xc0, 0xe6,
+	0x6d, 0x09, 0xa1, 0xa7, 0xf1, 0x74, 0x31, 0x65, 0xe9, 0xa7, 0x03, 0x22, 0xf0, 0x87, 0x84, 0x0b,
+	0xe7, 0x32, 0x40, 0x24, 0x25, 0x41, 0x42, 0xb8, 0x68, 0x58, 0xdb, 0x95, 0x9d, 0x15, 0x7f, 0x45,
+	0x49, 0xe4, 0xb5, 0xfb, 0xad, 0x0d, 0xff, 0x9f, 0xb1, 0xde, 0x63, 0xf4, 0x1e, 0x89, 0x9d, 0x8b,
+	0x70, 0x4e, 0x29, 0x36, 0xac, 0x6d, 0x6b, 0x67, 0xc5, 0xd7, 0x07, 0x67, 0x0f, 0xd6, 0x53, 0x74,
+	0x1c, 0x08, 0x26, 0x50, 0x12, 0xa0, 0x94, 0x8d, 0xa8, 0x68, 0xd8, 0x52, 0xa1, 0xb3, 0xf1, 0xe8,
+	0xe9, 0xd6, 0xc2, 0x6f, 0x4f, 0xb7, 0x2a, 0xb7, 0xa8, 0x78, 0xf2, 0xf0, 0x1a, 0x98, 0x2c, 0x6e,
+	0x51, 0xe1, 0xd7, 0x53, 0x74, 0x7c, 0x20, 0x2d, 0xda, 0xca, 0xc0, 0x39, 0x80, 0x0d, 0xe9, 0xe4,
+	0x10, 0x25, 0x24, 0x42, 0x82, 0x65, 0xc1, 0x90, 0x1d, 0xe1, 0x2c, 0xe0, 0x03, 0x94, 0xe1, 0x46,
+	0x65, 0xda, 0x5b, 0x17, 0x87, 0x13, 0xde, 0xba, 0x38, 0xf4, 0x2f, 0xa5, 0xe8, 0xf8, 0x93, 0xdc,
+	0xf4, 0xae, 0xb4, 0xec, 0x49, 0x43, 0xe7, 0x06, 0xd4, 0x53, 0x42, 0x83, 0x08, 0x27, 0x38, 0x46,
+	0x82, 0x30, 0xda, 0xa8, 0x96, 0x01, 0x3b, 0x9f, 0x12, 0xda, 0x2d, 0xf4, 0xdd, 0xef, 0x2d, 0x68,
+	0xcc, 0x94, 0xc3, 0xc7, 0x29, 0x3b, 0x44, 0xc9, 0x2b, 0xea, 0xb1, 0x0f, 0xb5, 0x11, 0xed, 0x33,
+	0x1a, 0x05, 0xb2, 0xd3, 0xaa, 0x14, 0xb5, 0xeb, 0x9b, 0x9e, 0xa6, 0x81, 0x97, 0xd3, 0xc0, 0x3b,
+	0xc8, 0x69, 0xd0, 0x59, 0x96, 0x68, 0x1e, 0x3c, 0xdb, 0xb2, 0x7c, 0xd0, 0x86, 0xf2, 0xca, 0xd9,
+	0x80, 0x65, 0x8a, 0x8f, 0x45, 0x70, 0x1f, 0x9f, 0xa8, 0x02, 0xac, 0xfa, 0x4b, 0xf2, 0xfc, 0x01,
+	0x3e, 0x71, 0x1f, 0x58, 0xb0, 0xb9, 0xff, 0xd5, 0x88, 0x1c, 0xa2, 0x04, 0x53, 0xa1, 0xe0, 0x0d,
+	0x13, 0x82, 0x33, 0x1f, 0x87, 0x2c, 0x8b, 0x9c, 0xd7, 0x60, 0x15, 0x0f, 0x59, 0x38, 0x08, 0xe8,
+	0x28, 0xed, 0xe3, 0x4c, 0xa1, 0xab, 0xf8, 0x35, 0x25, 0xbb, 0xa3, 0x44, 0x63, 0xe4, 0xf6, 0x24,
+	0xf2, 0xb7, 0x01, 0xd2, 0xc2, 0x59, 0x79, 0xd5, 0x27, 0x94, 0xdd, 0x3f, 0x6c, 0xb8, 0x34, 0x5d,
+	0xa7, 0xbc, 0x84, 0xce, 0x55, 0xb8, 0x60, 0x1a, 0xc0, 0xb2, 0x00, 0x45, 0x51, 0x86, 0x39, 0x37,
+	0x15, 0x5b, 0x2f, 0x2e, 0xda, 0x5a, 0x2e, 0x95, 0xc7, 0x1c, 0xc8, 0x95, 0x35, 0xc8, 0xf5, 0xe2,
+	0x22, 0x57, 0x3e, 0x2a, 0x3c, 0x13, 0x46, 0x73, 0xea, 0x55, 0x54, 0xbd, 0x37, 0x3c, 0x03, 0x54,
+	0x0e, 0x89, 0x67, 0x86, 0xc4, 0xdb, 0x63, 0x84, 0x76, 0x5a, 0x32, 0xa3, 0x9f, 0x9f, 0x6d, 0x5d,
+	0x89, 0x89, 0x18, 0x8c, 0xfa, 0x5e, 0xc8, 0x52, 0x33, 0x5f, 0xe6, 0xe7, 0x1a, 0x8f, 0xee, 0xb7,
+	0xc4, 0xc9, 0x10, 0x73, 0x65, 0x50, 0xa0, 0x24, 0x8c, 0x1a, 0xb6, 0x7e, 0x6d, 0x41, 0x03, 0x17,
+	0x0d, 0x08, 0xd4, 0xac, 0x47, 0x39, 0x80, 0x6a, 0x19, 0x80, 0xab, 0xa7, 0x09, 0x7e, 0x69, 0x1c,
+	0xa7, 0xa7, 0xc2, 0x68, 0x08, 0xee, 0x77, 0x55, 0xb8, 0x30, 0x59, 0xf0, 0x76, 0x8c, 0xa9, 0x70,
+	0xea, 0x60, 0x93, 0x48, 0x15, 0xb7, 0xea, 0xdb, 0x24, 0x72, 0xb6, 0xa0, 0xa6, 0xc0, 0x05, 0x93,
+	0xdd, 0x06, 0x25, 0xea, 0xe6, 0xc3, 0x6b, 0xb2, 0xc3, 0x45, 0xb9, 0x75, 0xe3, 0x1b, 0x4f, 0x1e,
+	0x5e, 0xbb, 0x68, 0x72, 0x30, 0x05, 0xef, 0x89, 0x8c, 0xd0, 0xd8, 0x5f, 0xcb, 0x2d, 0xf2, 0x3e,
+	0xec, 0xcf, 0x6b, 0x5a, 0xb5, 0xc4, 0xcb, 0x6c, 0x3b, 0xf7, 0x60, 0xfd, 0x88, 0x88, 0x41, 0x94,
+	0xa1, 0xa3, 0xc2, 0xcb, 0xb9, 0x32, 0x2c, 0xb9, 0x45, 0xee, 0xe4, 0x3d, 0x38, 0x3f, 0xdd, 0x8e,
+	0xc5, 0xb2, 0x89, 0x5f, 0xe5, 0x13, 0x75, 0x75, 0x76, 0x61, 0x51, 0x2d, 0x1d, 0xde, 0x58, 0x2a,
+	0x33, 0x34, 0x8a, 0x32, 0x64, 0x86, 0x8f, 0x50, 0x56, 0x84, 0x5c, 0x2e, 0x0d, 0xa9, 0xf5, 0x4d,
+	0xc8, 0xee, 0xb8, 0x07, 0x85, 0x8b, 0x95, 0x32, 0x17, 0x45, 0x13, 0x72, 0x42, 0xfc, 0x62, 0xc1,
+	0xff, 0x26, 0x09, 0xf1, 0xb1, 0x5a, 0x25, 0x84, 0xc6, 0x72, 0x93, 0x20, 0xc9, 0x8e, 0xa0, 0xa0,
+	0xc6, 0x92, 0x3a, 0xdf, 0x8a, 0x64, 0xe7, 0x66, 0x67, 0xd3, 0x2e, 0xeb, 0xdc, 0xcc, 0xd4, 0x7e,
+	0x0e, 0x4b, 0x98, 0x8a, 0x8c, 0x60, 0x49, 0x9e, 0xca, 0x4e, 0xed, 0xfa, 0xbb, 0x5e, 0xd9, 0x7b,
+	0xd1, 0x9b, 0x8b, 0x75, 0x9f, 0x8a, 0xec, 0xa4, 0x53, 0x95, 0x69, 0xfb, 0xb9, 0x4b, 0xf7, 0x4f,
+	0x1b, 0x36, 0x5f, 0xad, 0xed, 0xdc, 0x86, 0xb5, 0x90, 0xa5, 0xc3, 0x04, 0xab, 0x2d, 0xa0, 0x76,
+	0xae, 0x75, 0x8a, 0x9d, 0x5b, 0x1f, 0x1b, 0xcb, 0x6b, 0x87, 0xc3, 0x1a, 0xa1, 0x44, 0x10, 0x94,
+	0x04, 0x7d, 0x94, 0x20, 0x1a, 0xe6, 0x2b, 0xfc, 0x2c, 0x57, 0x4a, 0xdd, 0x84, 0xe8, 0xe8, 0x08,
+	0x4e, 0x04, 0x4b, 0x79, 0xb0, 0xb3, 0xdf, 0x5f, 0xb9, 0x6b, 0xe7, 0x0a, 0xac, 0x85, 0x19, 0xd6,
+	0xdb, 0x72, 0x80, 0x49, 0x3c, 0xd0, 0xcb, 0xaa, 0xe2, 0xd7, 0x73, 0xf1, 0x4d, 0x25, 0x75, 0xbf,
+	0x84, 0xc5, 0x6e, 0xfb, 0x2e, 0x22, 0xd9, 0x7c, 0x82, 0x58, 0xa7, 0x26, 0xc8, 0x24, 0x05, 0xed,
+	0x29, 0x0a, 0xba, 0x1f, 0xc1, 0x92, 0x8e, 0xc5, 0x9d, 0x2e, 0x9c, 0x1b, 0xca, 0x07, 0xf5, 0x55,
+	0x52, 0xbb, 0xbe, 0x53, 0x4e, 0x22, 0x6d, 0x69, 0x08, 0xa3, 0x8d, 0xdd, 0x6f, 0xec, 0xe9, 0x57,
+	0xb6, 0x8f, 0xc7, 0xfb, 0xfb, 0xac, 0xf2, 0xd9, 0x86, 0x55, 0x9e, 0x85, 0xc1, 0x4b, 0x39, 0x01,
+	0xcf, 0xc2, 0xb6, 0x99, 0xac, 0x6d, 0x58, 0x8d, 0xb8, 0x18, 0x6b, 0x54, 0xb4, 0x46, 0xc4, 0x45,
+	0xae, 0x11, 0x8c, 0x87, 0xa6, 0xaa, 0xf2, 0x7d, 0xff, 0x74, 0x43, 0x33, 0x99, 0xd7, 0xdc, 0xb9,
+	0xf9, 0xd1, 0x86, 0xcb, 0x7f, 0x6b, 0x30, 0x8f, 0x10, 0xd6, 0x3c, 0x42, 0xcc, 0x9b, 0x31, 0xfb,
+	0x5f, 0xcc, 0xd8, 0xcd, 0xd9, 0x19, 0x2b, 0xa5, 0xbd, 0x4e, 0xee, 0xe5, 0xc1, 0x79, 0x0b, 0x40,
+	0x6f, 0xe1, 0x20, 0xe2, 0xa2, 0xfc, 0xeb, 0x6e, 0x45, 0x2b, 0x77, 0xb9, 0x70, 0x7f, 0xb0, 0x00,
+	0xba, 0xbd, 0xee, 0x41, 0x46, 0x24, 0xb2, 0xff, 0x10, 0x31, 0xdc, 0x2f, 0xa0, 0x36, 0x06, 0xc6,
+	0x9d, 0x3b, 0xb0, 0x2c, 0xcc, 0xb3, 0x19, 0x8c, 0x37, 0xfe, 0xc1, 0x60, 0x14, 0x0e, 0x4c, 0xe1,
+	0x0a, 0x1f, 0xee, 0x4f, 0xd6, 0xf4, 0x17, 0x7e, 0x2f, 0x41, 0x7c, 0x60, 0x3e, 0x1d, 0xe7, 0x7f,
+	0xd1, 0xde, 0x80, 0x3a, 0x97, 0x4a, 0xe3, 0xd7, 0x53, 0xe9, 0xf7, 0xfd, 0x79, 0x63, 0x60, 0x5e,
+	0x71, 0xaf, 0xc3, 0x85, 0x04, 0x71, 0x11, 0x28, 0x69, 0x4e, 0xb5, 0x8a, 0xa2, 0xda, 0x9a, 0xbc,
+	0x50, 0x18, 0x34, 0xd7, 0x3a, 0xed, 0x47, 0xcf, 0x9b, 0xd6, 0xe3, 0xe7, 0x4d, 0xeb, 0xf7, 0xe7,
+	0x4d, 0xeb, 0xc1, 0x8b, 0xe6, 0xc2, 0xe3, 0x17, 0xcd, 0x85, 0x5f, 0x5f, 0x34, 0x17, 0x3e, 0xbb,
+	0x52, 0xfc, 0x3f, 0x3b, 0x9e, 0xf7, 0x0f, 0x4d, 0x1e, 0xd4, 0xda, 0xeb, 0x2f, 0x2a, 0x36, 0xbe,
+	0xf9, 0xd7, 0x00, 0x7d, 0x80, 0xf0, 0xf3, 0xd1, 0x0d, 0x00, 0x00,
+}
+
+func (m *MultiStakingDenomWhiteList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -792,46 +1009,29 @@ func (m *MultiStakingUnbonding) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiStakingUnbonding) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiStakingDenomWhiteList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiStakingUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiStakingDenomWhiteList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMultistake(dAtA, i, uint64(size))
-			}
+	if len(m.DenomList) > 0 {
+		for iNdEx := len(m.DenomList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomList[iNdEx])
+			copy(dAtA[i:], m.DenomList[iNdEx])
+			i = encodeVarintMultistake(dAtA, i, uint64(len(m.DenomList[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AgentId != 0 {
-		i = encodeVarintMultistake(dAtA, i, uint64(m.AgentId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiStakingUnbondingEntry) Marshal() (dAtA []byte, err error) {
+func (m *MultiStakingDenomConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -841,48 +1041,57 @@ func (m *MultiStakingUnbondingEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiStakingUnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiStakingDenomConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiStakingUnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiStakingDenomConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MinDelegation.Size()
+		i -= size
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxValidatorPowerShare.Size()
 		i -= size
+		if _, err := m.MaxValidatorPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintMultistake(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
 	{
-		size, err := m.InitialBalance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxTotalAmount.Size()
+		i -= size
+		if _, err := m.MaxTotalAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintMultistake(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err5 != nil {
-		return 0, err5
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n5
-	i = encodeVarintMultistake(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DAPair) Marshal() (dAtA []byte, err error) {
+func (m *MultiStakingDenomRemoval) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -892,32 +1101,42 @@ func (m *DAPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DAPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiStakingDenomRemoval) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DAPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiStakingDenomRemoval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AgentId != 0 {
-		i = encodeVarintMultistake(dAtA, i, uint64(m.AgentId))
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.NextKey)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMultistake(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DAPairs) Marshal() (dAtA []byte, err error) {
+func (m *EquivalentMultiplierRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -927,209 +1146,1469 @@ func (m *DAPairs) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DAPairs) MarshalTo(dAtA []byte) (int, error) {
+func (m *EquivalentMultiplierRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DAPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EquivalentMultiplierRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMultistake(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMultistake(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMultistake(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MultiStakingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MultiStakingDenomWhiteList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MultiStakingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DenomList) > 0 {
-		for _, s := range m.DenomList {
-			l = len(s)
-			n += 1 + l + sovMultistake(uint64(l))
+	if m.EquivalentStakedAmount != nil {
+		{
+			size, err := m.EquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultistake(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *EquivalentMultiplierRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.DelegationAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovMultistake(uint64(m.EpochNumber))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Multiplier.Size()
-	n += 1 + l + sovMultistake(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiStakingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStakingAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
 	}
-	l = m.DelegationAmount.Size()
-	n += 1 + l + sovMultistake(uint64(l))
-	if m.EquivalentStakedAmount != nil {
-		l = m.EquivalentStakedAmount.Size()
-		n += 1 + l + sovMultistake(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MultiStakingAgent) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.StakedAmount.Size()
+		i -= size
+		if _, err := m.StakedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMultistake(uint64(m.Id))
+	i--
+	dAtA[i] = 0x32
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.StakeDenom)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.DelegateAddress)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
+	if len(m.DelegateAddress) > 0 {
+		i -= len(m.DelegateAddress)
+		copy(dAtA[i:], m.DelegateAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegateAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
+	if len(m.StakeDenom) > 0 {
+		i -= len(m.StakeDenom)
+		copy(dAtA[i:], m.StakeDenom)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.StakeDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.StakedAmount.Size()
-	n += 1 + l + sovMultistake(uint64(l))
-	l = m.Shares.Size()
-	n += 1 + l + sovMultistake(uint64(l))
-	l = m.RewardAmount.Size()
-	n += 1 + l + sovMultistake(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiStakingUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStakingUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AgentId != 0 {
-		n += 1 + sovMultistake(uint64(m.AgentId))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
-	}
 	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovMultistake(uint64(l))
-		}
-	}
-	return n
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultistake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AgentId != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.AgentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MultiStakingUnbondingEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiStakingUnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingUnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingUnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovMultistake(uint64(l))
-	l = m.InitialBalance.Size()
-	n += 1 + l + sovMultistake(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovMultistake(uint64(l))
-	return n
+	if m.CreationHeight != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.InitialBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintMultistake(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *DAPair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DAPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DAPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMultistake(uint64(l))
-	}
 	if m.AgentId != 0 {
-		n += 1 + sovMultistake(uint64(m.AgentId))
+		i = encodeVarintMultistake(dAtA, i, uint64(m.AgentId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DAPairs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DAPairs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DAPairs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAPairs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovMultistake(uint64(l))
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultistake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStakingRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultistake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DstAgentId != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.DstAgentId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcAgentId != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.SrcAgentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStakingRedelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingRedelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingRedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharesDst.Size()
+		i -= size
+		if _, err := m.SharesDst.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.InitialBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintMultistake(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if m.CreationHeight != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DSDTriplet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DSDTriplet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DSDTriplet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DstAgentId != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.DstAgentId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SrcAgentId != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.SrcAgentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DSDTriplets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DSDTriplets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DSDTriplets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for iNdEx := len(m.Triplets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triplets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultistake(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStakingSlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStakingSlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStakingSlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSlashHeight != 0 {
+		i = encodeVarintMultistake(dAtA, i, uint64(m.LastSlashHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMultistake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMultistake(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultistake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultistake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultiStakingDenomWhiteList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomList) > 0 {
+		for _, s := range m.DenomList {
+			l = len(s)
+			n += 1 + l + sovMultistake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStakingDenomConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = m.MaxTotalAmount.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.MaxValidatorPowerShare.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	return n
+}
+
+func (m *MultiStakingDenomRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime)
+	n += 1 + l + sovMultistake(uint64(l))
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	return n
+}
+
+func (m *EquivalentMultiplierRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovMultistake(uint64(m.EpochNumber))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	return n
+}
+
+func (m *MultiStakingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	if m.EquivalentStakedAmount != nil {
+		l = m.EquivalentStakedAmount.Size()
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiStakingAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMultistake(uint64(m.Id))
+	}
+	l = len(m.StakeDenom)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = len(m.DelegateAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = m.StakedAmount.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	return n
+}
+
+func (m *MultiStakingUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AgentId != 0 {
+		n += 1 + sovMultistake(uint64(m.AgentId))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMultistake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStakingUnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovMultistake(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *DAPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	if m.AgentId != 0 {
+		n += 1 + sovMultistake(uint64(m.AgentId))
+	}
+	return n
+}
+
+func (m *DAPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovMultistake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStakingRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	if m.SrcAgentId != 0 {
+		n += 1 + sovMultistake(uint64(m.SrcAgentId))
+	}
+	if m.DstAgentId != 0 {
+		n += 1 + sovMultistake(uint64(m.DstAgentId))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovMultistake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStakingRedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovMultistake(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	l = m.SharesDst.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	return n
+}
+
+func (m *DSDTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	if m.SrcAgentId != 0 {
+		n += 1 + sovMultistake(uint64(m.SrcAgentId))
+	}
+	if m.DstAgentId != 0 {
+		n += 1 + sovMultistake(uint64(m.DstAgentId))
+	}
+	return n
+}
+
+func (m *DSDTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovMultistake(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiStakingSlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMultistake(uint64(l))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovMultistake(uint64(l))
+	if m.LastSlashHeight != 0 {
+		n += 1 + sovMultistake(uint64(m.LastSlashHeight))
+	}
+	return n
+}
+
+func sovMultistake(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultistake(x uint64) (n int) {
+	return sovMultistake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultiStakingDenomWhiteList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultistake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStakingDenomWhiteList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStakingDenomWhiteList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomList = append(m.DenomList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultistake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStakingDenomConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultistake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStakingDenomConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStakingDenomConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultistake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStakingDenomRemoval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultistake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStakingDenomRemoval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStakingDenomRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultistake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMultistake(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozMultistake(x uint64) (n int) {
-	return sovMultistake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultistake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivalentMultiplierRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivalentMultiplierRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultistake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MultiStakingDenomWhiteList) Unmarshal(dAtA []byte) error {
+func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1152,15 +2631,15 @@ func (m *MultiStakingDenomWhiteList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiStakingDenomWhiteList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStakingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiStakingDenomWhiteList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStakingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1188,7 +2667,108 @@ func (m *MultiStakingDenomWhiteList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomList = append(m.DenomList, string(dAtA[iNdEx:postIndex]))
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentStakedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EquivalentStakedAmount == nil {
+				m.EquivalentStakedAmount = &types.Coin{}
+			}
+			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1211,7 +2791,7 @@ func (m *MultiStakingDenomWhiteList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
+func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1234,17 +2814,17 @@ func (m *EquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EquivalentMultiplierRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStakingAgent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EquivalentMultiplierRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStakingAgent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.EpochNumber = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1254,14 +2834,14 @@ func (m *EquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1289,11 +2869,11 @@ func (m *EquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.StakeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1321,7 +2901,205 @@ func (m *EquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegateAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1346,7 +3124,7 @@ func (m *EquivalentMultiplierRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
+func (m *MultiStakingUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,49 +3147,17 @@ func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiStakingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStakingUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiStakingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStakingUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultistake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMultistake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMultistake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
-			var stringLen uint64
+			m.AgentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1421,29 +3167,16 @@ func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AgentId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMultistake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMultistake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1453,28 +3186,27 @@ func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultistake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquivalentStakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1501,10 +3233,8 @@ func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EquivalentStakedAmount == nil {
-				m.EquivalentStakedAmount = &types.Coin{}
-			}
-			if err := m.EquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, MultiStakingUnbondingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1529,7 +3259,7 @@ func (m *MultiStakingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
+func (m *MultiStakingUnbondingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1552,36 +3282,17 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiStakingAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStakingUnbondingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiStakingAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStakingUnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultistake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1591,29 +3302,30 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultistake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1623,29 +3335,30 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultistake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1655,29 +3368,30 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultistake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var stringLen uint64
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1687,27 +3401,64 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMultistake
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultistake(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultistake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1735,15 +3486,13 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
-			var stringLen uint64
+			m.AgentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1753,31 +3502,66 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AgentId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMultistake
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultistake(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultistake
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -1787,23 +3571,23 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultistake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pairs = append(m.Pairs, DAPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1828,7 +3612,7 @@ func (m *MultiStakingAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiStakingUnbonding) Unmarshal(dAtA []byte) error {
+func (m *MultiStakingRedelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1851,32 +3635,13 @@ func (m *MultiStakingUnbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiStakingUnbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStakingRedelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiStakingUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStakingRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
-			}
-			m.AgentId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMultistake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AgentId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
@@ -1908,7 +3673,45 @@ func (m *MultiStakingUnbonding) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcAgentId", wireType)
+			}
+			m.SrcAgentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcAgentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstAgentId", wireType)
+			}
+			m.DstAgentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstAgentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
@@ -1937,7 +3740,7 @@ func (m *MultiStakingUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, MultiStakingUnbondingEntry{})
+			m.Entries = append(m.Entries, MultiStakingRedelegationEntry{})
 			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1963,7 +3766,7 @@ func (m *MultiStakingUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiStakingUnbondingEntry) Unmarshal(dAtA []byte) error {
+func (m *MultiStakingRedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1986,13 +3789,32 @@ func (m *MultiStakingUnbondingEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiStakingUnbondingEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiStakingRedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiStakingUnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiStakingRedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
@@ -2025,7 +3847,7 @@ func (m *MultiStakingUnbondingEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
@@ -2058,11 +3880,11 @@ func (m *MultiStakingUnbondingEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -2072,22 +3894,23 @@ func (m *MultiStakingUnbondingEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMultistake
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMultistake
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2112,7 +3935,7 @@ func (m *MultiStakingUnbondingEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAPair) Unmarshal(dAtA []byte) error {
+func (m *DSDTriplet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2135,10 +3958,10 @@ func (m *DAPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: DSDTriplet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DSDTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2175,9 +3998,9 @@ func (m *DAPair) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcAgentId", wireType)
 			}
-			m.AgentId = 0
+			m.SrcAgentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMultistake
@@ -2187,7 +4010,26 @@ func (m *DAPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AgentId |= uint64(b&0x7F) << shift
+				m.SrcAgentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstAgentId", wireType)
+			}
+			m.DstAgentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstAgentId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2213,7 +4055,7 @@ func (m *DAPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAPairs) Unmarshal(dAtA []byte) error {
+func (m *DSDTriplets) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2236,15 +4078,15 @@ func (m *DAPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: DSDTriplets: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DSDTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2271,11 +4113,146 @@ func (m *DAPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DAPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Triplets = append(m.Triplets, DSDTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultistake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStakingSlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultistake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStakingSlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStakingSlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultistake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashHeight", wireType)
+			}
+			m.LastSlashHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultistake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultistake(dAtA[iNdEx:])
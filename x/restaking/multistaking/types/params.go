@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxUnbondingEntries is the same as the default max entries of x/staking.
+	DefaultMaxUnbondingEntries uint32 = 7
+
+	DefaultDenomRemovalGracePeriod = time.Hour * 24 * 7
+
+	// DefaultMaxDenomRemovalUnbonds bounds the force unbonding work of removed denoms in a block.
+	DefaultMaxDenomRemovalUnbonds uint32 = 100
+)
+
+// DefaultParams returns default multistaking parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxUnbondingEntries:            DefaultMaxUnbondingEntries,
+		DenomRemovalGracePeriod:        DefaultDenomRemovalGracePeriod,
+		MaxRestakedPowerShare:          sdk.ZeroDec(),
+		MaxValidatorRestakedPowerShare: sdk.ZeroDec(),
+		MaxDenomRemovalUnbonds:         DefaultMaxDenomRemovalUnbonds,
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.MaxUnbondingEntries == 0 {
+		return fmt.Errorf("max unbonding entries must be positive")
+	}
+
+	if p.DenomRemovalGracePeriod < 0 {
+		return fmt.Errorf("denom removal grace period must be non-negative")
+	}
+
+	if p.MaxDenomRemovalUnbonds == 0 {
+		return fmt.Errorf("max denom removal unbonds must be positive")
+	}
+
+	if err := validatePowerShare(p.MaxRestakedPowerShare); err != nil {
+		return fmt.Errorf("max restaked power share: %w", err)
+	}
+
+	if err := validatePowerShare(p.MaxValidatorRestakedPowerShare); err != nil {
+		return fmt.Errorf("max validator restaked power share: %w", err)
+	}
+
+	if strings.TrimSpace(p.SlashRecipient) != p.SlashRecipient {
+		return fmt.Errorf("slash recipient must not contain leading or trailing spaces, got %q", p.SlashRecipient)
+	}
+
+	return nil
+}
+
+func validatePowerShare(share sdk.Dec) error {
+	if share.IsNil() {
+		return nil
+	}
+
+	if share.IsNegative() || share.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be in [0, 1], got %s", share)
+	}
+
+	return nil
+}
+
+// PowerShareCapEnabled returns whether the power share is capped, both zero and one mean no cap.
+func PowerShareCapEnabled(share sdk.Dec) bool {
+	return !share.IsNil() && share.IsPositive() && share.LT(sdk.OneDec())
+}
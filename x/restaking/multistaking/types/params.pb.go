@@ -0,0 +1,565 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celinium/restaking/multistake/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the multistaking module.
+type Params struct {
+	// The max number of unbonding entries of a delegator in an agent, and the max number of
+	// redelegation entries of a delegator between two agents.
+	MaxUnbondingEntries uint32 `protobuf:"varint,1,opt,name=max_unbonding_entries,json=maxUnbondingEntries,proto3" json:"max_unbonding_entries,omitempty"`
+	// The grace period after a denom is removed from the white list, the positions of the denom
+	// are force unbonded when it ends.
+	DenomRemovalGracePeriod time.Duration `protobuf:"bytes,2,opt,name=denom_removal_grace_period,json=denomRemovalGracePeriod,proto3,stdduration" json:"denom_removal_grace_period"`
+	// The max fraction of the bonded tokens which comes from multistaking agents. Zero means no cap.
+	MaxRestakedPowerShare Dec `protobuf:"bytes,3,opt,name=max_restaked_power_share,json=maxRestakedPowerShare,proto3,customtype=Dec" json:"max_restaked_power_share"`
+	// The max fraction of a validator's tokens which comes from multistaking agents. Zero means no cap.
+	MaxValidatorRestakedPowerShare Dec `protobuf:"bytes,4,opt,name=max_validator_restaked_power_share,json=maxValidatorRestakedPowerShare,proto3,customtype=Dec" json:"max_validator_restaked_power_share"`
+	// The recipient of the slashed restaked coins. They are burned if it's empty, funded to the
+	// community pool if it's `community_pool`, otherwise sent to the module account of the name.
+	SlashRecipient string `protobuf:"bytes,5,opt,name=slash_recipient,json=slashRecipient,proto3" json:"slash_recipient,omitempty"`
+	// The max number of positions which are force unbonded in a block after the grace period
+	// of a removed denom ends.
+	MaxDenomRemovalUnbonds uint32 `protobuf:"varint,6,opt,name=max_denom_removal_unbonds,json=maxDenomRemovalUnbonds,proto3" json:"max_denom_removal_unbonds,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b806789ae7d9935c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxUnbondingEntries() uint32 {
+	if m != nil {
+		return m.MaxUnbondingEntries
+	}
+	return 0
+}
+
+func (m *Params) GetDenomRemovalGracePeriod() time.Duration {
+	if m != nil {
+		return m.DenomRemovalGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSlashRecipient() string {
+	if m != nil {
+		return m.SlashRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetMaxDenomRemovalUnbonds() uint32 {
+	if m != nil {
+		return m.MaxDenomRemovalUnbonds
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celinium.restaking.multistake.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("celinium/restaking/multistake/v1/params.proto", fileDescriptor_b806789ae7d9935c)
+}
+
+var fileDescriptor_b806789ae7d9935c = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x0a, 0x15, 0x18, 0x01, 0x52, 0xf8, 0x97, 0x76, 0x70, 0xab, 0x5b, 0xae, 0xcb,
+	0x25, 0xba, 0x63, 0x62, 0xa4, 0x2a, 0x62, 0xad, 0x8c, 0x60, 0x60, 0x31, 0x6e, 0xf2, 0x92, 0xb3,
+	0x88, 0xed, 0xc8, 0x76, 0x4a, 0xf8, 0x16, 0x8c, 0x7c, 0x10, 0x56, 0xf6, 0x1b, 0x4f, 0x4c, 0x88,
+	0xe1, 0x40, 0xed, 0x17, 0x41, 0xb6, 0x93, 0x02, 0x52, 0x25, 0xb6, 0xbc, 0x7e, 0xde, 0xf7, 0x97,
+	0xc7, 0xcf, 0x6b, 0x74, 0x92, 0x43, 0xc5, 0x25, 0x6f, 0x44, 0xa6, 0xc1, 0x58, 0xf6, 0x9e, 0xcb,
+	0x32, 0x13, 0x4d, 0x65, 0xb9, 0x2b, 0x20, 0xdb, 0x9c, 0x66, 0x35, 0xd3, 0x4c, 0x98, 0xb4, 0xd6,
+	0xca, 0xaa, 0x78, 0xd6, 0xb7, 0xa7, 0xfb, 0xf6, 0xf4, 0x4f, 0x7b, 0xba, 0x39, 0x9d, 0x3c, 0x28,
+	0x55, 0xa9, 0x7c, 0x73, 0xe6, 0xbe, 0xc2, 0xdc, 0x64, 0x9c, 0x2b, 0x23, 0x94, 0xa1, 0x41, 0x08,
+	0x45, 0x27, 0xe1, 0x52, 0xa9, 0xb2, 0x82, 0xcc, 0x57, 0xeb, 0xe6, 0x5d, 0x56, 0x34, 0x9a, 0x59,
+	0xae, 0x64, 0xd0, 0x8f, 0xbe, 0x0e, 0xd1, 0x68, 0xe5, 0x3d, 0xc4, 0x67, 0xe8, 0xa1, 0x60, 0x2d,
+	0x6d, 0xe4, 0x5a, 0xc9, 0x82, 0xcb, 0x92, 0x82, 0xb4, 0x9a, 0x83, 0x49, 0xa2, 0x59, 0x34, 0xbf,
+	0x43, 0xee, 0x0b, 0xd6, 0xbe, 0xea, 0xb5, 0xe7, 0x41, 0x8a, 0xdf, 0xa2, 0x49, 0x01, 0x52, 0x09,
+	0xaa, 0x41, 0xa8, 0x0d, 0xab, 0x68, 0xa9, 0x59, 0x0e, 0xb4, 0x06, 0xcd, 0x55, 0x91, 0x5c, 0x9b,
+	0x45, 0xf3, 0xdb, 0x67, 0xe3, 0x34, 0x78, 0x48, 0x7b, 0x0f, 0xe9, 0xb2, 0xf3, 0xb0, 0xb8, 0x79,
+	0x71, 0x35, 0x1d, 0x7c, 0xfe, 0x39, 0x8d, 0xc8, 0x63, 0x8f, 0x21, 0x81, 0xf2, 0xc2, 0x41, 0x56,
+	0x9e, 0x11, 0x13, 0x94, 0x38, 0x57, 0x21, 0x10, 0x28, 0x68, 0xad, 0x3e, 0x80, 0xa6, 0xe6, 0x9c,
+	0x69, 0x48, 0x86, 0xb3, 0x68, 0x7e, 0x6b, 0x31, 0x76, 0x90, 0x1f, 0x57, 0xd3, 0xe1, 0x12, 0xf2,
+	0x6f, 0x5f, 0x4e, 0x50, 0x77, 0xff, 0x25, 0xe4, 0xc4, 0x5d, 0x88, 0x74, 0x93, 0x2b, 0x37, 0xf8,
+	0xd2, 0xcd, 0xc5, 0x80, 0x8e, 0x1c, 0x73, 0xc3, 0x2a, 0x5e, 0x30, 0xab, 0xf4, 0x61, 0xfa, 0xf5,
+	0xff, 0xd1, 0xb1, 0x60, 0xed, 0xeb, 0x9e, 0x71, 0xe0, 0x37, 0xc7, 0xe8, 0x9e, 0xa9, 0x98, 0x39,
+	0xa7, 0x1a, 0x72, 0x5e, 0x73, 0x90, 0x36, 0xb9, 0xe1, 0x98, 0xe4, 0xae, 0x3f, 0x26, 0xfd, 0x69,
+	0xfc, 0x14, 0x8d, 0x9d, 0x9f, 0x7f, 0x93, 0x0c, 0x7b, 0x30, 0xc9, 0xc8, 0xa7, 0xff, 0x48, 0xb0,
+	0x76, 0xf9, 0x57, 0x44, 0x61, 0x13, 0x66, 0xf1, 0xec, 0x62, 0x8b, 0xa3, 0xcb, 0x2d, 0x8e, 0x7e,
+	0x6d, 0x71, 0xf4, 0x69, 0x87, 0x07, 0x97, 0x3b, 0x3c, 0xf8, 0xbe, 0xc3, 0x83, 0x37, 0xc7, 0xfb,
+	0xb7, 0xd7, 0x1e, 0x7a, 0x7d, 0xae, 0xb0, 0x1f, 0x6b, 0x30, 0xeb, 0x91, 0xdf, 0xcb, 0x93, 0xdf,
+	0x03, 0x00, 0xd6, 0xa1, 0xe0, 0x4c, 0xad, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDenomRemovalUnbonds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDenomRemovalUnbonds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SlashRecipient) > 0 {
+		i -= len(m.SlashRecipient)
+		copy(dAtA[i:], m.SlashRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SlashRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MaxValidatorRestakedPowerShare.Size()
+		i -= size
+		if _, err := m.MaxValidatorRestakedPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxRestakedPowerShare.Size()
+		i -= size
+		if _, err := m.MaxRestakedPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DenomRemovalGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DenomRemovalGracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxUnbondingEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnbondingEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxUnbondingEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxUnbondingEntries))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DenomRemovalGracePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxRestakedPowerShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxValidatorRestakedPowerShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.SlashRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxDenomRemovalUnbonds != 0 {
+		n += 1 + sovParams(uint64(m.MaxDenomRemovalUnbonds))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondingEntries", wireType)
+			}
+			m.MaxUnbondingEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnbondingEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRemovalGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DenomRemovalGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestakedPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRestakedPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorRestakedPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorRestakedPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomRemovalUnbonds", wireType)
+			}
+			m.MaxDenomRemovalUnbonds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomRemovalUnbonds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1198 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celinium/restaking/multistake/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorRestakedPower is the tokens of a validator which come from native delegations and multistaking agents.
+type ValidatorRestakedPower struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	NativeTokens     Int    `protobuf:"bytes,2,opt,name=native_tokens,json=nativeTokens,proto3,customtype=Int" json:"native_tokens"`
+	RestakedTokens   Int    `protobuf:"bytes,3,opt,name=restaked_tokens,json=restakedTokens,proto3,customtype=Int" json:"restaked_tokens"`
+}
+
+func (m *ValidatorRestakedPower) Reset()         { *m = ValidatorRestakedPower{} }
+func (m *ValidatorRestakedPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRestakedPower) ProtoMessage()    {}
+func (*ValidatorRestakedPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b66667ea3081f, []int{0}
+}
+func (m *ValidatorRestakedPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRestakedPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRestakedPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRestakedPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRestakedPower.Merge(m, src)
+}
+func (m *ValidatorRestakedPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRestakedPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRestakedPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRestakedPower proto.InternalMessageInfo
+
+func (m *ValidatorRestakedPower) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+type QueryValidatorRestakedPowerRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorRestakedPowerRequest) Reset()         { *m = QueryValidatorRestakedPowerRequest{} }
+func (m *QueryValidatorRestakedPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRestakedPowerRequest) ProtoMessage()    {}
+func (*QueryValidatorRestakedPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b66667ea3081f, []int{1}
+}
+func (m *QueryValidatorRestakedPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRestakedPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRestakedPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRestakedPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRestakedPowerRequest.Merge(m, src)
+}
+func (m *QueryValidatorRestakedPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRestakedPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRestakedPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRestakedPowerRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRestakedPowerRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+type QueryValidatorRestakedPowerResponse struct {
+	Power ValidatorRestakedPower `protobuf:"bytes,1,opt,name=power,proto3" json:"power"`
+}
+
+func (m *QueryValidatorRestakedPowerResponse) Reset()         { *m = QueryValidatorRestakedPowerResponse{} }
+func (m *QueryValidatorRestakedPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRestakedPowerResponse) ProtoMessage()    {}
+func (*QueryValidatorRestakedPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b66667ea3081f, []int{2}
+}
+func (m *QueryValidatorRestakedPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRestakedPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRestakedPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRestakedPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRestakedPowerResponse.Merge(m, src)
+}
+func (m *QueryValidatorRestakedPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRestakedPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRestakedPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRestakedPowerResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRestakedPowerResponse) GetPower() ValidatorRestakedPower {
+	if m != nil {
+		return m.Power
+	}
+	return ValidatorRestakedPower{}
+}
+
+type QueryValidatorsRestakedPowerRequest struct {
+}
+
+func (m *QueryValidatorsRestakedPowerRequest) Reset()         { *m = QueryValidatorsRestakedPowerRequest{} }
+func (m *QueryValidatorsRestakedPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsRestakedPowerRequest) ProtoMessage()    {}
+func (*QueryValidatorsRestakedPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b66667ea3081f, []int{3}
+}
+func (m *QueryValidatorsRestakedPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsRestakedPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsRestakedPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsRestakedPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsRestakedPowerRequest.Merge(m, src)
+}
+func (m *QueryValidatorsRestakedPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsRestakedPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsRestakedPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsRestakedPowerRequest proto.InternalMessageInfo
+
+type QueryValidatorsRestakedPowerResponse struct {
+	Powers []ValidatorRestakedPower `protobuf:"bytes,1,rep,name=powers,proto3" json:"powers"`
+}
+
+func (m *QueryValidatorsRestakedPowerResponse) Reset()         { *m = QueryValidatorsRestakedPowerResponse{} }
+func (m *QueryValidatorsRestakedPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsRestakedPowerResponse) ProtoMessage()    {}
+func (*QueryValidatorsRestakedPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_968b66667ea3081f, []int{4}
+}
+func (m *QueryValidatorsRestakedPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsRestakedPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsRestakedPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorsRestakedPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsRestakedPowerResponse.Merge(m, src)
+}
+func (m *QueryValidatorsRestakedPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsRestakedPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsRestakedPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorsRestakedPowerResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsRestakedPowerResponse) GetPowers() []ValidatorRestakedPower {
+	if m != nil {
+		return m.Powers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ValidatorRestakedPower)(nil), "celinium.restaking.multistake.v1.ValidatorRestakedPower")
+	proto.RegisterType((*QueryValidatorRestakedPowerRequest)(nil), "celinium.restaking.multistake.v1.QueryValidatorRestakedPowerRequest")
+	proto.RegisterType((*QueryValidatorRestakedPowerResponse)(nil), "celinium.restaking.multistake.v1.QueryValidatorRestakedPowerResponse")
+	proto.RegisterType((*QueryValidatorsRestakedPowerRequest)(nil), "celinium.restaking.multistake.v1.QueryValidatorsRestakedPowerRequest")
+	proto.RegisterType((*QueryValidatorsRestakedPowerResponse)(nil), "celinium.restaking.multistake.v1.QueryValidatorsRestakedPowerResponse")
+}
+
+func init() {
+	proto.RegisterFile("celinium/restaking/multistake/v1/query.proto", fileDescriptor_968b66667ea3081f)
+}
+
+var fileDescriptor_968b66667ea3081f = []byte{
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x37, 0x5d, 0x5b, 0x70, 0xfc, 0x3d, 0x88, 0xb6, 0x8b, 0xa6, 0x35, 0x2a, 0x7a, 0xb0,
+	0x19, 0x5a, 0x2f, 0x82, 0x28, 0x34, 0xfe, 0x80, 0x5e, 0x4a, 0x8d, 0x65, 0x0f, 0x5e, 0x96, 0xb1,
+	0x19, 0xc2, 0xd0, 0xec, 0x4c, 0x3a, 0x33, 0x89, 0x96, 0xa2, 0x07, 0xff, 0x02, 0xc1, 0x7f, 0xa5,
+	0x7f, 0x44, 0x8f, 0xa5, 0x5e, 0xc4, 0x43, 0x91, 0x5d, 0x8f, 0x7a, 0xf6, 0x24, 0x48, 0x66, 0x26,
+	0x29, 0xac, 0x69, 0x43, 0xe9, 0xde, 0x76, 0xe6, 0x7d, 0xe7, 0xf3, 0xde, 0xfb, 0xbe, 0xb7, 0x01,
+	0x0f, 0xd6, 0x49, 0x42, 0x19, 0xcd, 0xfa, 0x48, 0x10, 0xa9, 0xf0, 0x06, 0x65, 0x31, 0xea, 0x67,
+	0x89, 0xa2, 0xc5, 0x81, 0xa0, 0x7c, 0x01, 0x6d, 0x66, 0x44, 0x6c, 0xf9, 0xa9, 0xe0, 0x8a, 0xc3,
+	0xb9, 0x52, 0xed, 0x57, 0x6a, 0xff, 0x50, 0xed, 0xe7, 0x0b, 0x9d, 0xab, 0x31, 0x8f, 0xb9, 0x16,
+	0xa3, 0xe2, 0x97, 0x79, 0xd7, 0x99, 0x59, 0xe7, 0xb2, 0xcf, 0x65, 0xcf, 0x04, 0xcc, 0xc1, 0x86,
+	0x6e, 0xc4, 0x9c, 0xc7, 0x09, 0x41, 0x38, 0xa5, 0x08, 0x33, 0xc6, 0x15, 0x56, 0x94, 0x33, 0x1b,
+	0xf5, 0x7e, 0x39, 0xe0, 0x5a, 0x17, 0x27, 0x34, 0xc2, 0x8a, 0x8b, 0x50, 0xe7, 0x24, 0xd1, 0x2a,
+	0x7f, 0x47, 0x04, 0x5c, 0x01, 0x57, 0xf2, 0x32, 0xd2, 0xc3, 0x51, 0x24, 0x88, 0x94, 0xd3, 0xce,
+	0x9c, 0x73, 0xff, 0x6c, 0x70, 0x6b, 0x7f, 0x67, 0xfe, 0xa6, 0xcd, 0x52, 0xbd, 0x5e, 0x32, 0x92,
+	0xd7, 0x4a, 0x50, 0x16, 0x87, 0x97, 0xf3, 0x91, 0x7b, 0xf8, 0x14, 0x5c, 0x60, 0x58, 0xd1, 0x9c,
+	0xf4, 0x14, 0xdf, 0x20, 0x4c, 0x4e, 0x4f, 0x68, 0xd6, 0xcc, 0xee, 0xc1, 0x6c, 0xeb, 0xfb, 0xc1,
+	0x6c, 0x7b, 0x99, 0xa9, 0xfd, 0x9d, 0x79, 0x60, 0xb1, 0xcb, 0x4c, 0x85, 0xe7, 0x8d, 0x7e, 0x4d,
+	0xcb, 0x61, 0x00, 0x2e, 0x09, 0x5b, 0x60, 0x49, 0x68, 0x37, 0x11, 0x2e, 0x96, 0x2f, 0x0c, 0xc3,
+	0x53, 0xc0, 0x7b, 0x55, 0xd8, 0x5d, 0xdf, 0x72, 0x48, 0x36, 0x33, 0x22, 0xd5, 0xb8, 0x3b, 0xf7,
+	0xb6, 0xc1, 0xed, 0x63, 0xb3, 0xca, 0x94, 0x33, 0x49, 0xe0, 0x1a, 0x98, 0x4c, 0x8b, 0x0b, 0x9d,
+	0xea, 0xdc, 0xe2, 0x23, 0xbf, 0x69, 0x19, 0xfc, 0x7a, 0x60, 0x70, 0xa6, 0x30, 0x24, 0x34, 0x30,
+	0xef, 0xee, 0x68, 0x72, 0x59, 0xd7, 0xb3, 0xf7, 0x11, 0xdc, 0x39, 0x5e, 0x66, 0x8b, 0xec, 0x82,
+	0x29, 0xcd, 0x2d, 0x0c, 0x69, 0x8f, 0xa1, 0x4a, 0x4b, 0x5b, 0xfc, 0xd3, 0x06, 0x93, 0xba, 0x00,
+	0xf8, 0xf7, 0xe8, 0x95, 0x7c, 0xde, 0x9c, 0xac, 0x79, 0xbc, 0x9d, 0x17, 0xa7, 0xa4, 0x18, 0x27,
+	0xbc, 0xee, 0xa7, 0xaf, 0x3f, 0xbf, 0x4c, 0xac, 0xc2, 0x15, 0xd4, 0xf8, 0x17, 0x3f, 0xdc, 0xa6,
+	0x6a, 0x83, 0x75, 0xdb, 0x68, 0xfb, 0xbf, 0x3d, 0xfb, 0x00, 0x7f, 0x3b, 0xe0, 0xfa, 0x11, 0x53,
+	0x80, 0x27, 0x2e, 0xbd, 0x76, 0xd8, 0x9d, 0x97, 0xa7, 0xc5, 0x58, 0x0b, 0x9e, 0x69, 0x0b, 0x9e,
+	0xc0, 0xc7, 0x27, 0xb0, 0x40, 0x8e, 0x78, 0x10, 0x2c, 0xed, 0x0e, 0x5c, 0x67, 0x6f, 0xe0, 0x3a,
+	0x3f, 0x06, 0xae, 0xf3, 0x79, 0xe8, 0xb6, 0xf6, 0x86, 0x6e, 0xeb, 0xdb, 0xd0, 0x6d, 0xbd, 0xb9,
+	0x57, 0x51, 0xdf, 0xd7, 0x71, 0x8b, 0x83, 0xda, 0x4a, 0x89, 0x7c, 0x3b, 0xa5, 0x3f, 0x66, 0x0f,
+	0xff, 0x0d, 0x00, 0xd8, 0x18, 0x1b, 0xec, 0x6d, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	ValidatorRestakedPower(ctx context.Context, in *QueryValidatorRestakedPowerRequest, opts ...grpc.CallOption) (*QueryValidatorRestakedPowerResponse, error)
+	ValidatorsRestakedPower(ctx context.Context, in *QueryValidatorsRestakedPowerRequest, opts ...grpc.CallOption) (*QueryValidatorsRestakedPowerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ValidatorRestakedPower(ctx context.Context, in *QueryValidatorRestakedPowerRequest, opts ...grpc.CallOption) (*QueryValidatorRestakedPowerResponse, error) {
+	out := new(QueryValidatorRestakedPowerResponse)
+	err := c.cc.Invoke(ctx, "/celinium.restaking.multistake.v1.Query/ValidatorRestakedPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsRestakedPower(ctx context.Context, in *QueryValidatorsRestakedPowerRequest, opts ...grpc.CallOption) (*QueryValidatorsRestakedPowerResponse, error) {
+	out := new(QueryValidatorsRestakedPowerResponse)
+	err := c.cc.Invoke(ctx, "/celinium.restaking.multistake.v1.Query/ValidatorsRestakedPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	ValidatorRestakedPower(context.Context, *QueryValidatorRestakedPowerRequest) (*QueryValidatorRestakedPowerResponse, error)
+	ValidatorsRestakedPower(context.Context, *QueryValidatorsRestakedPowerRequest) (*QueryValidatorsRestakedPowerResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ValidatorRestakedPower(ctx context.Context, req *QueryValidatorRestakedPowerRequest) (*QueryValidatorRestakedPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRestakedPower not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorsRestakedPower(ctx context.Context, req *QueryValidatorsRestakedPowerRequest) (*QueryValidatorsRestakedPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsRestakedPower not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ValidatorRestakedPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRestakedPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRestakedPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.restaking.multistake.v1.Query/ValidatorRestakedPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRestakedPower(ctx, req.(*QueryValidatorRestakedPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsRestakedPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsRestakedPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsRestakedPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.restaking.multistake.v1.Query/ValidatorsRestakedPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsRestakedPower(ctx, req.(*QueryValidatorsRestakedPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celinium.restaking.multistake.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidatorRestakedPower",
+			Handler:    _Query_ValidatorRestakedPower_Handler,
+		},
+		{
+			MethodName: "ValidatorsRestakedPower",
+			Handler:    _Query_ValidatorsRestakedPower_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celinium/restaking/multistake/v1/query.proto",
+}
+
+func (m *ValidatorRestakedPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRestakedPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRestakedPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RestakedTokens.Size()
+		i -= size
+		if _, err := m.RestakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeTokens.Size()
+		i -= size
+		if _, err := m.NativeTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRestakedPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRestakedPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRestakedPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRestakedPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRestakedPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRestakedPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Power.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsRestakedPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsRestakedPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsRestakedPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsRestakedPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsRestakedPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsRestakedPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Powers) > 0 {
+		for iNdEx := len(m.Powers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Powers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorRestakedPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.NativeTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RestakedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorRestakedPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRestakedPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Power.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorsRestakedPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorsRestakedPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Powers) > 0 {
+		for _, e := range m.Powers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorRestakedPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRestakedPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRestakedPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRestakedPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRestakedPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRestakedPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRestakedPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRestakedPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRestakedPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsRestakedPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsRestakedPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsRestakedPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsRestakedPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsRestakedPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsRestakedPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Powers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Powers = append(m.Powers, ValidatorRestakedPower{})
+			if err := m.Powers[len(m.Powers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -35,8 +35,13 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgAddMultiStakingDenom struct {
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	Deonm  string `protobuf:"bytes,2,opt,name=deonm,proto3" json:"deonm,omitempty"`
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Deonm     string `protobuf:"bytes,2,opt,name=deonm,proto3" json:"deonm,omitempty"`
+	// the caps of the denom, zero means no cap.
+	MaxTotalAmount         Int `protobuf:"bytes,3,opt,name=max_total_amount,json=maxTotalAmount,proto3,customtype=Int" json:"max_total_amount"`
+	MaxValidatorPowerShare Dec `protobuf:"bytes,4,opt,name=max_validator_power_share,json=maxValidatorPowerShare,proto3,customtype=Dec" json:"max_validator_power_share"`
+	MinDelegation          Int `protobuf:"bytes,5,opt,name=min_delegation,json=minDelegation,proto3,customtype=Int" json:"min_delegation"`
 }
 
 func (m *MsgAddMultiStakingDenom) Reset()         { *m = MsgAddMultiStakingDenom{} }
@@ -72,9 +77,9 @@ func (m *MsgAddMultiStakingDenom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddMultiStakingDenom proto.InternalMessageInfo
 
-func (m *MsgAddMultiStakingDenom) GetSender() string {
+func (m *MsgAddMultiStakingDenom) GetAuthority() string {
 	if m != nil {
-		return m.Sender
+		return m.Authority
 	}
 	return ""
 }
@@ -122,6 +127,95 @@ func (m *MsgAddMultiStakingDenomResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddMultiStakingDenomResponse proto.InternalMessageInfo
 
+type MsgRemoveMultiStakingDenom struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveMultiStakingDenom) Reset()         { *m = MsgRemoveMultiStakingDenom{} }
+func (m *MsgRemoveMultiStakingDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMultiStakingDenom) ProtoMessage()    {}
+func (*MsgRemoveMultiStakingDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{2}
+}
+func (m *MsgRemoveMultiStakingDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMultiStakingDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMultiStakingDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMultiStakingDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMultiStakingDenom.Merge(m, src)
+}
+func (m *MsgRemoveMultiStakingDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMultiStakingDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMultiStakingDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMultiStakingDenom proto.InternalMessageInfo
+
+func (m *MsgRemoveMultiStakingDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveMultiStakingDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgRemoveMultiStakingDenomResponse struct {
+}
+
+func (m *MsgRemoveMultiStakingDenomResponse) Reset()         { *m = MsgRemoveMultiStakingDenomResponse{} }
+func (m *MsgRemoveMultiStakingDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveMultiStakingDenomResponse) ProtoMessage()    {}
+func (*MsgRemoveMultiStakingDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{3}
+}
+func (m *MsgRemoveMultiStakingDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveMultiStakingDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveMultiStakingDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveMultiStakingDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveMultiStakingDenomResponse.Merge(m, src)
+}
+func (m *MsgRemoveMultiStakingDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveMultiStakingDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveMultiStakingDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveMultiStakingDenomResponse proto.InternalMessageInfo
+
 type MsgMultiStakingDelegate struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -132,7 +226,7 @@ func (m *MsgMultiStakingDelegate) Reset()         { *m = MsgMultiStakingDelegate
 func (m *MsgMultiStakingDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiStakingDelegate) ProtoMessage()    {}
 func (*MsgMultiStakingDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a477979d5ff9d4, []int{2}
+	return fileDescriptor_46a477979d5ff9d4, []int{4}
 }
 func (m *MsgMultiStakingDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +262,7 @@ func (m *MsgMultiStakingDelegateResponse) Reset()         { *m = MsgMultiStaking
 func (m *MsgMultiStakingDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiStakingDelegateResponse) ProtoMessage()    {}
 func (*MsgMultiStakingDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a477979d5ff9d4, []int{3}
+	return fileDescriptor_46a477979d5ff9d4, []int{5}
 }
 func (m *MsgMultiStakingDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +301,7 @@ func (m *MsgMultiStakingUndelegate) Reset()         { *m = MsgMultiStakingUndele
 func (m *MsgMultiStakingUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiStakingUndelegate) ProtoMessage()    {}
 func (*MsgMultiStakingUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a477979d5ff9d4, []int{4}
+	return fileDescriptor_46a477979d5ff9d4, []int{6}
 }
 func (m *MsgMultiStakingUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,7 +339,7 @@ func (m *MsgMultiStakingUndelegateResponse) Reset()         { *m = MsgMultiStaki
 func (m *MsgMultiStakingUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMultiStakingUndelegateResponse) ProtoMessage()    {}
 func (*MsgMultiStakingUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a477979d5ff9d4, []int{5}
+	return fileDescriptor_46a477979d5ff9d4, []int{7}
 }
 func (m *MsgMultiStakingUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,13 +382,276 @@ func (m *MsgMultiStakingUndelegateResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+type MsgMultiStakingBeginRedelegate struct {
+	DelegatorAddress    string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorSrcAddress string     `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	ValidatorDstAddress string     `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	Amount              types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgMultiStakingBeginRedelegate) Reset()         { *m = MsgMultiStakingBeginRedelegate{} }
+func (m *MsgMultiStakingBeginRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiStakingBeginRedelegate) ProtoMessage()    {}
+func (*MsgMultiStakingBeginRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{8}
+}
+func (m *MsgMultiStakingBeginRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiStakingBeginRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiStakingBeginRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiStakingBeginRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiStakingBeginRedelegate.Merge(m, src)
+}
+func (m *MsgMultiStakingBeginRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiStakingBeginRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiStakingBeginRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiStakingBeginRedelegate proto.InternalMessageInfo
+
+type MsgMultiStakingBeginRedelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgMultiStakingBeginRedelegateResponse) Reset() {
+	*m = MsgMultiStakingBeginRedelegateResponse{}
+}
+func (m *MsgMultiStakingBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiStakingBeginRedelegateResponse) ProtoMessage()    {}
+func (*MsgMultiStakingBeginRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{9}
+}
+func (m *MsgMultiStakingBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiStakingBeginRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiStakingBeginRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiStakingBeginRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiStakingBeginRedelegateResponse.Merge(m, src)
+}
+func (m *MsgMultiStakingBeginRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiStakingBeginRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiStakingBeginRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiStakingBeginRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgMultiStakingBeginRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+type MsgCancelMultiStakingUnbonding struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the restaked coin to cancel from the unbonding entry
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the height which the unbonding entry was created
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+}
+
+func (m *MsgCancelMultiStakingUnbonding) Reset()         { *m = MsgCancelMultiStakingUnbonding{} }
+func (m *MsgCancelMultiStakingUnbonding) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMultiStakingUnbonding) ProtoMessage()    {}
+func (*MsgCancelMultiStakingUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{10}
+}
+func (m *MsgCancelMultiStakingUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMultiStakingUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultiStakingUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMultiStakingUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultiStakingUnbonding.Merge(m, src)
+}
+func (m *MsgCancelMultiStakingUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMultiStakingUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultiStakingUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMultiStakingUnbonding proto.InternalMessageInfo
+
+type MsgCancelMultiStakingUnbondingResponse struct {
+}
+
+func (m *MsgCancelMultiStakingUnbondingResponse) Reset() {
+	*m = MsgCancelMultiStakingUnbondingResponse{}
+}
+func (m *MsgCancelMultiStakingUnbondingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMultiStakingUnbondingResponse) ProtoMessage()    {}
+func (*MsgCancelMultiStakingUnbondingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{11}
+}
+func (m *MsgCancelMultiStakingUnbondingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMultiStakingUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMultiStakingUnbondingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMultiStakingUnbondingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMultiStakingUnbondingResponse.Merge(m, src)
+}
+func (m *MsgCancelMultiStakingUnbondingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMultiStakingUnbondingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMultiStakingUnbondingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelMultiStakingUnbondingResponse proto.InternalMessageInfo
+
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a477979d5ff9d4, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddMultiStakingDenom)(nil), "celinium.restaking.multistake.v1.MsgAddMultiStakingDenom")
 	proto.RegisterType((*MsgAddMultiStakingDenomResponse)(nil), "celinium.restaking.multistake.v1.MsgAddMultiStakingDenomResponse")
+	proto.RegisterType((*MsgRemoveMultiStakingDenom)(nil), "celinium.restaking.multistake.v1.MsgRemoveMultiStakingDenom")
+	proto.RegisterType((*MsgRemoveMultiStakingDenomResponse)(nil), "celinium.restaking.multistake.v1.MsgRemoveMultiStakingDenomResponse")
 	proto.RegisterType((*MsgMultiStakingDelegate)(nil), "celinium.restaking.multistake.v1.MsgMultiStakingDelegate")
 	proto.RegisterType((*MsgMultiStakingDelegateResponse)(nil), "celinium.restaking.multistake.v1.MsgMultiStakingDelegateResponse")
 	proto.RegisterType((*MsgMultiStakingUndelegate)(nil), "celinium.restaking.multistake.v1.MsgMultiStakingUndelegate")
 	proto.RegisterType((*MsgMultiStakingUndelegateResponse)(nil), "celinium.restaking.multistake.v1.MsgMultiStakingUndelegateResponse")
+	proto.RegisterType((*MsgMultiStakingBeginRedelegate)(nil), "celinium.restaking.multistake.v1.MsgMultiStakingBeginRedelegate")
+	proto.RegisterType((*MsgMultiStakingBeginRedelegateResponse)(nil), "celinium.restaking.multistake.v1.MsgMultiStakingBeginRedelegateResponse")
+	proto.RegisterType((*MsgCancelMultiStakingUnbonding)(nil), "celinium.restaking.multistake.v1.MsgCancelMultiStakingUnbonding")
+	proto.RegisterType((*MsgCancelMultiStakingUnbondingResponse)(nil), "celinium.restaking.multistake.v1.MsgCancelMultiStakingUnbondingResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "celinium.restaking.multistake.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "celinium.restaking.multistake.v1.MsgUpdateParamsResponse")
 }
 
 func init() {
@@ -302,42 +659,65 @@ func init() {
 }
 
 var fileDescriptor_46a477979d5ff9d4 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xbf, 0x6f, 0x13, 0x3f,
-	0x14, 0x3f, 0x37, 0xdf, 0x6f, 0x54, 0x5c, 0xf1, 0x23, 0xa7, 0x08, 0x92, 0x48, 0xdc, 0x25, 0x59,
-	0x68, 0x87, 0xfa, 0x94, 0x30, 0x20, 0xca, 0x94, 0x00, 0x63, 0x18, 0xae, 0xc0, 0xd0, 0x25, 0xba,
-	0xe4, 0xcc, 0xc9, 0xe2, 0x6c, 0x47, 0x67, 0x27, 0x6a, 0xff, 0x03, 0xc6, 0x0e, 0x0c, 0x8c, 0xdd,
-	0x59, 0xfb, 0x47, 0x74, 0x42, 0x55, 0x27, 0xa6, 0x82, 0x92, 0x85, 0x19, 0xc4, 0x8e, 0x7c, 0x76,
-	0x2e, 0x34, 0xba, 0x40, 0x8b, 0x98, 0xd8, 0xfc, 0xde, 0xfb, 0xbc, 0xe7, 0xcf, 0xfb, 0xc8, 0x1f,
-	0xc3, 0xad, 0x21, 0x8e, 0x09, 0x23, 0x63, 0xea, 0x25, 0x58, 0xc8, 0xe0, 0x35, 0x61, 0x91, 0x47,
-	0xc7, 0xb1, 0x24, 0x2a, 0xc0, 0xde, 0xa4, 0xe5, 0xc9, 0x7d, 0x34, 0x4a, 0xb8, 0xe4, 0x76, 0x7d,
-	0x0e, 0x45, 0x19, 0x14, 0x2d, 0xa0, 0x68, 0xd2, 0xaa, 0xb9, 0x11, 0xe7, 0x51, 0x8c, 0xbd, 0x14,
-	0x3f, 0x18, 0xbf, 0xf2, 0x24, 0xa1, 0x0a, 0x4a, 0x47, 0x7a, 0x44, 0xad, 0x1c, 0xf1, 0x88, 0xa7,
-	0x47, 0x4f, 0x9d, 0x4c, 0xb6, 0x3a, 0xe4, 0x82, 0x72, 0xd1, 0xd7, 0x05, 0x1d, 0x98, 0x92, 0xa3,
-	0x23, 0x6f, 0x10, 0x08, 0x45, 0x66, 0x80, 0x65, 0xd0, 0xf2, 0x86, 0x9c, 0x30, 0x5d, 0x6f, 0xee,
-	0xc1, 0x3b, 0x3d, 0x11, 0x75, 0xc2, 0xb0, 0xa7, 0x88, 0xec, 0x6a, 0x56, 0x4f, 0x30, 0xe3, 0xd4,
-	0xde, 0x82, 0x45, 0x81, 0x59, 0x88, 0x93, 0x0a, 0xa8, 0x83, 0xcd, 0x6b, 0xdd, 0xd2, 0xd7, 0x73,
-	0xf7, 0xfa, 0x41, 0x40, 0xe3, 0x9d, 0xa6, 0xce, 0x37, 0x7d, 0x03, 0xb0, 0xcb, 0xf0, 0xff, 0x10,
-	0x73, 0x46, 0x2b, 0x6b, 0x0a, 0xe9, 0xeb, 0xa0, 0xd9, 0x80, 0xee, 0x8a, 0xd9, 0x3e, 0x16, 0x23,
-	0xce, 0x04, 0x6e, 0x7e, 0x03, 0xe9, 0xfd, 0x17, 0x01, 0x31, 0x8e, 0x02, 0x89, 0xed, 0xa7, 0xb0,
-	0x14, 0xea, 0x33, 0x4f, 0xfa, 0x41, 0x18, 0x26, 0x58, 0x08, 0x43, 0xa5, 0x72, 0x76, 0xbc, 0x5d,
-	0x36, 0x7b, 0x76, 0x74, 0x65, 0x57, 0x26, 0x84, 0x45, 0xfe, 0xad, 0xac, 0xc5, 0xe4, 0xed, 0x67,
-	0xb0, 0x34, 0x09, 0x62, 0x12, 0x5e, 0x18, 0x93, 0xf2, 0xec, 0x36, 0xce, 0x8e, 0xb7, 0xef, 0x9a,
-	0x31, 0x2f, 0xe7, 0x98, 0xa5, 0x79, 0x93, 0xa5, 0xbc, 0xfd, 0x00, 0x16, 0x03, 0xca, 0xc7, 0x4c,
-	0x56, 0x0a, 0x75, 0xb0, 0xb9, 0xd1, 0xae, 0x22, 0x33, 0x41, 0x49, 0x8c, 0x8c, 0xc4, 0xe8, 0x31,
-	0x27, 0xac, 0xfb, 0xdf, 0xc9, 0xb9, 0x6b, 0xf9, 0x06, 0xbe, 0xb3, 0xfe, 0xe6, 0xc8, 0xb5, 0xbe,
-	0x1c, 0xb9, 0x96, 0x11, 0x26, 0x6f, 0xe9, 0x4c, 0x98, 0xef, 0x00, 0x56, 0x97, 0x30, 0x2f, 0x58,
-	0xf8, 0xef, 0x4b, 0xf3, 0x1e, 0xc0, 0xc6, 0xca, 0xbd, 0xe7, 0xea, 0xd8, 0x3d, 0x78, 0x73, 0xc8,
-	0xe9, 0x28, 0xc6, 0x92, 0x70, 0xd6, 0x57, 0x26, 0x49, 0xb7, 0xdf, 0x68, 0xd7, 0x90, 0x76, 0x10,
-	0x9a, 0x3b, 0x08, 0x3d, 0x9f, 0x3b, 0xa8, 0xbb, 0xae, 0xae, 0x3c, 0xfc, 0xe4, 0x02, 0xff, 0xc6,
-	0xa2, 0x59, 0x95, 0x7f, 0xe2, 0xbd, 0x76, 0x25, 0xde, 0xed, 0x0f, 0x05, 0x58, 0xe8, 0x89, 0xc8,
-	0x7e, 0x0b, 0x60, 0x39, 0xd7, 0x43, 0x0f, 0xd1, 0xef, 0x3c, 0x8f, 0x56, 0x58, 0xa4, 0xd6, 0xf9,
-	0xe3, 0xd6, 0x4c, 0x26, 0x45, 0x2b, 0xd7, 0x5a, 0x97, 0xa3, 0x95, 0xd7, 0x7a, 0x49, 0x5a, 0xbf,
-	0x7a, 0xdb, 0xf6, 0x3b, 0x00, 0x6f, 0xaf, 0x78, 0xd8, 0x8f, 0xae, 0x3c, 0x7d, 0xd1, 0xfc, 0x17,
-	0xa8, 0x75, 0x3b, 0x27, 0x53, 0x07, 0x9c, 0x4e, 0x1d, 0xf0, 0x79, 0xea, 0x80, 0xc3, 0x99, 0x63,
-	0x9d, 0xce, 0x1c, 0xeb, 0xe3, 0xcc, 0xb1, 0xf6, 0xee, 0x65, 0xff, 0xfc, 0x7e, 0xde, 0x4f, 0xaf,
-	0x02, 0x79, 0x30, 0xc2, 0x62, 0x50, 0x4c, 0x9f, 0xde, 0xfd, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x2c, 0x81, 0x55, 0x5e, 0x19, 0x06, 0x00, 0x00,
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xbd, 0x6f, 0x1c, 0x45,
+	0x14, 0xbf, 0xf5, 0x39, 0x96, 0x33, 0x01, 0x3b, 0x59, 0x4c, 0x7c, 0xb7, 0x88, 0xbd, 0x78, 0x85,
+	0x12, 0x53, 0x78, 0x57, 0x36, 0x12, 0x28, 0x40, 0x91, 0x3b, 0x1f, 0xc8, 0x29, 0x0e, 0x45, 0x6b,
+	0x9b, 0x82, 0x66, 0x35, 0xb7, 0x3b, 0xec, 0x0d, 0xec, 0xcc, 0xac, 0x76, 0xe6, 0x2e, 0x17, 0x89,
+	0x3f, 0x80, 0x32, 0x48, 0x14, 0x34, 0x48, 0x91, 0xa8, 0x10, 0xad, 0xff, 0x84, 0x14, 0x29, 0x23,
+	0x57, 0x11, 0x45, 0x40, 0x76, 0x43, 0x8f, 0xe8, 0xd1, 0xec, 0xd7, 0x7d, 0x70, 0xdf, 0x36, 0x8d,
+	0xbb, 0x9b, 0x99, 0xf7, 0xfb, 0xbd, 0xdf, 0xbc, 0xf7, 0xe6, 0xbd, 0x3d, 0xf0, 0xbe, 0x8b, 0x02,
+	0x4c, 0x71, 0x9b, 0x58, 0x11, 0xe2, 0x02, 0x7e, 0x8b, 0xa9, 0x6f, 0x91, 0x76, 0x20, 0xb0, 0x5c,
+	0x20, 0xab, 0xb3, 0x6b, 0x89, 0xae, 0x19, 0x46, 0x4c, 0x30, 0xf5, 0x4e, 0x66, 0x6a, 0xe6, 0xa6,
+	0x66, 0xcf, 0xd4, 0xec, 0xec, 0x6a, 0x15, 0x9f, 0x31, 0x3f, 0x40, 0x56, 0x6c, 0xdf, 0x6c, 0x7f,
+	0x6d, 0x09, 0x4c, 0xa4, 0x29, 0x09, 0x13, 0x0a, 0x6d, 0xc3, 0x67, 0x3e, 0x8b, 0x7f, 0x5a, 0xf2,
+	0x57, 0xba, 0x5b, 0x76, 0x19, 0x27, 0x8c, 0x3b, 0xc9, 0x41, 0xb2, 0x48, 0x8f, 0xf4, 0x64, 0x65,
+	0x35, 0x21, 0x97, 0x62, 0x9a, 0x48, 0xc0, 0x5d, 0xcb, 0x65, 0x98, 0xa6, 0xe7, 0x3b, 0x53, 0xe5,
+	0x87, 0x30, 0x82, 0x24, 0xa5, 0x33, 0x9e, 0x2f, 0x81, 0xcd, 0x06, 0xf7, 0xab, 0x9e, 0xd7, 0x90,
+	0x46, 0x87, 0x09, 0xa2, 0x8e, 0x28, 0x23, 0xea, 0x87, 0xe0, 0x3a, 0x6c, 0x8b, 0x16, 0x8b, 0xb0,
+	0x78, 0x52, 0x52, 0xee, 0x28, 0xdb, 0xd7, 0x6b, 0xa5, 0xd3, 0x93, 0x9d, 0x8d, 0x54, 0x4f, 0xd5,
+	0xf3, 0x22, 0xc4, 0xf9, 0xa1, 0x88, 0x30, 0xf5, 0xed, 0x9e, 0xa9, 0xba, 0x01, 0xae, 0x79, 0x88,
+	0x51, 0x52, 0x5a, 0x92, 0x18, 0x3b, 0x59, 0xa8, 0xfb, 0xe0, 0x26, 0x81, 0x5d, 0x47, 0x30, 0x01,
+	0x03, 0x07, 0x12, 0xd6, 0xa6, 0xa2, 0x54, 0x8c, 0x49, 0xcb, 0x2f, 0x5e, 0x57, 0x0a, 0xbf, 0xbf,
+	0xae, 0x14, 0x1f, 0x52, 0x71, 0x7a, 0xb2, 0x03, 0x52, 0xfe, 0x87, 0x54, 0xd8, 0x6b, 0x04, 0x76,
+	0x8f, 0x24, 0xa2, 0x1a, 0x03, 0xd4, 0x23, 0x50, 0x96, 0x24, 0x1d, 0x18, 0x60, 0x0f, 0x0a, 0x16,
+	0x39, 0x21, 0x7b, 0x8c, 0x22, 0x87, 0xb7, 0x60, 0x84, 0x4a, 0xcb, 0x83, 0x6c, 0x75, 0xe4, 0xf6,
+	0xb1, 0xd5, 0x91, 0x6b, 0xdf, 0x26, 0xb0, 0xfb, 0x65, 0x06, 0x7d, 0x24, 0x91, 0x87, 0x12, 0xa8,
+	0x3e, 0x00, 0x6b, 0x04, 0x53, 0xc7, 0x43, 0x01, 0xf2, 0xa1, 0xc0, 0x8c, 0x96, 0xae, 0x4d, 0x13,
+	0xf6, 0x26, 0xc1, 0xb4, 0x9e, 0xdb, 0x1b, 0x5b, 0xa0, 0x32, 0x26, 0x8a, 0x36, 0xe2, 0x21, 0xa3,
+	0x1c, 0x19, 0xdf, 0x00, 0xad, 0xc1, 0x7d, 0x1b, 0x11, 0xd6, 0x41, 0x97, 0x1c, 0x6b, 0xca, 0xfa,
+	0x62, 0x4d, 0x19, 0x31, 0xde, 0x03, 0xc6, 0x78, 0x5f, 0xb9, 0xa2, 0xbf, 0x95, 0x38, 0xf7, 0x83,
+	0x06, 0xf1, 0x95, 0x90, 0xfa, 0x19, 0xb8, 0x95, 0x86, 0x83, 0x45, 0x0e, 0x4c, 0xbc, 0x4f, 0xd5,
+	0x75, 0x33, 0x87, 0xa4, 0xfb, 0xea, 0x17, 0xe0, 0x56, 0x2f, 0x57, 0x19, 0x4d, 0x2c, 0xb5, 0xb6,
+	0x75, 0x7a, 0xb2, 0xf3, 0x6e, 0x4a, 0x93, 0x27, 0x65, 0x88, 0xaf, 0x33, 0xb4, 0xaf, 0x7e, 0x04,
+	0x56, 0xfa, 0x4a, 0xe7, 0xc6, 0x5e, 0xd9, 0x4c, 0x19, 0xe4, 0x73, 0x30, 0xd3, 0xe7, 0x60, 0xee,
+	0x33, 0x4c, 0x6b, 0xcb, 0x32, 0x79, 0x76, 0x6a, 0xfe, 0xf1, 0xea, 0xf7, 0xcf, 0x2a, 0x85, 0xbf,
+	0x9e, 0x55, 0x0a, 0x69, 0xaa, 0x46, 0x5d, 0x3a, 0x0f, 0xcc, 0x3f, 0x0a, 0x28, 0x0f, 0xd9, 0x1c,
+	0x53, 0xef, 0xea, 0x87, 0xe6, 0x37, 0x05, 0x6c, 0x8d, 0xbd, 0x77, 0x16, 0x1d, 0xb5, 0x01, 0xd6,
+	0x5d, 0x46, 0xc2, 0x00, 0xc9, 0xca, 0x77, 0x64, 0x43, 0x8b, 0x6f, 0x7f, 0x63, 0x4f, 0x33, 0x93,
+	0x6e, 0x67, 0x66, 0xdd, 0xce, 0x3c, 0xca, 0xba, 0x5d, 0x6d, 0x55, 0xba, 0x7c, 0xfa, 0x47, 0x45,
+	0xb1, 0xd7, 0x7a, 0x60, 0x79, 0xdc, 0xa7, 0x7b, 0x69, 0x2e, 0xdd, 0xc6, 0xab, 0x25, 0xa0, 0x0f,
+	0xa9, 0xad, 0x21, 0x1f, 0x53, 0x1b, 0x5d, 0x76, 0xaa, 0x8e, 0xc1, 0xdb, 0xbd, 0x54, 0xf1, 0xc8,
+	0x9d, 0x3f, 0x5d, 0x6f, 0xe5, 0xf8, 0xc3, 0xc8, 0x1d, 0x49, 0xeb, 0x71, 0x91, 0xd3, 0x16, 0xe7,
+	0xa7, 0xad, 0x73, 0xf1, 0xdf, 0x42, 0x58, 0x5e, 0xb4, 0x10, 0x1e, 0x83, 0xbb, 0x93, 0x23, 0xfb,
+	0x3f, 0x15, 0x83, 0xf1, 0x4b, 0x92, 0xd3, 0x7d, 0x48, 0x5d, 0x14, 0x0c, 0xd6, 0x61, 0x93, 0x51,
+	0x0f, 0x53, 0xff, 0xaa, 0x3d, 0x3f, 0xf5, 0x1e, 0x58, 0x77, 0x23, 0x14, 0x8f, 0x11, 0xa7, 0x85,
+	0xb0, 0xdf, 0x4a, 0xf2, 0x56, 0xb4, 0xd7, 0xb2, 0xed, 0x83, 0x78, 0xb7, 0x2f, 0x3d, 0xdb, 0xe0,
+	0xee, 0xe4, 0x20, 0xe5, 0x9d, 0xec, 0x07, 0x05, 0xac, 0x37, 0xb8, 0x7f, 0x1c, 0x7a, 0x50, 0xa0,
+	0x47, 0xf1, 0xe0, 0x5f, 0x78, 0xd4, 0x7c, 0x0e, 0x56, 0x92, 0x4f, 0x87, 0xf4, 0xa1, 0x6e, 0x9b,
+	0xd3, 0x3e, 0x7f, 0xcc, 0xc4, 0x63, 0x76, 0xe1, 0x04, 0x6d, 0x94, 0xc1, 0xe6, 0x90, 0xa4, 0x4c,
+	0xee, 0xde, 0xf3, 0x55, 0x50, 0x6c, 0x70, 0x5f, 0xfd, 0x51, 0x01, 0x1b, 0x23, 0x3f, 0x49, 0xee,
+	0x4f, 0xf7, 0x39, 0x66, 0x0e, 0x6b, 0xd5, 0x85, 0xa1, 0x79, 0xb1, 0xff, 0xac, 0x80, 0xcd, 0x71,
+	0x03, 0xfc, 0xd3, 0x99, 0xe8, 0xc7, 0xa0, 0xb5, 0xfa, 0x45, 0xd0, 0xb9, 0x3e, 0x19, 0xb6, 0x91,
+	0xd3, 0x7c, 0xb6, 0xb0, 0x8d, 0x82, 0x6a, 0xd5, 0x85, 0xa1, 0xb9, 0xac, 0x9f, 0x14, 0x70, 0x7b,
+	0xcc, 0x2c, 0xfd, 0x64, 0x6e, 0xf6, 0x1e, 0xf8, 0x32, 0xa4, 0xfd, 0xaa, 0x80, 0x77, 0x26, 0x0d,
+	0x90, 0x07, 0x73, 0xbb, 0x18, 0x62, 0xd0, 0x0e, 0x2e, 0xca, 0x30, 0xa0, 0x75, 0x52, 0x63, 0x9c,
+	0x4d, 0xeb, 0x04, 0x06, 0xed, 0xe0, 0xa2, 0x0c, 0xb9, 0xd6, 0xef, 0xc0, 0x1b, 0x03, 0x3d, 0x67,
+	0x77, 0x26, 0xe6, 0x7e, 0x88, 0x76, 0x7f, 0x6e, 0x48, 0xe6, 0xbd, 0x56, 0x7d, 0x71, 0xa6, 0x2b,
+	0x2f, 0xcf, 0x74, 0xe5, 0xcf, 0x33, 0x5d, 0x79, 0x7a, 0xae, 0x17, 0x5e, 0x9e, 0xeb, 0x85, 0x57,
+	0xe7, 0x7a, 0xe1, 0xab, 0x7b, 0xf9, 0xbf, 0xa3, 0xee, 0xa8, 0xff, 0x47, 0x72, 0x21, 0x9e, 0x84,
+	0x88, 0x37, 0x57, 0xe2, 0xb1, 0xf5, 0xc1, 0xbf, 0x03, 0x00, 0x76, 0x16, 0xf2, 0x5b, 0x0e, 0x0e,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -353,8 +733,12 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	AddMultiStakingDenom(ctx context.Context, in *MsgAddMultiStakingDenom, opts ...grpc.CallOption) (*MsgAddMultiStakingDenomResponse, error)
+	RemoveMultiStakingDenom(ctx context.Context, in *MsgRemoveMultiStakingDenom, opts ...grpc.CallOption) (*MsgRemoveMultiStakingDenomResponse, error)
 	MultiStakingDelegate(ctx context.Context, in *MsgMultiStakingDelegate, opts ...grpc.CallOption) (*MsgMultiStakingDelegateResponse, error)
 	MultiStakingUndelegate(ctx context.Context, in *MsgMultiStakingUndelegate, opts ...grpc.CallOption) (*MsgMultiStakingDelegateResponse, error)
+	MultiStakingBeginRedelegate(ctx context.Context, in *MsgMultiStakingBeginRedelegate, opts ...grpc.CallOption) (*MsgMultiStakingBeginRedelegateResponse, error)
+	CancelMultiStakingUnbonding(ctx context.Context, in *MsgCancelMultiStakingUnbonding, opts ...grpc.CallOption) (*MsgCancelMultiStakingUnbondingResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -374,6 +758,15 @@ func (c *msgClient) AddMultiStakingDenom(ctx context.Context, in *MsgAddMultiSta
 	return out, nil
 }
 
+func (c *msgClient) RemoveMultiStakingDenom(ctx context.Context, in *MsgRemoveMultiStakingDenom, opts ...grpc.CallOption) (*MsgRemoveMultiStakingDenomResponse, error) {
+	out := new(MsgRemoveMultiStakingDenomResponse)
+	err := c.cc.Invoke(ctx, "/celinium.restaking.multistake.v1.Msg/RemoveMultiStakingDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) MultiStakingDelegate(ctx context.Context, in *MsgMultiStakingDelegate, opts ...grpc.CallOption) (*MsgMultiStakingDelegateResponse, error) {
 	out := new(MsgMultiStakingDelegateResponse)
 	err := c.cc.Invoke(ctx, "/celinium.restaking.multistake.v1.Msg/MultiStakingDelegate", in, out, opts...)
@@ -392,11 +785,42 @@ func (c *msgClient) MultiStakingUndelegate(ctx context.Context, in *MsgMultiStak
 	return out, nil
 }
 
+func (c *msgClient) MultiStakingBeginRedelegate(ctx context.Context, in *MsgMultiStakingBeginRedelegate, opts ...grpc.CallOption) (*MsgMultiStakingBeginRedelegateResponse, error) {
+	out := new(MsgMultiStakingBeginRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/celinium.restaking.multistake.v1.Msg/MultiStakingBeginRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMultiStakingUnbonding(ctx context.Context, in *MsgCancelMultiStakingUnbonding, opts ...grpc.CallOption) (*MsgCancelMultiStakingUnbondingResponse, error) {
+	out := new(MsgCancelMultiStakingUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/celinium.restaking.multistake.v1.Msg/CancelMultiStakingUnbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/celinium.restaking.multistake.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddMultiStakingDenom(context.Context, *MsgAddMultiStakingDenom) (*MsgAddMultiStakingDenomResponse, error)
+	RemoveMultiStakingDenom(context.Context, *MsgRemoveMultiStakingDenom) (*MsgRemoveMultiStakingDenomResponse, error)
 	MultiStakingDelegate(context.Context, *MsgMultiStakingDelegate) (*MsgMultiStakingDelegateResponse, error)
 	MultiStakingUndelegate(context.Context, *MsgMultiStakingUndelegate) (*MsgMultiStakingDelegateResponse, error)
+	MultiStakingBeginRedelegate(context.Context, *MsgMultiStakingBeginRedelegate) (*MsgMultiStakingBeginRedelegateResponse, error)
+	CancelMultiStakingUnbonding(context.Context, *MsgCancelMultiStakingUnbonding) (*MsgCancelMultiStakingUnbondingResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -406,12 +830,24 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) AddMultiStakingDenom(ctx context.Context, req *MsgAddMultiStakingDenom) (*MsgAddMultiStakingDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddMultiStakingDenom not implemented")
 }
+func (*UnimplementedMsgServer) RemoveMultiStakingDenom(ctx context.Context, req *MsgRemoveMultiStakingDenom) (*MsgRemoveMultiStakingDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMultiStakingDenom not implemented")
+}
 func (*UnimplementedMsgServer) MultiStakingDelegate(ctx context.Context, req *MsgMultiStakingDelegate) (*MsgMultiStakingDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiStakingDelegate not implemented")
 }
 func (*UnimplementedMsgServer) MultiStakingUndelegate(ctx context.Context, req *MsgMultiStakingUndelegate) (*MsgMultiStakingDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiStakingUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) MultiStakingBeginRedelegate(ctx context.Context, req *MsgMultiStakingBeginRedelegate) (*MsgMultiStakingBeginRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiStakingBeginRedelegate not implemented")
+}
+func (*UnimplementedMsgServer) CancelMultiStakingUnbonding(ctx context.Context, req *MsgCancelMultiStakingUnbonding) (*MsgCancelMultiStakingUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMultiStakingUnbonding not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -435,6 +871,24 @@ func _Msg_AddMultiStakingDenom_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveMultiStakingDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMultiStakingDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMultiStakingDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.restaking.multistake.v1.Msg/RemoveMultiStakingDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMultiStakingDenom(ctx, req.(*MsgRemoveMultiStakingDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_MultiStakingDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgMultiStakingDelegate)
 	if err := dec(in); err != nil {
@@ -471,22 +925,92 @@ func _Msg_MultiStakingUndelegate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celinium.restaking.multistake.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddMultiStakingDenom",
-			Handler:    _Msg_AddMultiStakingDenom_Handler,
-		},
-		{
-			MethodName: "MultiStakingDelegate",
-			Handler:    _Msg_MultiStakingDelegate_Handler,
-		},
+func _Msg_MultiStakingBeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiStakingBeginRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiStakingBeginRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.restaking.multistake.v1.Msg/MultiStakingBeginRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiStakingBeginRedelegate(ctx, req.(*MsgMultiStakingBeginRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMultiStakingUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMultiStakingUnbonding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMultiStakingUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.restaking.multistake.v1.Msg/CancelMultiStakingUnbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMultiStakingUnbonding(ctx, req.(*MsgCancelMultiStakingUnbonding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celinium.restaking.multistake.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celinium.restaking.multistake.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddMultiStakingDenom",
+			Handler:    _Msg_AddMultiStakingDenom_Handler,
+		},
+		{
+			MethodName: "RemoveMultiStakingDenom",
+			Handler:    _Msg_RemoveMultiStakingDenom_Handler,
+		},
+		{
+			MethodName: "MultiStakingDelegate",
+			Handler:    _Msg_MultiStakingDelegate_Handler,
+		},
 		{
 			MethodName: "MultiStakingUndelegate",
 			Handler:    _Msg_MultiStakingUndelegate_Handler,
 		},
+		{
+			MethodName: "MultiStakingBeginRedelegate",
+			Handler:    _Msg_MultiStakingBeginRedelegate_Handler,
+		},
+		{
+			MethodName: "CancelMultiStakingUnbonding",
+			Handler:    _Msg_CancelMultiStakingUnbonding_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celinium/restaking/multistake/v1/tx.proto",
@@ -512,6 +1036,36 @@ func (m *MsgAddMultiStakingDenom) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinDelegation.Size()
+		i -= size
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxValidatorPowerShare.Size()
+		i -= size
+		if _, err := m.MaxValidatorPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxTotalAmount.Size()
+		i -= size
+		if _, err := m.MaxTotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Deonm) > 0 {
 		i -= len(m.Deonm)
 		copy(dAtA[i:], m.Deonm)
@@ -519,10 +1073,10 @@ func (m *MsgAddMultiStakingDenom) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -552,6 +1106,66 @@ func (m *MsgAddMultiStakingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRemoveMultiStakingDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMultiStakingDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMultiStakingDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMultiStakingDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMultiStakingDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMultiStakingDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgMultiStakingDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -710,110 +1324,1344 @@ func (m *MsgMultiStakingUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMultiStakingBeginRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddMultiStakingDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgMultiStakingBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiStakingBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Deonm)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddMultiStakingDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMultiStakingBeginRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMultiStakingDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMultiStakingBeginRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiStakingBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMultiStakingDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelMultiStakingUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMultiStakingUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelMultiStakingUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultiStakingUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.CreationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func (m *MsgMultiStakingUndelegateResponse) Size() (n int) {
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMultiStakingUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMultiStakingUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMultiStakingUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddMultiStakingDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Deonm)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxTotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxValidatorPowerShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddMultiStakingDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveMultiStakingDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveMultiStakingDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiStakingDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMultiStakingDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiStakingUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMultiStakingUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMultiStakingBeginRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMultiStakingBeginRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelMultiStakingUnbonding) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovTx(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelMultiStakingUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddMultiStakingDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMultiStakingDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMultiStakingDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deonm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deonm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMultiStakingDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMultiStakingDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMultiStakingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMultiStakingDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMultiStakingDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMultiStakingDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMultiStakingDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMultiStakingDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMultiStakingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiStakingDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiStakingDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiStakingDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiStakingDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiStakingDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiStakingDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiStakingUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiStakingUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiStakingUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiStakingUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiStakingUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiStakingUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddMultiStakingDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiStakingBeginRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,15 +2684,15 @@ func (m *MsgAddMultiStakingDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMultiStakingDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiStakingBeginRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMultiStakingDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiStakingBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -872,11 +2720,11 @@ func (m *MsgAddMultiStakingDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deonm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -904,7 +2752,72 @@ func (m *MsgAddMultiStakingDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deonm = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -927,7 +2840,7 @@ func (m *MsgAddMultiStakingDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMultiStakingDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiStakingBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -950,12 +2863,45 @@ func (m *MsgAddMultiStakingDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMultiStakingDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiStakingBeginRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMultiStakingDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiStakingBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -977,7 +2923,7 @@ func (m *MsgAddMultiStakingDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiStakingDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMultiStakingUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1000,10 +2946,10 @@ func (m *MsgMultiStakingDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiStakingDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMultiStakingUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiStakingDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMultiStakingUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1103,6 +3049,25 @@ func (m *MsgMultiStakingDelegate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1124,7 +3089,7 @@ func (m *MsgMultiStakingDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiStakingDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelMultiStakingUnbondingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1147,10 +3112,10 @@ func (m *MsgMultiStakingDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiStakingDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelMultiStakingUnbondingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiStakingDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelMultiStakingUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1174,7 +3139,7 @@ func (m *MsgMultiStakingDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiStakingUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1197,15 +3162,15 @@ func (m *MsgMultiStakingUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiStakingUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiStakingUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1233,43 +3198,11 @@ func (m *MsgMultiStakingUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1296,7 +3229,7 @@ func (m *MsgMultiStakingUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1321,7 +3254,7 @@ func (m *MsgMultiStakingUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiStakingUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1344,78 +3277,12 @@ func (m *MsgMultiStakingUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiStakingUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiStakingUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])